@@ -0,0 +1,95 @@
+// Package analysis measures audio for visualizers: it keeps a history of the generated samples so that the
+// part being heard can be looked at, splits premix data into song channels, and works out spectra and meter
+// levels
+// It does not draw anything, so it can be used and tested without a GPU.
+package analysis
+
+import (
+	"math"
+
+	"github.com/gotracker/playback/output"
+)
+
+// MinDB is the quietest level reported, in dB relative to full scale, which silence is clamped to
+const MinDB = -96
+
+// ToDB converts the amplitude `v` (1 is full scale) into dB relative to full scale
+func ToDB(v float64) float64 {
+	if v <= 0 {
+		return MinDB
+	}
+	db := 20 * math.Log10(v)
+	if db < MinDB {
+		return MinDB
+	}
+	return db
+}
+
+// Deinterleave copies channel `ch` of the interleaved samples `src`, which have `channels` channels, into `dst`
+// It returns the part of `dst` that was filled.
+func Deinterleave(dst, src []float32, channels, ch int) []float32 {
+	n := len(src) / channels
+	if n > len(dst) {
+		n = len(dst)
+	}
+	for i := 0; i < n; i++ {
+		dst[i] = src[i*channels+ch]
+	}
+	return dst[:n]
+}
+
+// Downmix mixes the interleaved samples `src`, which have `channels` channels, down to mono into `dst`
+// It returns the part of `dst` that was filled.
+func Downmix(dst, src []float32, channels int) []float32 {
+	n := len(src) / channels
+	if n > len(dst) {
+		n = len(dst)
+	}
+	for i := 0; i < n; i++ {
+		var sum float32
+		for _, v := range src[i*channels : (i+1)*channels] {
+			sum += v
+		}
+		dst[i] = sum / float32(channels)
+	}
+	return dst[:n]
+}
+
+// SplitPremix mixes every song channel of `premix` down to mono on its own, as interleaved frames with one
+// sample for each of the first `numChannels` song channels, and appends them to `dst`
+// The levels match what the channel adds to the mixed output, panning aside. Data that does not come from a
+// song channel, such as OPL2 output, is left out.
+func SplitPremix(dst []float32, premix *output.PremixData, numChannels int) []float32 {
+	start := len(dst)
+	n := premix.SamplesLen * numChannels
+	for i := 0; i < n; i++ {
+		dst = append(dst, 0)
+	}
+	out := dst[start:]
+
+	mixerVolume := float32(premix.MixerVolume)
+	for i, cdata := range premix.Data {
+		if i >= len(premix.Sources) {
+			break
+		}
+		ch := premix.Sources[i].Channel
+		if ch < 0 || ch >= numChannels {
+			continue
+		}
+		for _, d := range cdata {
+			vol := float32(d.Volume) * mixerVolume
+			for j, mtx := range d.Data {
+				frame := d.Pos + j
+				if frame >= premix.SamplesLen || mtx.Channels == 0 {
+					continue
+				}
+				var sum float32
+				for _, v := range mtx.StaticMatrix[:mtx.Channels] {
+					sum += float32(v)
+				}
+				out[frame*numChannels+ch] += sum / float32(mtx.Channels) * vol
+			}
+		}
+	}
+	return dst
+}
@@ -0,0 +1,182 @@
+package analysis
+
+import (
+	"math"
+	"math/cmplx"
+	"testing"
+	"time"
+
+	"github.com/gotracker/gomixing/mixing"
+	"github.com/gotracker/gomixing/volume"
+	"github.com/gotracker/playback/output"
+)
+
+const testSampleRate = 44100
+
+func sine(freq, amp float64, n int) []float32 {
+	samples := make([]float32, n)
+	for i := range samples {
+		samples[i] = float32(amp * math.Sin(2*math.Pi*freq*float64(i)/testSampleRate))
+	}
+	return samples
+}
+
+func TestFFT(t *testing.T) {
+	const n = 64
+	x := make([]complex128, n)
+	for i := range x {
+		x[i] = complex(math.Cos(2*math.Pi*5*float64(i)/n), 0)
+	}
+	FFT(x)
+	for i, v := range x {
+		want := 0.0
+		if i == 5 || i == n-5 {
+			want = n / 2
+		}
+		if math.Abs(cmplx.Abs(v)-want) > 1e-9 {
+			t.Fatalf("bin %d: got %v, want %v", i, cmplx.Abs(v), want)
+		}
+	}
+}
+
+func TestNewSpectrumBadSize(t *testing.T) {
+	if _, err := NewSpectrum(1000, testSampleRate, 16, 20, 20000); err != ErrBadFFTSize {
+		t.Fatalf("got %v, want ErrBadFFTSize", err)
+	}
+}
+
+func TestSpectrumFindsSine(t *testing.T) {
+	s, err := NewSpectrum(4096, testSampleRate, 32, 20, 20000)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, freq := range []float64{110, 1000, 8000} {
+		levels := s.Analyze(sine(freq, 0.5, s.Size()))
+		loudest := 0
+		for i, l := range levels {
+			if l > levels[loudest] {
+				loudest = i
+			}
+		}
+		lo, hi := s.Band(loudest)
+		if freq < lo || freq > hi {
+			t.Errorf("%v Hz: loudest band is %v-%v Hz", freq, lo, hi)
+		}
+		// a sine at half of full scale is -6 dB, give or take the window
+		if got := levels[loudest]; got < -8 || got > -5 {
+			t.Errorf("%v Hz: level %v dB, want about -6 dB", freq, got)
+		}
+	}
+}
+
+func TestSpectrumBandsAreLogarithmic(t *testing.T) {
+	s, err := NewSpectrum(2048, testSampleRate, 10, 20, 20480)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < s.Bands(); i++ {
+		lo, hi := s.Band(i)
+		if math.Abs(hi/lo-2) > 1e-9 {
+			t.Errorf("band %d: %v-%v Hz is not an octave", i, lo, hi)
+		}
+	}
+}
+
+func TestSpectrumSilence(t *testing.T) {
+	s, err := NewSpectrum(1024, testSampleRate, 8, 20, 20000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, l := range s.Analyze(nil) {
+		if l != MinDB {
+			t.Errorf("band %d: got %v dB for silence", i, l)
+		}
+	}
+}
+
+func TestMeterPeakHold(t *testing.T) {
+	m := NewMeter()
+	m.HoldTime = 100 * time.Millisecond
+	m.FallRate = 10
+
+	m.Update(sine(1000, 1, 441), 10*time.Millisecond)
+	if math.Abs(m.Peak()) > 0.01 {
+		t.Fatalf("peak %v dB, want 0 dB", m.Peak())
+	}
+	if want := ToDB(1 / math.Sqrt2); math.Abs(m.Level()-want) > 0.1 {
+		t.Fatalf("level %v dB, want %v dB", m.Level(), want)
+	}
+
+	peak := m.Peak()
+	silence := make([]float32, 441)
+	for i := 0; i < 10; i++ {
+		m.Update(silence, 10*time.Millisecond)
+	}
+	if m.Peak() != peak {
+		t.Fatalf("peak fell to %v dB while it was held", m.Peak())
+	}
+	m.Update(silence, 100*time.Millisecond)
+	if math.Abs(m.Peak()-(peak-1)) > 1e-9 {
+		t.Fatalf("peak %v dB after falling for 100ms, want 1 dB less than %v dB", m.Peak(), peak)
+	}
+
+	m.Update(silence, time.Hour)
+	if m.Peak() != MinDB || m.Level() != MinDB {
+		t.Fatalf("meter did not fall to silence: level %v dB, peak %v dB", m.Level(), m.Peak())
+	}
+}
+
+func TestHistory(t *testing.T) {
+	h := NewHistory(2, 4)
+	h.Write([]float32{1, -1, 2, -2, 3, -3})
+	h.Skip(1)
+	h.Write([]float32{5, -5, 6, -6})
+
+	got := h.Read(make([]float32, 6), 5)
+	want := []float32{3, -3, 0, 0, 5, -5}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+
+	// frame 1 has been overwritten, and frame 6 has not been written yet
+	got = h.Read(make([]float32, 4), 3)
+	if got[0] != 0 || got[1] != 0 || got[2] != 3 || got[3] != -3 {
+		t.Fatalf("got %v for frames that are too old", got)
+	}
+	got = h.Read(make([]float32, 2), 7)
+	if got[0] != 0 || got[1] != 0 {
+		t.Fatalf("got %v for frames that were not written", got)
+	}
+}
+
+func TestSplitPremix(t *testing.T) {
+	mtx := func(l, r volume.Volume) volume.Matrix {
+		var m volume.Matrix
+		m.Assign(2, []volume.Volume{l, r})
+		return m
+	}
+	premix := &output.PremixData{
+		SamplesLen:  3,
+		MixerVolume: 0.5,
+		Data: []mixing.ChannelData{
+			{{Data: mixing.MixBuffer{mtx(1, 1), mtx(1, 0)}, Volume: 1, Pos: 1}},
+			{{Data: mixing.MixBuffer{mtx(1, 1), mtx(1, 1), mtx(1, 1)}, Volume: 1}},
+			{{Data: mixing.MixBuffer{mtx(1, 1)}, Volume: 0.5}},
+		},
+		Sources: []output.PremixSource{{Channel: 1}, {Channel: -1}, {Channel: 0}},
+	}
+
+	got := SplitPremix(nil, premix, 2)
+	want := []float32{0.25, 0, 0, 0.5, 0, 0.25}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if math.Abs(float64(got[i]-want[i])) > 1e-6 {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
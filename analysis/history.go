@@ -0,0 +1,76 @@
+package analysis
+
+// History keeps the most recent frames of generated audio, so that the part being heard, which trails behind
+// what has been generated, can be looked at
+// Frames count from the start of the generated audio, or from the last call to Reset, like the frames of
+// a timeline.Timeline.
+type History struct {
+	channels int
+	frames   int
+	buf      []float32
+	written  int64
+}
+
+// NewHistory creates a history of the last `frames` frames of audio with `channels` channels
+func NewHistory(channels, frames int) *History {
+	return &History{
+		channels: channels,
+		frames:   frames,
+		buf:      make([]float32, channels*frames),
+	}
+}
+
+// Channels returns the number of channels in every frame
+func (h *History) Channels() int {
+	return h.channels
+}
+
+// Write adds the interleaved samples `samples` to the end of the history
+func (h *History) Write(samples []float32) {
+	for i := 0; i+h.channels <= len(samples); i += h.channels {
+		pos := int(h.written%int64(h.frames)) * h.channels
+		copy(h.buf[pos:pos+h.channels], samples[i:i+h.channels])
+		h.written++
+	}
+}
+
+// Skip adds `frames` frames of silence to the end of the history
+func (h *History) Skip(frames int) {
+	for i := 0; i < frames; i++ {
+		pos := int(h.written%int64(h.frames)) * h.channels
+		for j := pos; j < pos+h.channels; j++ {
+			h.buf[j] = 0
+		}
+		h.written++
+	}
+}
+
+// Written returns the number of frames written so far
+func (h *History) Written() int64 {
+	return h.written
+}
+
+// Read fills `dst` with the interleaved frames that come just before frame `end`, and returns it
+// Frames that have not been written yet, or that are too old to be held any more, read as silence.
+func (h *History) Read(dst []float32, end int64) []float32 {
+	n := len(dst) / h.channels
+	first := end - int64(n)
+	for i := 0; i < n; i++ {
+		frame := first + int64(i)
+		out := dst[i*h.channels : (i+1)*h.channels]
+		if frame < 0 || frame >= h.written || frame < h.written-int64(h.frames) {
+			for j := range out {
+				out[j] = 0
+			}
+			continue
+		}
+		pos := int(frame%int64(h.frames)) * h.channels
+		copy(out, h.buf[pos:pos+h.channels])
+	}
+	return dst
+}
+
+// Reset forgets everything written so far, for when the generated audio is thrown away
+func (h *History) Reset() {
+	h.written = 0
+}
@@ -0,0 +1,86 @@
+package analysis
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// DefaultHoldTime is how long a meter holds its peak before letting it fall, by default
+	DefaultHoldTime = 1500 * time.Millisecond
+	// DefaultFallRate is how fast a meter's level and peak fall by default, in dB per second
+	DefaultFallRate = 24
+)
+
+// Meter measures the level and the peak of audio, the way a VU meter with peak hold shows them
+// The level rises straight away and falls at FallRate, so that it does not flicker. The peak stays put for
+// HoldTime before it falls as well.
+type Meter struct {
+	HoldTime time.Duration
+	FallRate float64
+
+	level float64
+	peak  float64
+	held  time.Duration
+}
+
+// NewMeter creates a meter with the default hold time and fall rate, starting at silence
+func NewMeter() *Meter {
+	return &Meter{
+		HoldTime: DefaultHoldTime,
+		FallRate: DefaultFallRate,
+		level:    MinDB,
+		peak:     MinDB,
+	}
+}
+
+// Update measures the mono audio `samples`, which played over `elapsed`, and lets the level and peak fall for
+// that long
+func (m *Meter) Update(samples []float32, elapsed time.Duration) {
+	var sum, peak float64
+	for _, v := range samples {
+		a := math.Abs(float64(v))
+		sum += a * a
+		if a > peak {
+			peak = a
+		}
+	}
+	var rms float64
+	if len(samples) > 0 {
+		rms = math.Sqrt(sum / float64(len(samples)))
+	}
+
+	fall := m.FallRate * elapsed.Seconds()
+	m.level = math.Max(ToDB(rms), math.Max(m.level-fall, MinDB))
+
+	if db := ToDB(peak); db >= m.peak {
+		m.peak = db
+		m.held = 0
+		return
+	}
+	m.held += elapsed
+	if m.held > m.HoldTime {
+		falling := m.held - m.HoldTime
+		if falling > elapsed {
+			falling = elapsed
+		}
+		m.peak = math.Max(m.peak-m.FallRate*falling.Seconds(), math.Max(ToDB(peak), MinDB))
+	}
+}
+
+// Level returns the level of the audio, in dB relative to full scale
+func (m *Meter) Level() float64 {
+	return m.level
+}
+
+// Peak returns the held peak of the audio, in dB relative to full scale
+func (m *Meter) Peak() float64 {
+	return m.peak
+}
+
+// Reset goes back to silence
+func (m *Meter) Reset() {
+	m.level = MinDB
+	m.peak = MinDB
+	m.held = 0
+}
@@ -0,0 +1,157 @@
+package analysis
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+)
+
+var (
+	// ErrBadFFTSize is for when the FFT size is not a power of two
+	ErrBadFFTSize = errors.New("fft size must be a power of two")
+)
+
+// FFT transforms `x` into the frequency domain in place
+// The length of `x` has to be a power of two.
+func FFT(x []complex128) {
+	n := len(x)
+
+	// bit-reversal permutation
+	for i, j := 1, 0; i < n; i++ {
+		bit := n >> 1
+		for ; j&bit != 0; bit >>= 1 {
+			j ^= bit
+		}
+		j ^= bit
+		if i < j {
+			x[i], x[j] = x[j], x[i]
+		}
+	}
+
+	for size := 2; size <= n; size <<= 1 {
+		step := cmplx.Exp(complex(0, -2*math.Pi/float64(size)))
+		for start := 0; start < n; start += size {
+			w := complex(1, 0)
+			for k := 0; k < size/2; k++ {
+				a := x[start+k]
+				b := x[start+k+size/2] * w
+				x[start+k] = a + b
+				x[start+k+size/2] = a - b
+				w *= step
+			}
+		}
+	}
+}
+
+// Spectrum works out the levels of a number of frequency bands, spaced evenly on a logarithmic scale the way
+// the ear hears them
+type Spectrum struct {
+	size       int
+	sampleRate int
+	window     []float64
+	// scale turns a bin magnitude into an amplitude, so that a full scale sine wave reads as 0 dB
+	scale float64
+
+	// bands holds the edges of every band, in Hz
+	bands []float64
+	// bins holds the first and last FFT bin of every band
+	bins [][2]int
+
+	buf    []complex128
+	levels []float64
+}
+
+// NewSpectrum creates a spectrum analyzer that looks at `size` samples at a time, played at `sampleRate` frames
+// per second, and splits them into `bands` bands between `minFreq` and `maxFreq` Hz
+// The size has to be a power of two.
+func NewSpectrum(size, sampleRate, bands int, minFreq, maxFreq float64) (*Spectrum, error) {
+	if size < 2 || size&(size-1) != 0 {
+		return nil, ErrBadFFTSize
+	}
+	if nyquist := float64(sampleRate) / 2; maxFreq > nyquist {
+		maxFreq = nyquist
+	}
+
+	s := &Spectrum{
+		size:       size,
+		sampleRate: sampleRate,
+		window:     make([]float64, size),
+		bands:      make([]float64, bands+1),
+		bins:       make([][2]int, bands),
+		buf:        make([]complex128, size),
+		levels:     make([]float64, bands),
+	}
+
+	// a Hann window keeps the energy of one frequency from leaking into the bands around it
+	var sum float64
+	for i := range s.window {
+		s.window[i] = 0.5 - 0.5*math.Cos(2*math.Pi*float64(i)/float64(size-1))
+		sum += s.window[i]
+	}
+	s.scale = 2 / sum
+
+	ratio := maxFreq / minFreq
+	for i := range s.bands {
+		s.bands[i] = minFreq * math.Pow(ratio, float64(i)/float64(bands))
+	}
+	binWidth := float64(sampleRate) / float64(size)
+	for i := range s.bins {
+		first := int(math.Ceil(s.bands[i] / binWidth))
+		last := int(math.Ceil(s.bands[i+1]/binWidth)) - 1
+		if last < first {
+			// the band is narrower than a bin, so it reads the bin it falls in
+			first = int(math.Round((s.bands[i] + s.bands[i+1]) / 2 / binWidth))
+			last = first
+		}
+		if last > size/2 {
+			last = size / 2
+		}
+		s.bins[i] = [2]int{first, last}
+	}
+	return s, nil
+}
+
+// Size returns the number of samples the analyzer looks at
+func (s *Spectrum) Size() int {
+	return s.size
+}
+
+// Bands returns the number of bands
+func (s *Spectrum) Bands() int {
+	return len(s.levels)
+}
+
+// Band returns the lowest and highest frequencies of band `i`, in Hz
+func (s *Spectrum) Band(i int) (float64, float64) {
+	return s.bands[i], s.bands[i+1]
+}
+
+// Analyze works out the level of every band, in dB relative to full scale, from the last Size samples of the
+// mono audio `samples`
+// Missing samples count as silence. The returned slice is reused by the next call.
+func (s *Spectrum) Analyze(samples []float32) []float64 {
+	if len(samples) > s.size {
+		samples = samples[len(samples)-s.size:]
+	}
+	pad := s.size - len(samples)
+	for i := range s.buf {
+		var v float64
+		if i >= pad {
+			v = float64(samples[i-pad])
+		}
+		s.buf[i] = complex(v*s.window[i], 0)
+	}
+
+	FFT(s.buf)
+
+	for i, b := range s.bins {
+		var peak float64
+		for bin := b[0]; bin <= b[1]; bin++ {
+			if m := cmplx.Abs(s.buf[bin]); m > peak {
+				peak = m
+			}
+		}
+		s.levels[i] = ToDB(peak * s.scale)
+	}
+	return s.levels
+}
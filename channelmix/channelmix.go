@@ -0,0 +1,329 @@
+// Package channelmix applies per-channel mute, solo, volume and panning to song premix data before it is
+// flattened, so that parts of a song can be brought in and out while it plays
+package channelmix
+
+import (
+	"time"
+
+	"github.com/gotracker/gomixing/mixing"
+	"github.com/gotracker/gomixing/panning"
+	"github.com/gotracker/gomixing/volume"
+
+	"github.com/gotracker/playback/output"
+)
+
+// DefaultRampTime is how long volume and panning changes take by default
+// Changing the volume of a channel in one go makes the waveform jump, which is heard as a click.
+const DefaultRampTime = 20 * time.Millisecond
+
+// Mixer holds the mixing settings of every song channel
+// Channels are numbered from 0 like in output.PremixSource, and all start out at full volume with the
+// song's own panning. Settings asked for a channel that has none yet are the ones it starts out with, and
+// changes to a negative channel are ignored.
+type Mixer struct {
+	sampleRate int
+	rampFrames int
+
+	channels []channel
+	solos    int
+}
+
+type channel struct {
+	volume float32
+	mute   bool
+	solo   bool
+
+	// gain is the volume the channel is mixed at right now, which moves by step every frame for the next
+	// left frames, and is then target
+	gain   float32
+	target float32
+	step   float32
+	left   int
+
+	pan       float32
+	panTarget float32
+	// panAmount blends between the song's panning (0) and pan (1)
+	panAmount       float32
+	panAmountTarget float32
+	panStep         float32
+}
+
+// New creates a mixer for audio at `sampleRate` frames per second
+func New(sampleRate int) *Mixer {
+	m := &Mixer{
+		sampleRate: sampleRate,
+	}
+	m.SetRampTime(DefaultRampTime)
+	return m
+}
+
+// SetRampTime sets how long volume and panning changes take from now on
+func (m *Mixer) SetRampTime(d time.Duration) {
+	m.rampFrames = m.durationToFrames(d)
+}
+
+// NumChannels returns the number of channels that have been mixed or had settings changed so far
+func (m *Mixer) NumChannels() int {
+	return len(m.channels)
+}
+
+// SetMute mutes or unmutes channel `ch`
+func (m *Mixer) SetMute(ch int, mute bool) {
+	if ch < 0 {
+		return
+	}
+	m.channel(ch).mute = mute
+	m.updateTargets(m.rampFrames)
+}
+
+// Mute returns true if channel `ch` is muted
+func (m *Mixer) Mute(ch int) bool {
+	return m.settings(ch).mute
+}
+
+// SetSolo solos or unsolos channel `ch`
+// While any channel is soloed, only the soloed channels are heard, even the ones that are muted.
+func (m *Mixer) SetSolo(ch int, solo bool) {
+	if ch < 0 {
+		return
+	}
+	c := m.channel(ch)
+	if c.solo == solo {
+		return
+	}
+	c.solo = solo
+	if solo {
+		m.solos++
+	} else {
+		m.solos--
+	}
+	m.updateTargets(m.rampFrames)
+}
+
+// Solo returns true if channel `ch` is soloed
+func (m *Mixer) Solo(ch int) bool {
+	return m.settings(ch).solo
+}
+
+// SetVolume sets the volume of channel `ch`, where 1 is the volume the song plays it at
+func (m *Mixer) SetVolume(ch int, vol float32) {
+	m.FadeVolume(ch, vol, 0)
+}
+
+// FadeVolume sets the volume of channel `ch` like SetVolume, but takes `d` to get there
+// A duration shorter than the ramp time uses the ramp time.
+func (m *Mixer) FadeVolume(ch int, vol float32, d time.Duration) {
+	if ch < 0 {
+		return
+	}
+	if vol < 0 {
+		vol = 0
+	}
+	m.channel(ch).volume = vol
+
+	frames := m.durationToFrames(d)
+	if frames < m.rampFrames {
+		frames = m.rampFrames
+	}
+	m.updateTargets(frames)
+}
+
+// Volume returns the volume of channel `ch`
+func (m *Mixer) Volume(ch int) float32 {
+	return m.settings(ch).volume
+}
+
+// SetPan overrides the panning of channel `ch` with `pan`, from -1 (left) to 1 (right)
+func (m *Mixer) SetPan(ch int, pan float32) {
+	if ch < 0 {
+		return
+	}
+	if pan < -1 {
+		pan = -1
+	} else if pan > 1 {
+		pan = 1
+	}
+
+	c := m.channel(ch)
+	if c.panAmountTarget == 0 && c.panAmount == 0 {
+		// nothing to ramp from
+		c.pan = pan
+	}
+	c.panTarget = pan
+	c.panAmountTarget = 1
+	m.updatePanStep(c)
+}
+
+// ClearPan goes back to the song's own panning on channel `ch`
+func (m *Mixer) ClearPan(ch int) {
+	if ch < 0 {
+		return
+	}
+	c := m.channel(ch)
+	c.panAmountTarget = 0
+	m.updatePanStep(c)
+}
+
+// Pan returns the panning override of channel `ch`, and false when it uses the song's own panning
+func (m *Mixer) Pan(ch int) (float32, bool) {
+	c := m.settings(ch)
+	return c.panTarget, c.panAmountTarget != 0
+}
+
+// Reset goes back to every channel at full volume with the song's own panning, right away
+func (m *Mixer) Reset() {
+	m.channels = m.channels[:0]
+	m.solos = 0
+}
+
+// Apply applies the channel settings to `premix`, changing its data in place
+func (m *Mixer) Apply(premix *output.PremixData) {
+	for i, cdata := range premix.Data {
+		if i >= len(premix.Sources) || premix.Sources[i].Channel < 0 {
+			continue
+		}
+		c := m.channel(premix.Sources[i].Channel)
+		for j := range cdata {
+			m.applyGain(c, &cdata[j])
+			m.applyPan(c, &cdata[j])
+		}
+	}
+
+	for i := range m.channels {
+		m.advance(&m.channels[i], premix.SamplesLen)
+	}
+}
+
+// applyGain ramps the samples of `d` from the current gain of `c` towards its target
+func (m *Mixer) applyGain(c *channel, d *mixing.Data) {
+	if c.gain == 1 && c.target == 1 {
+		return
+	}
+	for i := range d.Data {
+		d.Data[i] = d.Data[i].Apply(volume.Volume(c.gainAt(d.Pos + i)))
+	}
+}
+
+// applyPan sets the panning of `d`, which can only change once per tick
+func (m *Mixer) applyPan(c *channel, d *mixing.Data) {
+	if c.panAmount == 0 {
+		return
+	}
+	songPan := panning.FromStereoPosition(d.Pan, -1, 1)
+	pan := songPan + (c.pan-songPan)*c.panAmount
+	d.Pan = panning.MakeStereoPosition(pan, -1, 1)
+}
+
+// gainAt returns the gain of `c` at `frame` frames into the current tick
+// The ramp is counted in frames rather than checked against the target, so that rounding cannot leave the
+// gain short of it.
+func (c *channel) gainAt(frame int) float32 {
+	if frame >= c.left {
+		return c.target
+	}
+	return c.gain + c.step*float32(frame)
+}
+
+// advance moves the ramps of `c` on by `frames` frames
+func (m *Mixer) advance(c *channel, frames int) {
+	c.gain = c.gainAt(frames)
+	if c.left -= frames; c.left <= 0 {
+		c.left = 0
+		c.step = 0
+	}
+
+	if c.panStep != 0 {
+		n := float32(frames) * c.panStep
+		c.panAmount = approach(c.panAmount, c.panAmountTarget, n)
+		c.pan = approach(c.pan, c.panTarget, 2*n)
+		if c.panAmount == c.panAmountTarget && c.pan == c.panTarget {
+			c.panStep = 0
+		}
+	}
+}
+
+// updateTargets works out the gain every channel should end up at, and sets the ones that changed ramping
+// there over `frames` frames
+func (m *Mixer) updateTargets(frames int) {
+	for i := range m.channels {
+		c := &m.channels[i]
+		// while any channel is soloed, the soloed channels are heard whether they are muted or not
+		target := c.volume
+		if (m.solos > 0 && !c.solo) || (m.solos == 0 && c.mute) {
+			target = 0
+		}
+		if target == c.target {
+			continue
+		}
+		c.target = target
+		if frames <= 0 {
+			c.gain = c.target
+			c.step = 0
+			c.left = 0
+		} else {
+			c.step = (c.target - c.gain) / float32(frames)
+			c.left = frames
+		}
+	}
+}
+
+func (m *Mixer) updatePanStep(c *channel) {
+	if m.rampFrames <= 0 {
+		c.panAmount = c.panAmountTarget
+		c.pan = c.panTarget
+		c.panStep = 0
+		return
+	}
+	c.panStep = 1 / float32(m.rampFrames)
+}
+
+// channel returns the settings of channel `ch`, which must not be negative, adding channels as needed
+func (m *Mixer) channel(ch int) *channel {
+	for len(m.channels) <= ch {
+		m.channels = append(m.channels, m.newChannel())
+	}
+	return &m.channels[ch]
+}
+
+// settings returns the settings of channel `ch` without adding it, which are the ones a new channel starts out
+// with if it has not been added yet
+func (m *Mixer) settings(ch int) channel {
+	if ch < 0 || ch >= len(m.channels) {
+		return m.newChannel()
+	}
+	return m.channels[ch]
+}
+
+// newChannel returns the settings a channel starts out with
+func (m *Mixer) newChannel() channel {
+	c := channel{
+		volume: 1,
+		gain:   1,
+		target: 1,
+	}
+	if m.solos > 0 {
+		// a new channel is not soloed, so it starts out silenced
+		c.gain = 0
+		c.target = 0
+	}
+	return c
+}
+
+func (m *Mixer) durationToFrames(d time.Duration) int {
+	return int(int64(d) * int64(m.sampleRate) / int64(time.Second))
+}
+
+// approach moves `v` towards `target` by no more than `step`
+func approach(v, target, step float32) float32 {
+	switch {
+	case v < target:
+		if v += step; v > target {
+			v = target
+		}
+	case v > target:
+		if v -= step; v < target {
+			v = target
+		}
+	}
+	return v
+}
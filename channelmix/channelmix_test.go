@@ -0,0 +1,235 @@
+package channelmix
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/gotracker/gomixing/mixing"
+	"github.com/gotracker/gomixing/panning"
+	"github.com/gotracker/gomixing/volume"
+
+	"github.com/gotracker/playback/output"
+)
+
+// testRate is the sample rate of the tests, at which a frame lasts a millisecond
+const testRate = 1000
+
+// songPan is the panning every channel of the test premix data has from the song
+var songPan = panning.MakeStereoPosition(0.5, -1, 1)
+
+// newPremix returns premix data of `frames` frames for song channels 0 to `channels`-1, in which every
+// channel plays a constant mono sample of 1
+func newPremix(channels, frames int) *output.PremixData {
+	p := &output.PremixData{
+		SamplesLen:  frames,
+		MixerVolume: 1,
+	}
+	for ch := 0; ch < channels; ch++ {
+		buf := make(mixing.MixBuffer, frames)
+		for i := range buf {
+			buf[i].Assign(1, []volume.Volume{1})
+		}
+		p.Data = append(p.Data, mixing.ChannelData{{
+			Data:       buf,
+			Pan:        songPan,
+			Volume:     1,
+			SamplesLen: frames,
+		}})
+		p.Sources = append(p.Sources, output.PremixSource{Channel: ch})
+	}
+	return p
+}
+
+// gains returns the gain of every frame of channel `ch` of `p`
+func gains(p *output.PremixData, ch int) []float32 {
+	var g []float32
+	for _, m := range p.Data[ch][0].Data {
+		g = append(g, float32(m.Get(0)))
+	}
+	return g
+}
+
+// run applies `m` to `ticks` ticks of `frames` frames of premix data for `channels` channels and returns
+// the gains of every channel over all of them
+func run(m *Mixer, channels, ticks, frames int) [][]float32 {
+	all := make([][]float32, channels)
+	for i := 0; i < ticks; i++ {
+		p := newPremix(channels, frames)
+		m.Apply(p)
+		for ch := range all {
+			all[ch] = append(all[ch], gains(p, ch)...)
+		}
+	}
+	return all
+}
+
+// isConstant returns true if every one of `g` is `v`
+func isConstant(g []float32, v float32) bool {
+	for _, x := range g {
+		if x != v {
+			return false
+		}
+	}
+	return true
+}
+
+func TestSoloOverridesMute(t *testing.T) {
+	m := New(testRate)
+	m.SetRampTime(0)
+	m.SetMute(0, true)
+	m.SetSolo(0, true)
+	m.SetMute(2, true)
+
+	tests := []struct {
+		name string
+		do   func()
+		want []float32
+	}{
+		{"muted and soloed", func() {}, []float32{1, 0, 0}},
+		{"solo of a muted channel", func() { m.SetSolo(2, true) }, []float32{1, 0, 1}},
+		{"unmuted and soloed", func() { m.SetMute(0, false) }, []float32{1, 0, 1}},
+		{"no solos", func() {
+			m.SetMute(0, true)
+			m.SetSolo(0, false)
+			m.SetSolo(2, false)
+		}, []float32{0, 1, 0}},
+	}
+	for _, tc := range tests {
+		tc.do()
+		got := run(m, 3, 1, 10)
+		for ch, want := range tc.want {
+			if !isConstant(got[ch], want) {
+				t.Errorf("%s: channel %d has gains %v, want %v", tc.name, ch, got[ch], want)
+			}
+		}
+	}
+}
+
+// TestSoloNewChannel checks that a channel the mixer sees for the first time while another one is soloed is
+// silent from its first frame
+func TestSoloNewChannel(t *testing.T) {
+	m := New(testRate)
+	m.SetSolo(0, true)
+	got := run(m, 2, 1, 10)
+	if !isConstant(got[0], 1) || !isConstant(got[1], 0) {
+		t.Errorf("got gains %v", got)
+	}
+}
+
+func TestRamps(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(m *Mixer)
+		// frames is how long the ramp takes, and target the gain it ends on
+		frames int
+		target float32
+	}{
+		{"volume", func(m *Mixer) { m.SetVolume(0, 0.3) }, 20, 0.3},
+		{"volume up", func(m *Mixer) { m.SetVolume(0, 1.7) }, 20, 1.7},
+		{"mute", func(m *Mixer) { m.SetMute(0, true) }, 20, 0},
+		{"solo of another channel", func(m *Mixer) { m.SetSolo(1, true) }, 20, 0},
+		{"fade", func(m *Mixer) { m.FadeVolume(0, 0.25, 130*time.Millisecond) }, 130, 0.25},
+		{"fade shorter than the ramp", func(m *Mixer) { m.FadeVolume(0, 0, 5*time.Millisecond) }, 20, 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			m := New(testRate)
+			// the mixer sees the channels before the change, as it does while a song plays
+			run(m, 2, 1, 7)
+			tc.change(m)
+
+			// ticks of 7 frames do not line up with the end of the ramp
+			got := run(m, 2, (tc.frames+40)/7, 7)[0]
+			for i := 1; i < tc.frames; i++ {
+				moving := got[i] - got[i-1]
+				if (tc.target < 1 && moving >= 0) || (tc.target > 1 && moving <= 0) {
+					t.Fatalf("gain does not move towards %v at frame %d: %v", tc.target, i, got[:tc.frames])
+				}
+			}
+			if !isConstant(got[tc.frames:], tc.target) {
+				t.Errorf("gains after the ramp are %v, want %v", got[tc.frames:], tc.target)
+			}
+			c := m.channels[0]
+			if c.gain != tc.target || c.step != 0 {
+				t.Errorf("channel ended with gain %v and step %v, want gain %v and no step", c.gain, c.step, tc.target)
+			}
+		})
+	}
+}
+
+func TestPanRamp(t *testing.T) {
+	m := New(testRate)
+	run(m, 1, 1, 10)
+
+	m.SetPan(0, -1)
+	var last panning.Position
+	for i := 0; i < 5; i++ {
+		p := newPremix(1, 10)
+		m.Apply(p)
+		last = p.Data[0][0].Pan
+	}
+	want := panning.MakeStereoPosition(-1, -1, 1)
+	if math.Abs(float64(last.Angle-want.Angle)) > 1e-6 || math.Abs(float64(last.Distance-want.Distance)) > 1e-6 {
+		t.Errorf("panned to %+v after the ramp, want %+v", last, want)
+	}
+	if pan, ok := m.Pan(0); !ok || pan != -1 {
+		t.Errorf("got pan %v, %v, want -1, true", pan, ok)
+	}
+
+	m.ClearPan(0)
+	for i := 0; i < 5; i++ {
+		p := newPremix(1, 10)
+		m.Apply(p)
+		last = p.Data[0][0].Pan
+	}
+	if last != songPan {
+		t.Errorf("panned to %+v after clearing the pan, want the song's %+v", last, songPan)
+	}
+	if c := m.channels[0]; c.panAmount != 0 || c.panStep != 0 {
+		t.Errorf("channel ended with pan amount %v and step %v", c.panAmount, c.panStep)
+	}
+}
+
+// TestReadsDoNotAddChannels checks that asking about a channel that has no settings, or a negative one, returns
+// the settings it would start out with and leaves the mixer as it was
+func TestReadsDoNotAddChannels(t *testing.T) {
+	for _, ch := range []int{-1, 0, 3, 100} {
+		m := New(testRate)
+		if m.Mute(ch) || m.Solo(ch) {
+			t.Errorf("channel %d is muted or soloed", ch)
+		}
+		if v := m.Volume(ch); v != 1 {
+			t.Errorf("channel %d has volume %v, want 1", ch, v)
+		}
+		if pan, ok := m.Pan(ch); pan != 0 || ok {
+			t.Errorf("channel %d has pan %v, %v, want 0, false", ch, pan, ok)
+		}
+		if n := m.NumChannels(); n != 0 {
+			t.Errorf("reading channel %d made %d channels, want 0", ch, n)
+		}
+	}
+}
+
+// TestNegativeChannel checks that changes to a negative channel are ignored
+func TestNegativeChannel(t *testing.T) {
+	m := New(testRate)
+	m.SetMute(-1, true)
+	m.SetSolo(-1, true)
+	m.SetVolume(-1, 0.5)
+	m.FadeVolume(-2, 0, time.Second)
+	m.SetPan(-1, 1)
+	m.ClearPan(-1)
+	if n := m.NumChannels(); n != 0 {
+		t.Errorf("the mixer has %d channels, want 0", n)
+	}
+	if m.Solo(-1) {
+		t.Error("channel -1 is soloed")
+	}
+
+	// the solo was not counted, so the song's channels are heard
+	got := run(m, 2, 1, 10)
+	if !isConstant(got[0], 1) || !isConstant(got[1], 1) {
+		t.Errorf("got gains %v", got)
+	}
+}
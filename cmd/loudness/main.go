@@ -0,0 +1,65 @@
+// Command loudness measures the loudness of songs and keeps the measurements in a cache file, which the demo
+// reads to play every song at the same loudness
+// Songs already in the cache are not measured again, so it can be run as part of every build.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/eliasdaler/ebiten-tracker-demo/loudness"
+	"github.com/eliasdaler/ebiten-tracker-demo/playlist"
+)
+
+func main() {
+	output := flag.String("o", "loudness.json", "cache file to read and update")
+	target := flag.Float64("target", loudness.DefaultTarget, "target loudness to show gains for, in LUFS")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] song|playlist|directory...\n",
+			filepath.Base(os.Args[0]))
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	cache, err := loudness.LoadCache(*output)
+	if err != nil {
+		log.Fatalf("%s: %v", *output, err)
+	}
+	// only the songs asked for are kept, so that songs that have gone or changed drop out of the cache
+	kept := loudness.NewCache()
+	for _, arg := range flag.Args() {
+		entries, err := playlist.Open(arg, 0)
+		if err != nil {
+			log.Fatalf("%s: %v", arg, err)
+		}
+		for _, e := range entries {
+			data, err := e.Read()
+			if err != nil {
+				log.Fatalf("%s: %v", e.Path, err)
+			}
+			r, measured, err := cache.Measure(e.Path, e.Format, data)
+			if err != nil {
+				log.Fatalf("%s: %v", e.Path, err)
+			}
+			kept.Add(e.Path, data, r)
+			cached := ""
+			if !measured {
+				cached = " (cached)"
+			}
+			fmt.Printf("%-24s %6.1f LUFS %6.1f dBTP %+5.1f dB%s\n", e.Path, r.Integrated, r.TruePeak,
+				r.GainDB(*target, loudness.DefaultMaxTruePeak), cached)
+		}
+	}
+
+	if err := kept.Save(*output); err != nil {
+		log.Fatal(err)
+	}
+}
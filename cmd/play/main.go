@@ -0,0 +1,309 @@
+// Command play plays a tracked music module (MOD, S3M, XM or IT) without a window or an audio device, by
+// writing it as WAV or raw PCM data to standard output or a file
+// In a terminal, it shows where the song is and takes keyboard controls while it plays.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/gotracker/goaudiofile/audio/wav"
+	"github.com/gotracker/playback"
+	"github.com/gotracker/playback/index"
+	"github.com/gotracker/playback/voice/interpolation"
+
+	"github.com/eliasdaler/ebiten-tracker-demo/render"
+)
+
+var (
+	// ErrTerminalOutput is for when the audio would be written to a terminal
+	ErrTerminalOutput = errors.New("refusing to write audio to a terminal; pipe it somewhere or use -o")
+)
+
+func main() {
+	opts := render.DefaultOptions
+
+	output := flag.String("o", "-", "output file, or - for standard output")
+	raw := flag.Bool("raw", false, "write raw PCM data instead of a WAV file")
+	formatName := flag.String("format", "", "module format: mod, s3m, xm or it (default: from the file extension)")
+	flag.IntVar(&opts.SampleRate, "rate", opts.SampleRate, "sample rate in Hz")
+	flag.IntVar(&opts.Channels, "channels", opts.Channels, "number of output channels: 1, 2 or 4")
+	flag.IntVar(&opts.BitsPerSample, "bits", opts.BitsPerSample, "bits per sample: 8, 16, 24 or 32 (float)")
+	flag.IntVar(&opts.Loops, "loops", opts.Loops, "number of times the song repeats; negative loops until -max")
+	flag.DurationVar(&opts.FadeOut, "fade", opts.FadeOut, "fade-out length at the end of the song")
+	flag.DurationVar(&opts.MaxDuration, "max", opts.MaxDuration, "maximum playing time (0 = until the song ends)")
+	interp := flag.String("interp", "linear", "sample interpolation: linear, nearest, cubic, sinc, a500 or a1200")
+	realtime := flag.Bool("realtime", false, "write the audio no faster than it plays")
+	quiet := flag.Bool("q", false, "do not show the progress")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] module\n", filepath.Base(os.Args[0]))
+		flag.PrintDefaults()
+		fmt.Fprintf(flag.CommandLine.Output(), "Keys, when run in a terminal: %s\n", keyHelp)
+	}
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	mode, err := interpolation.Parse(*interp)
+	if err != nil {
+		log.Fatalf("%v: %q", err, *interp)
+	}
+	opts.Interpolation = mode
+	input := flag.Arg(0)
+
+	if *formatName == "" {
+		*formatName = strings.ToLower(strings.TrimPrefix(filepath.Ext(input), "."))
+	}
+
+	data, err := os.ReadFile(input)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	s := session{
+		format:   *formatName,
+		data:     data,
+		opts:     opts,
+		raw:      *raw,
+		realtime: *realtime,
+	}
+	if err := s.start(); err != nil {
+		log.Fatalf("%s: %v", input, err)
+	}
+	// the progress shows the length of the song when it can be worked out, and leaves it out when it cannot,
+	// such as for songs that never stop looping
+	if s.length, err = measure(s.format, s.data, s.opts); err != nil {
+		log.Printf("%s: measuring the song: %v", input, err)
+	}
+
+	out := os.Stdout
+	if *output != "-" {
+		f, err := os.Create(*output)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer f.Close()
+		out = f
+	} else if isTerminal(out) {
+		log.Fatal(ErrTerminalOutput)
+	}
+
+	if !*quiet && isTerminal(os.Stderr) {
+		s.progress = &progress{w: os.Stderr}
+	}
+
+	if isTerminal(os.Stdin) {
+		restore, err := makeRaw(os.Stdin)
+		if err != nil {
+			log.Println(err)
+		} else {
+			s.keys = make(chan key, 1)
+			go readKeys(os.Stdin, s.keys)
+			defer restore()
+		}
+	}
+
+	err = s.play(out)
+	if s.progress != nil {
+		s.progress.clear()
+	}
+	if err != nil {
+		log.Printf("%s: %v", input, err)
+		return
+	}
+	log.Printf("%s: played %v", input, s.played().Round(time.Second))
+}
+
+// session plays a song, and keeps track of where it is
+type session struct {
+	format   string
+	data     []byte
+	opts     render.Options
+	raw      bool
+	realtime bool
+
+	player playback.Playback
+	r      *render.Renderer
+	// base is the song time at the frame `from` of the renderer, which changes when the song seeks
+	base time.Duration
+	from int
+
+	length   *songLength
+	progress *progress
+	keys     chan key
+	paused   bool
+	// written is the number of frames written, through restarts and seeks
+	written int
+}
+
+// start loads the song and starts it from the very beginning, with all of its initial settings
+func (s *session) start() error {
+	player, err := render.Load(s.format, s.data, s.opts)
+	if err != nil {
+		return err
+	}
+	r, err := render.NewRenderer(player, s.opts)
+	if err != nil {
+		return err
+	}
+	s.player, s.r = player, r
+	s.base, s.from = 0, 0
+	return nil
+}
+
+// elapsed returns the time into the song that has been played
+func (s *session) elapsed() time.Duration {
+	return s.base + s.framesToDuration(s.r.Frames()-s.from)
+}
+
+// played returns how long the audio written so far plays for
+func (s *session) played() time.Duration {
+	return s.framesToDuration(s.written)
+}
+
+func (s *session) framesToDuration(frames int) time.Duration {
+	return time.Duration(frames) * time.Second / time.Duration(s.opts.SampleRate)
+}
+
+// seekOrder moves the song to the start of `order`
+func (s *session) seekOrder(order int) error {
+	if numOrders := s.player.GetNumOrders(); order >= numOrders {
+		order = numOrders - 1
+	}
+	if order < 0 {
+		order = 0
+	}
+
+	base := s.elapsed()
+	if err := s.player.Seek(index.Order(order), 0); err != nil {
+		return err
+	}
+	// the time an order starts at is known when the song has been measured, and only if it is ever played
+	if s.length != nil {
+		if start, ok := s.length.starts[order]; ok {
+			base = start
+		}
+	}
+	s.base, s.from = base, s.r.Frames()
+	return nil
+}
+
+// play writes the song to `w` until it ends or is stopped
+func (s *session) play(w io.Writer) error {
+	enc := w
+	if !s.raw {
+		ww, err := wav.NewWriter(w, s.opts.FmtChunk())
+		if err != nil {
+			return err
+		}
+		// a WAV file that was stopped early is still finished, so that it can be read
+		defer ww.Close()
+		enc = ww
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signals)
+
+	started := time.Now()
+	var order, row int
+	var pcm []byte
+	for {
+		if s.paused {
+			// nothing is written while paused, so whatever reads the audio waits for more
+			select {
+			case k := <-s.keys:
+				if done, err := s.handleKey(k); done || err != nil {
+					return err
+				}
+			case <-signals:
+				return nil
+			}
+			started = time.Now().Add(-s.played())
+			s.drawProgress(order, row, true)
+			continue
+		}
+
+		select {
+		case k := <-s.keys:
+			if done, err := s.handleKey(k); done || err != nil {
+				return err
+			}
+			s.drawProgress(order, row, true)
+			continue
+		case <-signals:
+			return nil
+		default:
+		}
+
+		b, err := s.r.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		order, row = b.Order, b.Row
+
+		pcm = render.AppendPCM(pcm[:0], b.Master, s.opts.BitsPerSample)
+		if _, err := enc.Write(pcm); err != nil {
+			return err
+		}
+		s.written += b.Frames
+		s.drawProgress(order, row, false)
+
+		if s.realtime {
+			time.Sleep(time.Until(started.Add(s.played())))
+		}
+	}
+}
+
+// handleKey carries out the keyboard control `k`, and returns true when the song is to stop
+func (s *session) handleKey(k key) (bool, error) {
+	switch k {
+	case keyPause:
+		s.paused = !s.paused
+	case keyNextOrder, keyPrevOrder:
+		order := int(s.player.GetCurrentOrder())
+		if k == keyNextOrder {
+			order++
+		} else if order > 0 {
+			order--
+		}
+		if err := s.seekOrder(order); err != nil {
+			// the song is still where it was, so just carry on from there
+			log.Println(err)
+		}
+	case keyRestart:
+		if err := s.start(); err != nil {
+			return true, err
+		}
+	case keyQuit:
+		return true, nil
+	}
+	return false, nil
+}
+
+// drawProgress redraws the progress line, if there is one
+func (s *session) drawProgress(order, row int, force bool) {
+	if s.progress == nil {
+		return
+	}
+	var total time.Duration
+	if s.length != nil {
+		total = s.length.total
+	}
+	s.progress.draw(order, s.player.GetNumOrders(), row, s.elapsed(), total, s.paused, force)
+}
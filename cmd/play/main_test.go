@@ -0,0 +1,159 @@
+package main
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/eliasdaler/ebiten-tracker-demo/internal/fixture"
+	"github.com/eliasdaler/ebiten-tracker-demo/render"
+)
+
+// newSession starts the fixture MOD, which has two orders, and measures it the way main does
+func newSession(t *testing.T) *session {
+	t.Helper()
+	s := &session{
+		format: "mod",
+		data:   fixture.MOD(),
+		opts:   render.DefaultOptions,
+	}
+	if err := s.start(); err != nil {
+		t.Fatal(err)
+	}
+	length, err := measure(s.format, s.data, s.opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.length = length
+	return s
+}
+
+// advance renders `blocks` blocks of the song
+func advance(t *testing.T, s *session, blocks int) {
+	t.Helper()
+	for i := 0; i < blocks; i++ {
+		if _, err := s.r.Next(); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestHandleKeySeeksOrders(t *testing.T) {
+	tests := []struct {
+		name string
+		// from is the order to start on
+		from int
+		k    key
+		want int
+	}{
+		{"next", 0, keyNextOrder, 1},
+		{"previous", 1, keyPrevOrder, 0},
+		{"previous on the first order", 0, keyPrevOrder, 0},
+		{"next on the last order", 1, keyNextOrder, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := newSession(t)
+			if err := s.seekOrder(tt.from); err != nil {
+				t.Fatal(err)
+			}
+			advance(t, s, 3)
+
+			done, err := s.handleKey(tt.k)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if done {
+				t.Fatal("seeking stops the song")
+			}
+			if got := int(s.player.GetCurrentOrder()); got != tt.want {
+				t.Errorf("the song is on order %d, want %d", got, tt.want)
+			}
+			if got, want := s.elapsed(), s.length.starts[tt.want]; got != want {
+				t.Errorf("the song is %v in, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestHandleKey(t *testing.T) {
+	s := newSession(t)
+	advance(t, s, 10)
+
+	if done, _ := s.handleKey(keyPause); done || !s.paused {
+		t.Errorf("after pausing, done = %v and paused = %v, want false and true", done, s.paused)
+	}
+	if done, _ := s.handleKey(keyPause); done || s.paused {
+		t.Errorf("after pausing again, done = %v and paused = %v, want false and false", done, s.paused)
+	}
+
+	if _, err := s.handleKey(keyRestart); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.elapsed(); got != 0 {
+		t.Errorf("after restarting, the song is %v in, want 0", got)
+	}
+	if got := s.player.GetCurrentOrder(); got != 0 {
+		t.Errorf("after restarting, the song is on order %d, want 0", got)
+	}
+
+	if done, err := s.handleKey(keyQuit); !done || err != nil {
+		t.Errorf("quitting returns %v, %v, want true and no error", done, err)
+	}
+}
+
+// TestSeekOrderWithoutLength checks that a song that could not be measured keeps counting its time from where
+// it was when it seeks
+func TestSeekOrderWithoutLength(t *testing.T) {
+	s := newSession(t)
+	s.length = nil
+	advance(t, s, 10)
+
+	before := s.elapsed()
+	if err := s.seekOrder(1); err != nil {
+		t.Fatal(err)
+	}
+	if got := s.elapsed(); got != before {
+		t.Errorf("right after seeking, the song is %v in, want %v", got, before)
+	}
+	advance(t, s, 1)
+	if got := s.elapsed(); got <= before || got > before+time.Second {
+		t.Errorf("a block after seeking, the song is %v in, want a little after %v", got, before)
+	}
+}
+
+func TestReadKeys(t *testing.T) {
+	input := []string{" ", "\x1b[C", "\x1b[D", "r", "x", "\x1b[1~", "q"}
+	want := []key{keyPause, keyNextOrder, keyPrevOrder, keyRestart, keyRestart, keyQuit}
+
+	keys := make(chan key, len(input))
+	readKeys(&chunkReader{chunks: input}, keys)
+	close(keys)
+
+	var got []key
+	for k := range keys {
+		got = append(got, k)
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got keys %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("key %d is %v, want %v", i, got[i], want[i])
+		}
+	}
+}
+
+// chunkReader returns one chunk per read, the way a terminal in raw mode returns one key press
+type chunkReader struct {
+	chunks []string
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	if len(r.chunks) == 0 {
+		return 0, io.EOF
+	}
+	n := copy(p, r.chunks[0])
+	r.chunks = r.chunks[1:]
+	return n, nil
+}
@@ -0,0 +1,127 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gotracker/goaudiofile/music/tracked/duration"
+	"github.com/gotracker/goaudiofile/music/tracked/it"
+	"github.com/gotracker/goaudiofile/music/tracked/mod"
+	"github.com/gotracker/goaudiofile/music/tracked/s3m"
+	"github.com/gotracker/goaudiofile/music/tracked/xm"
+
+	"github.com/eliasdaler/ebiten-tracker-demo/render"
+)
+
+var (
+	// ErrUnknownLength is for when the format of a song is not known, so its length cannot be worked out
+	ErrUnknownLength = errors.New("length of a song in an unknown format")
+)
+
+// progressInterval is how often the progress line is redrawn
+const progressInterval = 100 * time.Millisecond
+
+// songLength is how long a song plays for, and when each of its orders starts for the first time
+type songLength struct {
+	total  time.Duration
+	starts map[int]time.Duration
+}
+
+// measure works out the length of the song in `data`, as it plays with `opts`, from its order list and flow
+// control effects, without rendering it
+func measure(format string, data []byte, opts render.Options) (*songLength, error) {
+	info, err := analyze(format, data)
+	if err != nil {
+		return nil, err
+	}
+
+	total := info.Duration
+	switch {
+	case opts.Loops < 0:
+		total = opts.MaxDuration
+	case opts.Loops > 0:
+		// every repeat plays from where the song loops back to until its end
+		total += time.Duration(opts.Loops) * (info.Duration - info.LoopStart)
+	}
+	if opts.MaxDuration > 0 && total > opts.MaxDuration {
+		total = opts.MaxDuration
+	}
+	return &songLength{
+		total:  total,
+		starts: info.OrderStarts,
+	}, nil
+}
+
+// analyze reads the song in `data` and follows its flow through the order list
+func analyze(format string, data []byte) (*duration.Info, error) {
+	r := bytes.NewReader(data)
+	switch format {
+	case "mod":
+		f, err := mod.Read(r)
+		if err != nil {
+			return nil, err
+		}
+		return duration.FromMOD(f)
+	case "s3m":
+		f, err := s3m.Read(r)
+		if err != nil {
+			return nil, err
+		}
+		return duration.FromS3M(f)
+	case "xm":
+		f, err := xm.Read(r)
+		if err != nil {
+			return nil, err
+		}
+		return duration.FromXM(f)
+	case "it":
+		f, err := it.Read(r)
+		if err != nil {
+			return nil, err
+		}
+		return duration.FromIT(f)
+	}
+	return nil, ErrUnknownLength
+}
+
+// progress draws a line showing where the song is, redrawing it in place
+type progress struct {
+	w     io.Writer
+	drawn time.Time
+}
+
+// draw redraws the progress line, unless it was drawn very recently and `force` is false
+// `total` is zero while the length of the song is not known.
+func (p *progress) draw(order, numOrders, row int, elapsed, total time.Duration, paused, force bool) {
+	now := time.Now()
+	if !force && now.Sub(p.drawn) < progressInterval {
+		return
+	}
+	p.drawn = now
+
+	length := "--:--"
+	if total > 0 {
+		length = formatDuration(total)
+	}
+	state := "Playing"
+	if paused {
+		state = "Paused "
+	}
+	// the line is cleared to its end, as it can be shorter than the one before it
+	fmt.Fprintf(p.w, "\r%s - Order %d/%d, Row %d  %s / %s\x1b[K", state, order, numOrders-1, row,
+		formatDuration(elapsed), length)
+}
+
+// clear removes the progress line
+func (p *progress) clear() {
+	fmt.Fprint(p.w, "\r\x1b[K")
+}
+
+// formatDuration formats `d` as minutes and seconds
+func formatDuration(d time.Duration) string {
+	s := int(d / time.Second)
+	return fmt.Sprintf("%d:%02d", s/60, s%60)
+}
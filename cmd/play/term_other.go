@@ -0,0 +1,18 @@
+//go:build !linux && !darwin
+
+package main
+
+import (
+	"errors"
+	"os"
+)
+
+// isTerminal returns true when `f` is a terminal, which it never is as far as this platform is concerned
+func isTerminal(f *os.File) bool {
+	return false
+}
+
+// makeRaw is not supported on this platform, so there are no keyboard controls
+func makeRaw(f *os.File) (func(), error) {
+	return nil, errors.New("keyboard controls are not supported on this platform")
+}
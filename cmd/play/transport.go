@@ -0,0 +1,43 @@
+package main
+
+import (
+	"io"
+)
+
+// key is a keyboard control
+type key int
+
+const (
+	keyPause = key(iota)
+	keyNextOrder
+	keyPrevOrder
+	keyRestart
+	keyQuit
+)
+
+// keyHelp describes the keyboard controls
+const keyHelp = "Space pause, Left/Right previous/next order, R restart, Q quit"
+
+// readKeys reads the keyboard controls pressed on the terminal `r` and sends them to `keys`, until `r` fails
+func readKeys(r io.Reader, keys chan<- key) {
+	buf := make([]byte, 16)
+	for {
+		n, err := r.Read(buf)
+		if err != nil {
+			return
+		}
+		// a key that sends an escape sequence arrives in one read
+		switch string(buf[:n]) {
+		case " ", "p", "P":
+			keys <- keyPause
+		case "\x1b[C":
+			keys <- keyNextOrder
+		case "\x1b[D":
+			keys <- keyPrevOrder
+		case "r", "R", "\x1b[H", "\x1b[1~":
+			keys <- keyRestart
+		case "q", "Q", "\x1b":
+			keys <- keyQuit
+		}
+	}
+}
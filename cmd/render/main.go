@@ -0,0 +1,100 @@
+// Command render renders a tracked music module (MOD, S3M, XM or IT) to a WAV file
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gotracker/playback/voice/interpolation"
+
+	"github.com/eliasdaler/ebiten-tracker-demo/render"
+)
+
+func main() {
+	opts := render.DefaultOptions
+
+	output := flag.String("o", "", "output WAV file (default: input file name with a .wav extension)")
+	formatName := flag.String("format", "", "module format: mod, s3m, xm or it (default: from the file extension)")
+	flag.IntVar(&opts.SampleRate, "rate", opts.SampleRate, "sample rate in Hz")
+	flag.IntVar(&opts.Channels, "channels", opts.Channels, "number of output channels: 1, 2 or 4")
+	flag.IntVar(&opts.BitsPerSample, "bits", opts.BitsPerSample, "bits per sample: 8, 16, 24 or 32 (float)")
+	flag.IntVar(&opts.Loops, "loops", opts.Loops, "number of times the song repeats; negative loops until -max")
+	flag.DurationVar(&opts.FadeOut, "fade", opts.FadeOut, "fade-out length at the end of the render")
+	flag.DurationVar(&opts.MaxDuration, "max", opts.MaxDuration, "maximum length of the render (0 = until the song ends)")
+	interp := flag.String("interp", "linear", "sample interpolation: linear, nearest, cubic, sinc, a500 or a1200")
+	stems := flag.String("stems", "", "also write one WAV per stem next to the output: channel or instrument")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] module\n", filepath.Base(os.Args[0]))
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	stemMode := render.StemsNone
+	switch *stems {
+	case "":
+	case "channel":
+		stemMode = render.StemsChannel
+	case "instrument":
+		stemMode = render.StemsInstrument
+	default:
+		log.Fatalf("unknown stem mode %q", *stems)
+	}
+	mode, err := interpolation.Parse(*interp)
+	if err != nil {
+		log.Fatalf("%v: %q", err, *interp)
+	}
+	opts.Interpolation = mode
+	input := flag.Arg(0)
+
+	if *formatName == "" {
+		*formatName = strings.ToLower(strings.TrimPrefix(filepath.Ext(input), "."))
+	}
+	if *output == "" {
+		*output = strings.TrimSuffix(input, filepath.Ext(input)) + ".wav"
+	}
+
+	data, err := os.ReadFile(input)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	player, err := render.Load(*formatName, data, opts)
+	if err != nil {
+		log.Fatalf("%s: %v", input, err)
+	}
+
+	r, err := render.NewRenderer(player, opts)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	f, err := os.Create(*output)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	// the files are written directly, so that the WAV sizes can be filled in at the end
+	if stemMode == render.StemsNone {
+		err = render.WriteWAV(f, r)
+	} else {
+		base := strings.TrimSuffix(*output, filepath.Ext(*output))
+		err = render.WriteStemsWAV(f, func(name string) (io.WriteCloser, error) {
+			return os.Create(base + "_" + name + ".wav")
+		}, stemMode, r)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("%s: rendered %v to %s", input, r.Elapsed(), *output)
+}
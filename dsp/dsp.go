@@ -0,0 +1,71 @@
+// Package dsp processes mixed audio on its way to the output, as a chain of stages that each work on
+// interleaved floating-point samples in place, before they are quantized
+// Every stage can be set up and bypassed while the audio plays. The stages do not depend on an audio device,
+// so they can be run and tested offline.
+package dsp
+
+import "strings"
+
+// Processor is a stage of audio processing
+type Processor interface {
+	// Process processes the interleaved samples in `buf` in place
+	Process(buf []float32)
+	// Reset forgets the audio processed so far, such as the tail of a reverb
+	Reset()
+}
+
+// Stage is a processor in a chain
+type Stage struct {
+	Name      string
+	Processor Processor
+	// Bypass lets the audio through the stage untouched
+	Bypass bool
+}
+
+// Chain runs audio through its stages in order
+type Chain struct {
+	stages []*Stage
+}
+
+// NewChain creates an empty chain, which lets audio through untouched
+func NewChain() *Chain {
+	return &Chain{}
+}
+
+// Add adds processor `p` to the end of the chain, under `name`, and returns its stage
+func (c *Chain) Add(name string, p Processor) *Stage {
+	s := &Stage{Name: name, Processor: p}
+	c.stages = append(c.stages, s)
+	return s
+}
+
+// Stages returns the stages of the chain, in order
+func (c *Chain) Stages() []*Stage {
+	return c.stages
+}
+
+// Stage returns the stage called `name`, ignoring case, or nil if there is none
+func (c *Chain) Stage(name string) *Stage {
+	for _, s := range c.stages {
+		if strings.EqualFold(s.Name, name) {
+			return s
+		}
+	}
+	return nil
+}
+
+// Process runs the interleaved samples in `buf` through every stage that is not bypassed
+func (c *Chain) Process(buf []float32) {
+	for _, s := range c.stages {
+		if !s.Bypass {
+			s.Processor.Process(buf)
+		}
+	}
+}
+
+// Reset resets every stage, for when the audio that follows does not carry on from the audio before it
+func (c *Chain) Reset() {
+	for _, s := range c.stages {
+		s.Processor.Reset()
+	}
+}
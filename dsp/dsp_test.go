@@ -0,0 +1,221 @@
+package dsp
+
+import (
+	"math"
+	"testing"
+)
+
+const testSampleRate = 44100
+
+// stereoSine returns `n` frames of a sine at `freq` Hz and amplitude `amp` on both channels of stereo audio
+func stereoSine(freq, amp float64, n int) []float32 {
+	samples := make([]float32, 2*n)
+	for i := 0; i < n; i++ {
+		v := float32(amp * math.Sin(2*math.Pi*freq*float64(i)/testSampleRate))
+		samples[2*i], samples[2*i+1] = v, v
+	}
+	return samples
+}
+
+func peak(samples []float32) float64 {
+	var p float64
+	for _, v := range samples {
+		p = math.Max(p, math.Abs(float64(v)))
+	}
+	return p
+}
+
+func rms(samples []float32) float64 {
+	var sum float64
+	for _, v := range samples {
+		sum += float64(v) * float64(v)
+	}
+	return math.Sqrt(sum / float64(len(samples)))
+}
+
+func TestChainBypass(t *testing.T) {
+	c := NewChain()
+	c.Add("clip", NewSoftClipper())
+	c.Add("width", NewWidth(2)).Bypass = true
+
+	in := stereoSine(440, 2, 1000)
+	buf := append([]float32(nil), in...)
+	c.Stage("CLIP").Bypass = true
+	c.Process(buf)
+	for i := range buf {
+		if buf[i] != in[i] {
+			t.Fatalf("sample %d: got %v through bypassed stages, want %v", i, buf[i], in[i])
+		}
+	}
+
+	c.Stage("clip").Bypass = false
+	c.Process(buf)
+	if p := peak(buf); p >= 1 {
+		t.Fatalf("peak %v with the soft clipper on, want under 1", p)
+	}
+	if c.Stage("reverb") != nil {
+		t.Fatal("found a stage that was never added")
+	}
+}
+
+func TestLimiterCeiling(t *testing.T) {
+	l := NewLimiter(testSampleRate, 2)
+	buf := stereoSine(100, 4, testSampleRate/2)
+	l.Process(buf)
+	if p := peak(buf); p > DefaultCeiling+1e-6 {
+		t.Fatalf("peak %v, want at most %v", p, DefaultCeiling)
+	}
+	if l.Gain() > 0.5 {
+		t.Fatalf("gain %v while limiting a sine 12 dB over full scale", l.Gain())
+	}
+}
+
+func TestLimiterLeavesQuietAudio(t *testing.T) {
+	l := NewLimiter(testSampleRate, 2)
+	in := stereoSine(1000, 0.5, 4096)
+	buf := append([]float32(nil), in...)
+	l.Process(buf)
+	for i := range buf {
+		if buf[i] != in[i] {
+			t.Fatalf("sample %d: got %v, want %v", i, buf[i], in[i])
+		}
+	}
+}
+
+func TestLimiterRelease(t *testing.T) {
+	l := NewLimiter(testSampleRate, 2)
+	l.Process(stereoSine(100, 4, 4410))
+	l.Process(make([]float32, 2*testSampleRate))
+	if g := l.Gain(); g < 0.99 {
+		t.Fatalf("gain %v a second after the peak, want back near 1", g)
+	}
+}
+
+func TestSoftClipper(t *testing.T) {
+	s := NewSoftClipper()
+	quiet := stereoSine(1000, 0.05, 1000)
+	buf := append([]float32(nil), quiet...)
+	s.Process(buf)
+	for i := range buf {
+		if math.Abs(float64(buf[i]-quiet[i])) > 1e-4 {
+			t.Fatalf("sample %d: got %v from quiet audio, want about %v", i, buf[i], quiet[i])
+		}
+	}
+
+	buf = stereoSine(1000, 10, 1000)
+	s.Process(buf)
+	if p := peak(buf); p > 1 {
+		t.Fatalf("peak %v, want at most 1", p)
+	}
+}
+
+// eqGain returns how much `e` changes the level of a sine at `freq` Hz, in dB
+func eqGain(e *EQ, freq float64) float64 {
+	e.Reset()
+	in := stereoSine(freq, 0.25, testSampleRate/2)
+	buf := append([]float32(nil), in...)
+	e.Process(buf)
+	// leave out the start, where the filters settle
+	skip := len(buf) / 4
+	return 20 * math.Log10(rms(buf[skip:])/rms(in[skip:]))
+}
+
+func TestEQFlat(t *testing.T) {
+	e := NewEQ(testSampleRate, 2)
+	for _, freq := range []float64{50, 1000, 12000} {
+		if g := eqGain(e, freq); math.Abs(g) > 0.01 {
+			t.Fatalf("%v Hz: flat EQ changed the level by %v dB", freq, g)
+		}
+	}
+}
+
+func TestEQBands(t *testing.T) {
+	e := NewEQ(testSampleRate, 2)
+	tests := []struct {
+		low, mid, high float64
+		freq           float64
+		want           float64
+	}{
+		{low: 12, freq: 40, want: 12},
+		{low: 12, freq: 12000, want: 0},
+		{mid: -6, freq: MidFrequency, want: -6},
+		{high: 6, freq: 16000, want: 6},
+		{high: 6, freq: 60, want: 0},
+	}
+	for _, tt := range tests {
+		e.SetGains(tt.low, tt.mid, tt.high)
+		if g := eqGain(e, tt.freq); math.Abs(g-tt.want) > 0.5 {
+			t.Errorf("gains %v/%v/%v at %v Hz: got %.2f dB, want %v dB", tt.low, tt.mid, tt.high, tt.freq, g,
+				tt.want)
+		}
+	}
+}
+
+func TestReverbTail(t *testing.T) {
+	r := NewReverb(testSampleRate, 2)
+	r.Mix = 1
+	buf := make([]float32, 2*testSampleRate)
+	buf[0], buf[1] = 1, 1
+	r.Process(buf)
+
+	tail := buf[testSampleRate/2:]
+	if rms(tail) == 0 {
+		t.Fatal("no reverb tail half a second after an impulse")
+	}
+	// the channels have delays of their own, so the reverb spreads across them
+	same := true
+	for i := 0; i < len(tail); i += 2 {
+		if tail[i] != tail[i+1] {
+			same = false
+			break
+		}
+	}
+	if same {
+		t.Fatal("the reverb tail is the same on both channels")
+	}
+
+	r.Reset()
+	silence := make([]float32, 2*1000)
+	r.Process(silence)
+	if p := peak(silence); p != 0 {
+		t.Fatalf("peak %v after Reset, want silence", p)
+	}
+}
+
+func TestReverbDry(t *testing.T) {
+	r := NewReverb(testSampleRate, 2)
+	r.Mix = 0
+	in := stereoSine(440, 0.5, 4096)
+	buf := append([]float32(nil), in...)
+	r.Process(buf)
+	for i := range buf {
+		if buf[i] != in[i] {
+			t.Fatalf("sample %d: got %v with no reverb mixed in, want %v", i, buf[i], in[i])
+		}
+	}
+}
+
+func TestWidth(t *testing.T) {
+	w := NewWidth(2)
+	buf := []float32{1, 0, 0.5, -0.5}
+
+	w.Amount = 1
+	w.Process(buf)
+	if buf[0] != 1 || buf[1] != 0 || buf[2] != 0.5 || buf[3] != -0.5 {
+		t.Fatalf("width 1 changed the audio: %v", buf)
+	}
+
+	w.Amount = 0
+	w.Process(buf)
+	if buf[0] != buf[1] || buf[2] != buf[3] {
+		t.Fatalf("width 0 left the channels apart: %v", buf)
+	}
+
+	mono := NewWidth(1)
+	mono.Amount = 0
+	buf = []float32{1, -1}
+	mono.Process(buf)
+	if buf[0] != 1 || buf[1] != -1 {
+		t.Fatalf("width changed mono audio: %v", buf)
+	}
+}
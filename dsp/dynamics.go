@@ -0,0 +1,103 @@
+package dsp
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// DefaultCeiling is the level the limiter keeps the output under, a little below full scale
+	DefaultCeiling = 0.98
+	// DefaultRelease is how long the limiter takes to come back up after a peak
+	DefaultRelease = 100 * time.Millisecond
+)
+
+// Limiter is a brickwall limiter: it turns the audio down straight away when a frame would go over the
+// ceiling, and lets it back up slowly once it is quiet again
+// It has no lookahead, so it adds no latency.
+type Limiter struct {
+	// Ceiling is the highest level a sample can have, where 1 is full scale
+	Ceiling float32
+
+	channels     int
+	sampleRate   int
+	releaseCoeff float32
+	gain         float32
+}
+
+// NewLimiter creates a limiter for audio at `sampleRate` frames per second in `channels` channels
+func NewLimiter(sampleRate, channels int) *Limiter {
+	l := &Limiter{
+		Ceiling:    DefaultCeiling,
+		channels:   channels,
+		sampleRate: sampleRate,
+		gain:       1,
+	}
+	l.SetRelease(DefaultRelease)
+	return l
+}
+
+// SetRelease sets how long the limiter takes to come back up after a peak
+func (l *Limiter) SetRelease(d time.Duration) {
+	frames := d.Seconds() * float64(l.sampleRate)
+	if frames < 1 {
+		frames = 1
+	}
+	l.releaseCoeff = float32(1 - math.Exp(-1/frames))
+}
+
+// Gain returns how much the limiter is turning the audio down right now, where 1 is not at all
+func (l *Limiter) Gain() float32 {
+	return l.gain
+}
+
+// Process limits the interleaved samples in `buf` in place
+func (l *Limiter) Process(buf []float32) {
+	for i := 0; i+l.channels <= len(buf); i += l.channels {
+		frame := buf[i : i+l.channels]
+		var peak float32
+		for _, v := range frame {
+			if v < 0 {
+				v = -v
+			}
+			if v > peak {
+				peak = v
+			}
+		}
+
+		l.gain += (1 - l.gain) * l.releaseCoeff
+		if peak*l.gain > l.Ceiling {
+			l.gain = l.Ceiling / peak
+		}
+		for c := range frame {
+			frame[c] *= l.gain
+		}
+	}
+}
+
+// Reset lets the gain back up to 1
+func (l *Limiter) Reset() {
+	l.gain = 1
+}
+
+// SoftClipper rounds off the peaks of the audio with a tanh curve, which distorts loud parts gently instead
+// of clipping them hard, and leaves quiet parts nearly as they are
+type SoftClipper struct {
+	// Drive is how hard the audio is pushed into the curve; 1 leaves quiet audio at its own level
+	Drive float32
+}
+
+// NewSoftClipper creates a soft clipper with a drive of 1
+func NewSoftClipper() *SoftClipper {
+	return &SoftClipper{Drive: 1}
+}
+
+// Process rounds off the interleaved samples in `buf` in place
+func (s *SoftClipper) Process(buf []float32) {
+	for i, v := range buf {
+		buf[i] = float32(math.Tanh(float64(v * s.Drive)))
+	}
+}
+
+// Reset does nothing, as the soft clipper has no state
+func (s *SoftClipper) Reset() {}
@@ -0,0 +1,118 @@
+package dsp
+
+import "math"
+
+const (
+	// LowFrequency is the frequency under which the low band of the EQ works, in Hz
+	LowFrequency = 200
+	// MidFrequency is the center of the mid band of the EQ, in Hz
+	MidFrequency = 1000
+	// HighFrequency is the frequency over which the high band of the EQ works, in Hz
+	HighFrequency = 5000
+)
+
+// biquad is a second-order filter section, with the coefficients normalized so that a0 is 1
+type biquad struct {
+	b0, b1, b2, a1, a2 float64
+}
+
+// biquadState is the state of a biquad on one channel
+type biquadState struct {
+	x1, x2, y1, y2 float64
+}
+
+func (f *biquad) process(s *biquadState, x float64) float64 {
+	y := f.b0*x + f.b1*s.x1 + f.b2*s.x2 - f.a1*s.y1 - f.a2*s.y2
+	s.x2, s.x1 = s.x1, x
+	s.y2, s.y1 = s.y1, y
+	return y
+}
+
+// set normalizes and stores the coefficients
+func (f *biquad) set(b0, b1, b2, a0, a1, a2 float64) {
+	f.b0, f.b1, f.b2 = b0/a0, b1/a0, b2/a0
+	f.a1, f.a2 = a1/a0, a2/a0
+}
+
+// The filters below follow the shelf and peaking designs of the Audio EQ Cookbook.
+
+func (f *biquad) lowShelf(freq, gainDB float64, sampleRate int) {
+	a := math.Pow(10, gainDB/40)
+	w := 2 * math.Pi * freq / float64(sampleRate)
+	cos, alpha := math.Cos(w), math.Sin(w)/math.Sqrt2
+	sq := 2 * math.Sqrt(a) * alpha
+	f.set(a*((a+1)-(a-1)*cos+sq), 2*a*((a-1)-(a+1)*cos), a*((a+1)-(a-1)*cos-sq),
+		(a+1)+(a-1)*cos+sq, -2*((a-1)+(a+1)*cos), (a+1)+(a-1)*cos-sq)
+}
+
+func (f *biquad) highShelf(freq, gainDB float64, sampleRate int) {
+	a := math.Pow(10, gainDB/40)
+	w := 2 * math.Pi * freq / float64(sampleRate)
+	cos, alpha := math.Cos(w), math.Sin(w)/math.Sqrt2
+	sq := 2 * math.Sqrt(a) * alpha
+	f.set(a*((a+1)+(a-1)*cos+sq), -2*a*((a-1)+(a+1)*cos), a*((a+1)+(a-1)*cos-sq),
+		(a+1)-(a-1)*cos+sq, 2*((a-1)-(a+1)*cos), (a+1)-(a-1)*cos-sq)
+}
+
+func (f *biquad) peaking(freq, gainDB, q float64, sampleRate int) {
+	a := math.Pow(10, gainDB/40)
+	w := 2 * math.Pi * freq / float64(sampleRate)
+	cos, alpha := math.Cos(w), math.Sin(w)/(2*q)
+	f.set(1+alpha*a, -2*cos, 1-alpha*a, 1+alpha/a, -2*cos, 1-alpha/a)
+}
+
+// EQ is a 3-band equalizer: a low shelf, a peak in the middle and a high shelf
+type EQ struct {
+	sampleRate int
+	channels   int
+
+	low, mid, high float64
+	bands          [3]biquad
+	// state holds the state of the three bands of every channel
+	state [][3]biquadState
+}
+
+// NewEQ creates a flat EQ for audio at `sampleRate` frames per second in `channels` channels
+func NewEQ(sampleRate, channels int) *EQ {
+	e := &EQ{
+		sampleRate: sampleRate,
+		channels:   channels,
+		state:      make([][3]biquadState, channels),
+	}
+	e.SetGains(0, 0, 0)
+	return e
+}
+
+// SetGains sets how much the low, mid and high bands are turned up, in dB; negative turns them down
+func (e *EQ) SetGains(low, mid, high float64) {
+	e.low, e.mid, e.high = low, mid, high
+	e.bands[0].lowShelf(LowFrequency, low, e.sampleRate)
+	e.bands[1].peaking(MidFrequency, mid, 0.7, e.sampleRate)
+	e.bands[2].highShelf(HighFrequency, high, e.sampleRate)
+}
+
+// Gains returns how much the low, mid and high bands are turned up, in dB
+func (e *EQ) Gains() (low, mid, high float64) {
+	return e.low, e.mid, e.high
+}
+
+// Process equalizes the interleaved samples in `buf` in place
+func (e *EQ) Process(buf []float32) {
+	for i := 0; i+e.channels <= len(buf); i += e.channels {
+		for c := 0; c < e.channels; c++ {
+			v := float64(buf[i+c])
+			st := &e.state[c]
+			for b := range e.bands {
+				v = e.bands[b].process(&st[b], v)
+			}
+			buf[i+c] = float32(v)
+		}
+	}
+}
+
+// Reset clears the filter state of every channel
+func (e *EQ) Reset() {
+	for i := range e.state {
+		e.state[i] = [3]biquadState{}
+	}
+}
@@ -0,0 +1,139 @@
+package dsp
+
+// The reverb is a Freeverb-style design: parallel feedback comb filters with damping, followed by allpass
+// filters in series, with slightly longer delays on every channel after the first for a stereo spread.
+
+// combTunings and allpassTunings are the delay lengths of the filters at 44100 Hz, in frames
+var (
+	combTunings    = [...]int{1116, 1188, 1277, 1356, 1422, 1491, 1557, 1617}
+	allpassTunings = [...]int{556, 441, 341, 225}
+)
+
+const (
+	// stereoSpread is how much longer the delays of every next channel are, at 44100 Hz
+	stereoSpread = 23
+	// reverbInputGain keeps the sum of the combs in range
+	reverbInputGain = 0.015
+	allpassFeedback = 0.5
+)
+
+type comb struct {
+	buf    []float32
+	pos    int
+	filter float32
+}
+
+func (c *comb) process(x, feedback, damp float32) float32 {
+	y := c.buf[c.pos]
+	c.filter = y*(1-damp) + c.filter*damp
+	c.buf[c.pos] = x + c.filter*feedback
+	if c.pos++; c.pos >= len(c.buf) {
+		c.pos = 0
+	}
+	return y
+}
+
+type allpass struct {
+	buf []float32
+	pos int
+}
+
+func (a *allpass) process(x float32) float32 {
+	delayed := a.buf[a.pos]
+	a.buf[a.pos] = x + delayed*allpassFeedback
+	if a.pos++; a.pos >= len(a.buf) {
+		a.pos = 0
+	}
+	return delayed - x
+}
+
+type reverbChannel struct {
+	combs     [len(combTunings)]comb
+	allpasses [len(allpassTunings)]allpass
+}
+
+// Reverb adds the sound of a room to the audio
+type Reverb struct {
+	// RoomSize goes from 0 (a small room with a short tail) to 1 (a large hall)
+	RoomSize float32
+	// Damping goes from 0 (bright) to 1 (the tail loses its high frequencies quickly)
+	Damping float32
+	// Mix is how much of the reverb is heard, from 0 (none) to 1 (only the reverb)
+	Mix float32
+
+	channels []reverbChannel
+}
+
+// NewReverb creates a reverb for audio at `sampleRate` frames per second in `channels` channels
+func NewReverb(sampleRate, channels int) *Reverb {
+	r := &Reverb{
+		RoomSize: 0.5,
+		Damping:  0.5,
+		Mix:      0.2,
+		channels: make([]reverbChannel, channels),
+	}
+	scale := func(frames int) int {
+		n := frames * sampleRate / 44100
+		if n < 1 {
+			n = 1
+		}
+		return n
+	}
+	for c := range r.channels {
+		ch := &r.channels[c]
+		for i, t := range combTunings {
+			ch.combs[i].buf = make([]float32, scale(t+c*stereoSpread))
+		}
+		for i, t := range allpassTunings {
+			ch.allpasses[i].buf = make([]float32, scale(t+c*stereoSpread))
+		}
+	}
+	return r
+}
+
+// Process adds the reverb to the interleaved samples in `buf` in place
+func (r *Reverb) Process(buf []float32) {
+	feedback := 0.7 + 0.28*r.RoomSize
+	damp := 0.4 * r.Damping
+	n := len(r.channels)
+	for i := 0; i+n <= len(buf); i += n {
+		// every channel gets the same mono input, and its own delays make it sound different
+		var in float32
+		for c := 0; c < n; c++ {
+			in += buf[i+c]
+		}
+		in *= reverbInputGain / float32(n)
+
+		for c := range r.channels {
+			ch := &r.channels[c]
+			var wet float32
+			for k := range ch.combs {
+				wet += ch.combs[k].process(in, feedback, damp)
+			}
+			for k := range ch.allpasses {
+				wet = ch.allpasses[k].process(wet)
+			}
+			buf[i+c] = buf[i+c]*(1-r.Mix) + wet*r.Mix
+		}
+	}
+}
+
+// Reset cuts the tail of the reverb off
+func (r *Reverb) Reset() {
+	for c := range r.channels {
+		ch := &r.channels[c]
+		for k := range ch.combs {
+			clear32(ch.combs[k].buf)
+			ch.combs[k].filter = 0
+		}
+		for k := range ch.allpasses {
+			clear32(ch.allpasses[k].buf)
+		}
+	}
+}
+
+func clear32(buf []float32) {
+	for i := range buf {
+		buf[i] = 0
+	}
+}
@@ -0,0 +1,30 @@
+package dsp
+
+// Width changes how wide stereo audio sounds by scaling the difference between its two channels
+// It leaves audio that is not stereo alone.
+type Width struct {
+	// Amount is 0 for mono, 1 to leave the audio as it is, and more than 1 to make it wider
+	Amount float32
+
+	channels int
+}
+
+// NewWidth creates a width control for audio in `channels` channels, which leaves it as it is
+func NewWidth(channels int) *Width {
+	return &Width{Amount: 1, channels: channels}
+}
+
+// Process changes the width of the interleaved samples in `buf` in place
+func (w *Width) Process(buf []float32) {
+	if w.channels != 2 {
+		return
+	}
+	for i := 0; i+1 < len(buf); i += 2 {
+		mid := (buf[i] + buf[i+1]) / 2
+		side := (buf[i] - buf[i+1]) / 2 * w.Amount
+		buf[i], buf[i+1] = mid+side, mid-side
+	}
+}
+
+// Reset does nothing, as the width control has no state
+func (w *Width) Reset() {}
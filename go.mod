@@ -4,16 +4,19 @@ go 1.19
 
 replace github.com/gotracker/goaudiofile => ./goaudiofile
 
+replace github.com/gotracker/playback => ./playback
+
 require (
+	github.com/gotracker/goaudiofile v1.0.14
 	github.com/gotracker/gomixing v1.3.0
 	github.com/gotracker/playback v0.2.7
 	github.com/hajimehoshi/ebiten/v2 v2.4.13
+	golang.org/x/sys v0.0.0-20220818161305-2296e01440c6
 )
 
 require (
 	github.com/ebitengine/purego v0.0.0-20220905075623-aeed57cda744 // indirect
 	github.com/go-gl/glfw/v3.3/glfw v0.0.0-20220806181222-55e207c401ad // indirect
-	github.com/gotracker/goaudiofile v1.0.14 // indirect
 	github.com/gotracker/opl2 v1.0.1 // indirect
 	github.com/hajimehoshi/file2byteslice v0.0.0-20210813153925-5340248a8f41 // indirect
 	github.com/hajimehoshi/oto/v2 v2.3.1 // indirect
@@ -25,5 +28,4 @@ require (
 	golang.org/x/exp/shiny v0.0.0-20221126150942-6ab00d035af9 // indirect
 	golang.org/x/image v0.1.0 // indirect
 	golang.org/x/mobile v0.0.0-20220722155234-aaac322e2105 // indirect
-	golang.org/x/sys v0.0.0-20220818161305-2296e01440c6 // indirect
 )
@@ -0,0 +1,236 @@
+package aiff
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+)
+
+var (
+	// ErrInvalidFileFormat is for when an invalid file format is encountered
+	ErrInvalidFileFormat = errors.New("invalid file format")
+	// ErrUnsupportedCompression is for when an AIFF-C file uses a compression type other than raw PCM
+	ErrUnsupportedCompression = errors.New("unsupported aiff-c compression type")
+)
+
+// CommonChunk is a representation of the AIFF COMM chunk
+type CommonChunk struct {
+	Channels      int16
+	Frames        uint32
+	BitsPerSample int16
+	SampleRate    float64
+}
+
+// PlayMode is the way an instrument loop is played
+type PlayMode int16
+
+const (
+	// PlayModeNone is no loop
+	PlayModeNone = PlayMode(0)
+	// PlayModeForward plays the loop from begin to end, then repeats from begin
+	PlayModeForward = PlayMode(1)
+	// PlayModeForwardBackward plays the loop from begin to end, then back from end to begin, and so on
+	PlayModeForwardBackward = PlayMode(2)
+)
+
+// Loop is an instrument loop, resolved from its markers into frame positions
+// The End position is exclusive (it is the first frame after the loop)
+type Loop struct {
+	PlayMode PlayMode
+	Begin    int
+	End      int
+}
+
+// File is an AIFF internal file representation
+// Data is interleaved, signed, big-endian PCM as stored in the SSND chunk (or little-endian for `sowt` AIFF-C files)
+type File struct {
+	Common       CommonChunk
+	LittleEndian bool
+	Data         []byte
+	Name         string
+	BaseNote     int8
+	SustainLoop  Loop
+	ReleaseLoop  Loop
+}
+
+type instrumentLoop struct {
+	PlayMode    PlayMode
+	BeginMarker int16
+	EndMarker   int16
+}
+
+type instrumentChunk struct {
+	BaseNote     int8
+	Detune       int8
+	LowNote      int8
+	HighNote     int8
+	LowVelocity  int8
+	HighVelocity int8
+	Gain         int16
+	SustainLoop  instrumentLoop
+	ReleaseLoop  instrumentLoop
+}
+
+// Read reads an AIFF or AIFF-C file from the reader `r` and creates an internal File representation
+func Read(r io.Reader) (*File, error) {
+	var form struct {
+		ID   [4]byte
+		Size uint32
+		Type [4]byte
+	}
+	if err := binary.Read(r, binary.BigEndian, &form); err != nil {
+		return nil, err
+	}
+	formType := string(form.Type[:])
+	if string(form.ID[:]) != "FORM" || (formType != "AIFF" && formType != "AIFC") {
+		return nil, ErrInvalidFileFormat
+	}
+
+	f := File{}
+	markers := make(map[int16]int)
+	var inst *instrumentChunk
+	haveComm := false
+	haveData := false
+	for {
+		var ch struct {
+			ID   [4]byte
+			Size uint32
+		}
+		if err := binary.Read(r, binary.BigEndian, &ch); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+
+		payload := make([]byte, int(ch.Size))
+		if _, err := io.ReadFull(r, payload); err != nil {
+			return nil, err
+		}
+		if ch.Size%2 != 0 {
+			var pad [1]byte
+			_, _ = r.Read(pad[:])
+		}
+
+		switch string(ch.ID[:]) {
+		case "COMM":
+			if err := readCommon(&f, payload, formType == "AIFC"); err != nil {
+				return nil, err
+			}
+			haveComm = true
+		case "SSND":
+			if len(payload) < 8 {
+				return nil, ErrInvalidFileFormat
+			}
+			offset := int(binary.BigEndian.Uint32(payload))
+			if 8+offset > len(payload) {
+				return nil, ErrInvalidFileFormat
+			}
+			f.Data = payload[8+offset:]
+			haveData = true
+		case "MARK":
+			readMarkers(markers, payload)
+		case "INST":
+			inst = &instrumentChunk{}
+			if err := binary.Read(bytes.NewReader(payload), binary.BigEndian, inst); err != nil {
+				return nil, err
+			}
+		case "NAME":
+			f.Name = string(bytes.TrimRight(payload, "\x00"))
+		}
+	}
+
+	if !haveComm || !haveData {
+		return nil, ErrInvalidFileFormat
+	}
+
+	if inst != nil {
+		f.BaseNote = inst.BaseNote
+		f.SustainLoop = resolveLoop(inst.SustainLoop, markers)
+		f.ReleaseLoop = resolveLoop(inst.ReleaseLoop, markers)
+	}
+
+	return &f, nil
+}
+
+func readCommon(f *File, payload []byte, isAIFC bool) error {
+	var comm struct {
+		Channels      int16
+		Frames        uint32
+		BitsPerSample int16
+		SampleRate    [10]byte
+	}
+	r := bytes.NewReader(payload)
+	if err := binary.Read(r, binary.BigEndian, &comm); err != nil {
+		return err
+	}
+	f.Common = CommonChunk{
+		Channels:      comm.Channels,
+		Frames:        comm.Frames,
+		BitsPerSample: comm.BitsPerSample,
+		SampleRate:    extendedToFloat64(comm.SampleRate),
+	}
+
+	if isAIFC {
+		var compression [4]byte
+		if err := binary.Read(r, binary.BigEndian, &compression); err != nil {
+			return err
+		}
+		switch string(compression[:]) {
+		case "NONE", "twos":
+		case "sowt":
+			f.LittleEndian = true
+		default:
+			return ErrUnsupportedCompression
+		}
+	}
+	return nil
+}
+
+func readMarkers(markers map[int16]int, payload []byte) {
+	if len(payload) < 2 {
+		return
+	}
+	count := int(binary.BigEndian.Uint16(payload))
+	pos := 2
+	for i := 0; i < count && pos+7 <= len(payload); i++ {
+		id := int16(binary.BigEndian.Uint16(payload[pos:]))
+		markers[id] = int(binary.BigEndian.Uint32(payload[pos+2:]))
+		// the marker name is a pascal string, padded to an even total length
+		nameLen := int(payload[pos+6])
+		pos += 6 + 1 + nameLen
+		if (1+nameLen)%2 != 0 {
+			pos++
+		}
+	}
+}
+
+func resolveLoop(l instrumentLoop, markers map[int16]int) Loop {
+	begin, okBegin := markers[l.BeginMarker]
+	end, okEnd := markers[l.EndMarker]
+	if l.PlayMode == PlayModeNone || !okBegin || !okEnd || end <= begin {
+		return Loop{}
+	}
+	return Loop{
+		PlayMode: l.PlayMode,
+		Begin:    begin,
+		End:      end,
+	}
+}
+
+// extendedToFloat64 converts an 80-bit IEEE 754 extended precision value into a float64
+func extendedToFloat64(b [10]byte) float64 {
+	exp := int(binary.BigEndian.Uint16(b[0:]))
+	mantissa := binary.BigEndian.Uint64(b[2:])
+	sign := 1.0
+	if exp&0x8000 != 0 {
+		sign = -1
+		exp &= 0x7FFF
+	}
+	if exp == 0 && mantissa == 0 {
+		return 0
+	}
+	return sign * math.Ldexp(float64(mantissa), exp-16383-63)
+}
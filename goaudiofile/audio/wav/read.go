@@ -0,0 +1,127 @@
+package wav
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/gotracker/goaudiofile/internal/util"
+)
+
+var (
+	// ErrInvalidFileFormat is for when an invalid file format is encountered
+	ErrInvalidFileFormat = errors.New("invalid file format")
+)
+
+// formatExtensible is the format tag of WAVE_FORMAT_EXTENSIBLE, where the actual format is in the sub-format GUID
+const formatExtensible = Format(0xFFFE)
+
+// Read reads a WAV file from the reader `r` and creates an internal File representation
+func Read(r io.Reader) (*File, error) {
+	var riff struct {
+		ID   [4]byte
+		Size uint32
+		Type [4]byte
+	}
+	if err := binary.Read(r, binary.LittleEndian, &riff); err != nil {
+		return nil, err
+	}
+	if string(riff.ID[:]) != "RIFF" || string(riff.Type[:]) != "WAVE" {
+		return nil, ErrInvalidFileFormat
+	}
+
+	f := File{}
+	haveFmt := false
+	haveData := false
+	for {
+		var ch struct {
+			ID   [4]byte
+			Size uint32
+		}
+		if err := binary.Read(r, binary.LittleEndian, &ch); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+
+		payload := make([]byte, int(ch.Size))
+		if _, err := io.ReadFull(r, payload); err != nil {
+			// streamed files are allowed to be truncated in the data chunk
+			if string(ch.ID[:]) != "data" || !errors.Is(err, io.ErrUnexpectedEOF) {
+				return nil, err
+			}
+		}
+		if ch.Size%2 != 0 {
+			var pad [1]byte
+			_, _ = r.Read(pad[:])
+		}
+
+		switch string(ch.ID[:]) {
+		case "fmt ":
+			if err := binary.Read(bytes.NewReader(payload), binary.LittleEndian, &f.Fmt); err != nil {
+				return nil, err
+			}
+			if f.Fmt.Format == formatExtensible && len(payload) >= 26 {
+				// the first two bytes of the sub-format GUID hold the real format tag
+				f.Fmt.Format = Format(binary.LittleEndian.Uint16(payload[24:]))
+			}
+			haveFmt = true
+		case "data":
+			f.Data = payload
+			haveData = true
+		case "smpl":
+			if err := readSamplerChunk(&f, payload); err != nil {
+				return nil, err
+			}
+		case "LIST":
+			readInfoList(&f, payload)
+		}
+	}
+
+	if !haveFmt || !haveData {
+		return nil, ErrInvalidFileFormat
+	}
+
+	return &f, nil
+}
+
+func readSamplerChunk(f *File, payload []byte) error {
+	r := bytes.NewReader(payload)
+	sc := SamplerChunk{}
+	if err := binary.Read(r, binary.LittleEndian, &sc); err != nil {
+		return err
+	}
+	f.Sampler = &sc
+
+	for i := uint32(0); i < sc.NumSampleLoops; i++ {
+		l := SampleLoop{}
+		if err := binary.Read(r, binary.LittleEndian, &l); err != nil {
+			return err
+		}
+		f.Loops = append(f.Loops, l)
+	}
+	return nil
+}
+
+func readInfoList(f *File, payload []byte) {
+	if len(payload) < 4 || string(payload[:4]) != "INFO" {
+		return
+	}
+
+	for pos := 4; pos+8 <= len(payload); {
+		var id InfoID
+		copy(id[:], payload[pos:])
+		size := int(binary.LittleEndian.Uint32(payload[pos+4:]))
+		pos += 8
+		if pos+size > len(payload) {
+			return
+		}
+		f.Info = append(f.Info, InfoEntry{
+			ID:    id,
+			Value: util.GetString(payload[pos : pos+size]),
+		})
+		pos += size + size%2
+	}
+}
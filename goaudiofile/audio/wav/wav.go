@@ -0,0 +1,260 @@
+package wav
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+)
+
+// Format is the WAVE format tag stored in the fmt chunk
+type Format uint16
+
+const (
+	// FormatPCM is integer PCM data
+	FormatPCM = Format(0x0001)
+	// FormatIEEEFloat is IEEE floating-point data
+	FormatIEEEFloat = Format(0x0003)
+)
+
+// FmtChunk is a representation of the WAV fmt chunk
+type FmtChunk struct {
+	Format        Format
+	Channels      uint16
+	SampleRate    uint32
+	ByteRate      uint32
+	BlockAlign    uint16
+	BitsPerSample uint16
+}
+
+// NewFmtChunk creates a fmt chunk with the derived fields (byte rate and block alignment) filled in
+func NewFmtChunk(format Format, channels int, sampleRate int, bitsPerSample int) FmtChunk {
+	blockAlign := channels * ((bitsPerSample + 7) / 8)
+	return FmtChunk{
+		Format:        format,
+		Channels:      uint16(channels),
+		SampleRate:    uint32(sampleRate),
+		ByteRate:      uint32(sampleRate * blockAlign),
+		BlockAlign:    uint16(blockAlign),
+		BitsPerSample: uint16(bitsPerSample),
+	}
+}
+
+// subFormatGUIDTail is the part of the sub-format GUID of a WAVE_FORMAT_EXTENSIBLE fmt chunk that follows
+// the format tag
+var subFormatGUIDTail = [14]byte{0x00, 0x00, 0x00, 0x00, 0x10, 0x00, 0x80, 0x00, 0x00, 0xAA, 0x00, 0x38, 0x9B, 0x71}
+
+// speakerMasks are the speaker positions of the usual channel layouts, for WAVE_FORMAT_EXTENSIBLE
+var speakerMasks = map[uint16]uint32{
+	1: 0x4,   // front center
+	2: 0x3,   // front left and right
+	4: 0x33,  // front and back left and right
+	6: 0x3F,  // 5.1
+	8: 0x63F, // 7.1
+}
+
+// isExtensible returns true if the fmt chunk has to be written as WAVE_FORMAT_EXTENSIBLE, which is the case
+// for samples of more than 16 bits or more than 2 channels
+func (c FmtChunk) isExtensible() bool {
+	return c.BitsPerSample > 16 || c.Channels > 2
+}
+
+// payload returns the fmt chunk as it is written to a file
+// Formats other than PCM have the size of their extension after the basic fields, even when it is empty.
+func (c FmtChunk) payload() []byte {
+	buf := &bytes.Buffer{}
+	if !c.isExtensible() {
+		_ = binary.Write(buf, binary.LittleEndian, &c)
+		if c.Format != FormatPCM {
+			_ = binary.Write(buf, binary.LittleEndian, uint16(0))
+		}
+		return buf.Bytes()
+	}
+
+	ext := c
+	ext.Format = formatExtensible
+	_ = binary.Write(buf, binary.LittleEndian, &ext)
+	_ = binary.Write(buf, binary.LittleEndian, &struct {
+		Size          uint16
+		ValidBits     uint16
+		ChannelMask   uint32
+		SubFormat     uint16
+		SubFormatTail [14]byte
+	}{
+		Size:          22,
+		ValidBits:     c.BitsPerSample,
+		ChannelMask:   speakerMasks[c.Channels],
+		SubFormat:     uint16(c.Format),
+		SubFormatTail: subFormatGUIDTail,
+	})
+	return buf.Bytes()
+}
+
+// LoopType is the type of a loop stored in the smpl chunk
+type LoopType uint32
+
+const (
+	// LoopTypeForward plays the loop from start to end, then repeats from start
+	LoopTypeForward = LoopType(0)
+	// LoopTypePingPong plays the loop from start to end, then back from end to start, and so on
+	LoopTypePingPong = LoopType(1)
+	// LoopTypeBackward plays the loop from end to start, then repeats from end
+	LoopTypeBackward = LoopType(2)
+)
+
+// SampleLoop is a representation of a single loop entry in the smpl chunk
+// Start and End are frame offsets, and End is the last frame played in the loop (inclusive)
+type SampleLoop struct {
+	CuePointID uint32
+	Type       LoopType
+	Start      uint32
+	End        uint32
+	Fraction   uint32
+	PlayCount  uint32
+}
+
+// SamplerChunk is a representation of the smpl chunk header
+type SamplerChunk struct {
+	Manufacturer      uint32
+	Product           uint32
+	SamplePeriod      uint32
+	MIDIUnityNote     uint32
+	MIDIPitchFraction uint32
+	SMPTEFormat       uint32
+	SMPTEOffset       uint32
+	NumSampleLoops    uint32
+	SamplerData       uint32
+}
+
+// InfoID is the identifier of an entry in the LIST/INFO chunk
+type InfoID [4]byte
+
+var (
+	// InfoName is the INFO entry for the name of the subject of the file
+	InfoName = InfoID{'I', 'N', 'A', 'M'}
+	// InfoSource is the INFO entry for the source the data originally came from
+	InfoSource = InfoID{'I', 'S', 'R', 'C'}
+	// InfoComment is the INFO entry for general comments
+	InfoComment = InfoID{'I', 'C', 'M', 'T'}
+	// InfoSoftware is the INFO entry for the software which created the file
+	InfoSoftware = InfoID{'I', 'S', 'F', 'T'}
+)
+
+// InfoEntry is a single entry in the LIST/INFO chunk
+type InfoEntry struct {
+	ID    InfoID
+	Value string
+}
+
+// File is a WAV internal file representation
+type File struct {
+	Fmt     FmtChunk
+	Data    []byte
+	Sampler *SamplerChunk
+	Loops   []SampleLoop
+	Info    []InfoEntry
+}
+
+// Frames returns the number of sample frames stored in the Data field
+func (f *File) Frames() int {
+	if f.Fmt.BlockAlign == 0 {
+		return 0
+	}
+	return len(f.Data) / int(f.Fmt.BlockAlign)
+}
+
+// GetInfo returns the value of the INFO entry `id`, or an empty string if there is none
+func (f *File) GetInfo(id InfoID) string {
+	for _, e := range f.Info {
+		if e.ID == id {
+			return e.Value
+		}
+	}
+	return ""
+}
+
+// Write writes the WAV file `f` to the writer `w`
+func Write(w io.Writer, f *File) error {
+	body := &bytes.Buffer{}
+	body.WriteString("WAVE")
+
+	if err := writeChunk(body, "fmt ", f.Fmt.payload()); err != nil {
+		return err
+	}
+
+	// files in formats other than PCM say how many frames they hold
+	if f.Fmt.Format != FormatPCM {
+		if err := writeChunk(body, "fact", uint32(f.Frames())); err != nil {
+			return err
+		}
+	}
+
+	if err := writeChunk(body, "data", f.Data); err != nil {
+		return err
+	}
+
+	if f.Sampler != nil || len(f.Loops) != 0 {
+		smpl := &bytes.Buffer{}
+		var sc SamplerChunk
+		if f.Sampler != nil {
+			sc = *f.Sampler
+		}
+		sc.NumSampleLoops = uint32(len(f.Loops))
+		sc.SamplerData = 0
+		if err := binary.Write(smpl, binary.LittleEndian, &sc); err != nil {
+			return err
+		}
+		for _, l := range f.Loops {
+			if err := binary.Write(smpl, binary.LittleEndian, &l); err != nil {
+				return err
+			}
+		}
+		if err := writeChunk(body, "smpl", smpl.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	if len(f.Info) != 0 {
+		list := &bytes.Buffer{}
+		list.WriteString("INFO")
+		for _, e := range f.Info {
+			// INFO strings are zero-terminated
+			v := append([]byte(e.Value), 0)
+			if err := writeChunk(list, string(e.ID[:]), v); err != nil {
+				return err
+			}
+		}
+		if err := writeChunk(body, "LIST", list.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	return writeChunk(w, "RIFF", body.Bytes())
+}
+
+func writeChunk(w io.Writer, id string, data any) error {
+	payload, ok := data.([]byte)
+	if !ok {
+		buf := &bytes.Buffer{}
+		if err := binary.Write(buf, binary.LittleEndian, data); err != nil {
+			return err
+		}
+		payload = buf.Bytes()
+	}
+
+	if _, err := io.WriteString(w, id); err != nil {
+		return err
+	}
+	if err := binary.Write(w, binary.LittleEndian, uint32(len(payload))); err != nil {
+		return err
+	}
+	if _, err := w.Write(payload); err != nil {
+		return err
+	}
+	// chunks are always aligned to an even number of bytes
+	if len(payload)%2 != 0 {
+		if _, err := w.Write([]byte{0}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,332 @@
+package wav
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+)
+
+// chunk is a chunk of a RIFF file, as a test finds it
+type chunk struct {
+	id      string
+	payload []byte
+}
+
+// readChunks splits the WAV file in `data` into its chunks, checking the RIFF header and the size in it
+func readChunks(t *testing.T, data []byte) []chunk {
+	t.Helper()
+	if len(data) < 12 || string(data[:4]) != "RIFF" || string(data[8:12]) != "WAVE" {
+		t.Fatalf("not a WAV file: % x", data[:12])
+	}
+	if size := binary.LittleEndian.Uint32(data[4:]); int(size) != len(data)-8 {
+		t.Fatalf("RIFF size is %d, want %d", size, len(data)-8)
+	}
+	var chunks []chunk
+	for pos := 12; pos < len(data); {
+		if pos+8 > len(data) {
+			t.Fatalf("chunk header at %d runs past the end of the file", pos)
+		}
+		id := string(data[pos : pos+4])
+		size := int(binary.LittleEndian.Uint32(data[pos+4:]))
+		pos += 8
+		if pos+size > len(data) {
+			t.Fatalf("%q chunk of %d bytes runs past the end of the file", id, size)
+		}
+		chunks = append(chunks, chunk{id: id, payload: data[pos : pos+size]})
+		pos += size + size%2
+	}
+	return chunks
+}
+
+func chunkIDs(chunks []chunk) []string {
+	var ids []string
+	for _, c := range chunks {
+		ids = append(ids, c.id)
+	}
+	return ids
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		file File
+		// chunks are the chunks the file is written with, in order
+		chunks []string
+		// fmtSize and format are the size of the fmt chunk and the format tag in it
+		fmtSize int
+		format  Format
+	}{
+		{
+			name: "16-bit stereo with loops and info",
+			file: File{
+				Fmt:  NewFmtChunk(FormatPCM, 2, 44100, 16),
+				Data: []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12},
+				Sampler: &SamplerChunk{
+					SamplePeriod:  22675,
+					MIDIUnityNote: 60,
+				},
+				Loops: []SampleLoop{
+					{Type: LoopTypeForward, Start: 0, End: 1},
+					{CuePointID: 1, Type: LoopTypePingPong, Start: 1, End: 2, PlayCount: 3},
+				},
+				Info: []InfoEntry{
+					{ID: InfoName, Value: "lead"},
+					{ID: InfoSoftware, Value: "goaudiofile"},
+				},
+			},
+			chunks:  []string{"fmt ", "data", "smpl", "LIST"},
+			fmtSize: 16,
+			format:  FormatPCM,
+		},
+		{
+			name: "8-bit mono with an odd length",
+			file: File{
+				Fmt:  NewFmtChunk(FormatPCM, 1, 8363, 8),
+				Data: []byte{0x80, 0x90, 0xA0},
+				Info: []InfoEntry{{ID: InfoComment, Value: "odd"}},
+			},
+			chunks:  []string{"fmt ", "data", "LIST"},
+			fmtSize: 16,
+			format:  FormatPCM,
+		},
+		{
+			name: "24-bit mono",
+			file: File{
+				Fmt:  NewFmtChunk(FormatPCM, 1, 48000, 24),
+				Data: []byte{1, 2, 3, 4, 5, 6},
+			},
+			chunks:  []string{"fmt ", "data"},
+			fmtSize: 40,
+			format:  formatExtensible,
+		},
+		{
+			name: "16-bit quad",
+			file: File{
+				Fmt:  NewFmtChunk(FormatPCM, 4, 44100, 16),
+				Data: make([]byte, 16),
+			},
+			chunks:  []string{"fmt ", "data"},
+			fmtSize: 40,
+			format:  formatExtensible,
+		},
+		{
+			name: "float stereo",
+			file: File{
+				Fmt:  NewFmtChunk(FormatIEEEFloat, 2, 44100, 32),
+				Data: make([]byte, 24),
+			},
+			chunks:  []string{"fmt ", "fact", "data"},
+			fmtSize: 40,
+			format:  formatExtensible,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			if err := Write(buf, &tc.file); err != nil {
+				t.Fatal(err)
+			}
+
+			chunks := readChunks(t, buf.Bytes())
+			if ids := chunkIDs(chunks); !reflect.DeepEqual(ids, tc.chunks) {
+				t.Fatalf("got chunks %q, want %q", ids, tc.chunks)
+			}
+			fmtChunk := chunks[0].payload
+			if len(fmtChunk) != tc.fmtSize {
+				t.Errorf("fmt chunk is %d bytes, want %d", len(fmtChunk), tc.fmtSize)
+			}
+			if got := Format(binary.LittleEndian.Uint16(fmtChunk)); got != tc.format {
+				t.Errorf("format tag is %#x, want %#x", got, tc.format)
+			}
+			for _, c := range chunks {
+				if c.id == "fact" {
+					if frames := binary.LittleEndian.Uint32(c.payload); int(frames) != tc.file.Frames() {
+						t.Errorf("fact chunk has %d frames, want %d", frames, tc.file.Frames())
+					}
+				}
+			}
+
+			got, err := Read(bytes.NewReader(buf.Bytes()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			want := tc.file
+			if want.Sampler != nil {
+				sc := *want.Sampler
+				sc.NumSampleLoops = uint32(len(want.Loops))
+				want.Sampler = &sc
+			}
+			if !reflect.DeepEqual(*got, want) {
+				t.Errorf("read back %+v, want %+v", *got, want)
+			}
+		})
+	}
+}
+
+func TestExtensibleFmt(t *testing.T) {
+	buf := &bytes.Buffer{}
+	f := File{
+		Fmt:  NewFmtChunk(FormatIEEEFloat, 2, 48000, 32),
+		Data: make([]byte, 8),
+	}
+	if err := Write(buf, &f); err != nil {
+		t.Fatal(err)
+	}
+	p := readChunks(t, buf.Bytes())[0].payload
+	ext := struct {
+		Size        uint16
+		ValidBits   uint16
+		ChannelMask uint32
+		SubFormat   [16]byte
+	}{}
+	if err := binary.Read(bytes.NewReader(p[16:]), binary.LittleEndian, &ext); err != nil {
+		t.Fatal(err)
+	}
+	wantGUID := [16]byte{0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x00, 0x80, 0x00, 0x00, 0xAA, 0x00, 0x38, 0x9B,
+		0x71}
+	if ext.Size != 22 || ext.ValidBits != 32 || ext.ChannelMask != 0x3 || ext.SubFormat != wantGUID {
+		t.Errorf("got extension %+v", ext)
+	}
+}
+
+func TestReadInvalid(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"not RIFF", []byte("RIFX\x04\x00\x00\x00WAVE")},
+		{"not WAVE", []byte("RIFF\x04\x00\x00\x00AVI ")},
+		{"no data", []byte("RIFF\x1c\x00\x00\x00WAVEfmt \x10\x00\x00\x00\x01\x00\x01\x00\x44\xac\x00\x00\x88\x58\x01\x00\x02\x00\x10\x00")},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if _, err := Read(bytes.NewReader(tc.data)); !errors.Is(err, ErrInvalidFileFormat) {
+				t.Errorf("got %v, want %v", err, ErrInvalidFileFormat)
+			}
+		})
+	}
+}
+
+// seekBuffer is an in-memory io.WriteSeeker
+type seekBuffer struct {
+	data []byte
+	pos  int
+}
+
+func (b *seekBuffer) Write(p []byte) (int, error) {
+	if end := b.pos + len(p); end > len(b.data) {
+		b.data = append(b.data, make([]byte, end-len(b.data))...)
+	}
+	n := copy(b.data[b.pos:], p)
+	b.pos += n
+	return n, nil
+}
+
+func (b *seekBuffer) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+		b.pos = int(offset)
+	case io.SeekCurrent:
+		b.pos += int(offset)
+	case io.SeekEnd:
+		b.pos = len(b.data) + int(offset)
+	}
+	return int64(b.pos), nil
+}
+
+func TestWriter(t *testing.T) {
+	tests := []struct {
+		name string
+		fmt  FmtChunk
+		// writes are the lengths of the writes of sample data
+		writes []int
+		chunks []string
+	}{
+		{"16-bit stereo", NewFmtChunk(FormatPCM, 2, 44100, 16), []int{8, 4, 12}, []string{"fmt ", "data"}},
+		{"8-bit mono with an odd length", NewFmtChunk(FormatPCM, 1, 22050, 8), []int{3, 2}, []string{"fmt ", "data"}},
+		{"24-bit stereo", NewFmtChunk(FormatPCM, 2, 48000, 24), []int{6, 12}, []string{"fmt ", "data"}},
+		{"float mono", NewFmtChunk(FormatIEEEFloat, 1, 48000, 32), []int{4, 8, 4}, []string{"fmt ", "fact", "data"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// the file is written after some data of its own, as when it is part of a larger stream
+			prefix := []byte("prefix")
+			out := &seekBuffer{}
+			if _, err := out.Write(prefix); err != nil {
+				t.Fatal(err)
+			}
+			w, err := NewWriter(out, tc.fmt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var data []byte
+			for i, n := range tc.writes {
+				p := bytes.Repeat([]byte{byte(i + 1)}, n)
+				if _, err := w.Write(p); err != nil {
+					t.Fatal(err)
+				}
+				data = append(data, p...)
+			}
+			if err := w.Close(); err != nil {
+				t.Fatal(err)
+			}
+			if out.pos != len(out.data) {
+				t.Errorf("left the writer at %d, not at the end of the file at %d", out.pos, len(out.data))
+			}
+
+			file := out.data[len(prefix):]
+			chunks := readChunks(t, file)
+			if ids := chunkIDs(chunks); !reflect.DeepEqual(ids, tc.chunks) {
+				t.Fatalf("got chunks %q, want %q", ids, tc.chunks)
+			}
+			frames := len(data) / int(tc.fmt.BlockAlign)
+			for _, c := range chunks {
+				if c.id == "fact" && int(binary.LittleEndian.Uint32(c.payload)) != frames {
+					t.Errorf("fact chunk has %d frames, want %d", binary.LittleEndian.Uint32(c.payload), frames)
+				}
+			}
+
+			got, err := Read(bytes.NewReader(file))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.Fmt != tc.fmt {
+				t.Errorf("got fmt %+v, want %+v", got.Fmt, tc.fmt)
+			}
+			if !bytes.Equal(got.Data, data) {
+				t.Errorf("got data % x, want % x", got.Data, data)
+			}
+		})
+	}
+}
+
+// TestWriterStreamed checks that the sizes are left at their maximum when the writer cannot seek back to them
+func TestWriterStreamed(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w, err := NewWriter(buf, NewFmtChunk(FormatIEEEFloat, 2, 44100, 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write(make([]byte, 16)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	data := buf.Bytes()
+	// RIFF header, fmt chunk of 40 bytes, fact chunk of 4 bytes, data chunk header
+	const headerLen = 12 + 8 + 40 + 8 + 4 + 8
+	if len(data) != headerLen+16 {
+		t.Fatalf("wrote %d bytes, want %d", len(data), headerLen+16)
+	}
+	for _, offset := range []int{4, 12 + 8 + 40 + 8, headerLen - 4} {
+		if size := binary.LittleEndian.Uint32(data[offset:]); size != streamedSize {
+			t.Errorf("size at %d is %#x, want %#x", offset, size, streamedSize)
+		}
+	}
+}
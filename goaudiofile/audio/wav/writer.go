@@ -0,0 +1,108 @@
+package wav
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+)
+
+// streamedSize is the chunk size used while the final size is unknown
+const streamedSize = uint32(0xFFFFFFFF)
+
+// Writer writes the data chunk of a WAV file as the data is produced
+// When the underlying writer is an io.WriteSeeker, the chunk sizes are fixed up on Close - otherwise
+// they are left at their maximum value, which most readers take as 'read until the end of the stream'.
+type Writer struct {
+	w          io.Writer
+	start      int64
+	seeker     io.WriteSeeker
+	blockAlign int
+	dataLen    int64
+
+	// factOffset is where the frame count of the fact chunk is, or 0 if there is none, and headerLen is the
+	// length of everything before the sample data
+	factOffset int64
+	headerLen  int64
+}
+
+// NewWriter writes the header of a WAV file with the format `fmt` to the writer `w` and returns
+// a Writer for the sample data that follows it
+func NewWriter(w io.Writer, fmt FmtChunk) (*Writer, error) {
+	ww := Writer{
+		w:          w,
+		blockAlign: int(fmt.BlockAlign),
+	}
+	if s, ok := w.(io.WriteSeeker); ok {
+		if pos, err := s.Seek(0, io.SeekCurrent); err == nil {
+			ww.seeker = s
+			ww.start = pos
+		}
+	}
+
+	header := &bytes.Buffer{}
+	header.WriteString("RIFF")
+	_ = binary.Write(header, binary.LittleEndian, streamedSize)
+	header.WriteString("WAVE")
+	if err := writeChunk(header, "fmt ", fmt.payload()); err != nil {
+		return nil, err
+	}
+	if fmt.Format != FormatPCM {
+		ww.factOffset = int64(header.Len()) + 8
+		if err := writeChunk(header, "fact", streamedSize); err != nil {
+			return nil, err
+		}
+	}
+	header.WriteString("data")
+	_ = binary.Write(header, binary.LittleEndian, streamedSize)
+	ww.headerLen = int64(header.Len())
+
+	if _, err := w.Write(header.Bytes()); err != nil {
+		return nil, err
+	}
+	return &ww, nil
+}
+
+// Write appends the sample data `p` to the data chunk
+func (w *Writer) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	w.dataLen += int64(n)
+	return n, err
+}
+
+// Close pads the data chunk and, if possible, writes the final chunk sizes
+// It does not close the underlying writer.
+func (w *Writer) Close() error {
+	end := w.dataLen
+	if w.dataLen%2 != 0 {
+		if _, err := w.w.Write([]byte{0}); err != nil {
+			return err
+		}
+		end++
+	}
+
+	if w.seeker == nil {
+		return nil
+	}
+
+	type size struct {
+		offset int64
+		value  uint32
+	}
+	sizes := []size{
+		{offset: 4, value: uint32(w.headerLen - 8 + end)},
+		{offset: w.headerLen - 4, value: uint32(w.dataLen)},
+	}
+	if w.factOffset != 0 && w.blockAlign != 0 {
+		sizes = append(sizes, size{offset: w.factOffset, value: uint32(w.dataLen / int64(w.blockAlign))})
+	}
+	for _, s := range sizes {
+		if _, err := w.seeker.Seek(w.start+s.offset, io.SeekStart); err != nil {
+			return err
+		}
+		if err := binary.Write(w.seeker, binary.LittleEndian, s.value); err != nil {
+			return err
+		}
+	}
+	_, err := w.seeker.Seek(w.start+w.headerLen+end, io.SeekStart)
+	return err
+}
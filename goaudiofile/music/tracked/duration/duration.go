@@ -0,0 +1,262 @@
+// Package duration works out how long a tracked song plays by following its order list and
+// pattern flow control, without rendering any audio
+package duration
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+var (
+	// ErrInfiniteLoop is for when the pattern flow control never lets the song end or repeat itself
+	ErrInfiniteLoop = errors.New("song flow does not terminate")
+)
+
+// Info is the result of a song length analysis
+type Info struct {
+	// Duration is how long the song plays before it ends or starts repeating itself
+	Duration time.Duration
+	// LoopOrder and LoopRow are where playback continues after Duration when the song loops
+	LoopOrder int
+	LoopRow   int
+	// LoopStart is the time into the song at which LoopOrder and LoopRow were first played
+	// If the song never played that position before (for example, a restart position that was
+	// skipped over), it is equal to Duration.
+	LoopStart time.Duration
+	// Ended is true when the song ran off the end of its order list, and false when it jumped
+	// back into a part it has already played
+	Ended bool
+	// Unreached holds the positions in the order list (0-based) that are never played
+	Unreached []int
+	// OrderStarts holds the time into the song at which each position in the order list is first played from
+	// its first row, for the positions that are
+	OrderStarts map[int]time.Duration
+}
+
+const (
+	// orderSkip is an order list entry that is skipped over
+	orderSkip = -1
+	// orderEnd is an order list entry that ends the song
+	orderEnd = -2
+
+	noValue = -1
+
+	// maxRows is a safety net for the pattern loop handling, which allows rows to be revisited
+	maxRows = 1 << 20
+)
+
+// loopCommand is a pattern loop command on a single channel; a zero count sets the loop start
+type loopCommand struct {
+	channel int
+	count   int
+}
+
+// row is the flow control that happens on a single pattern row, normalized over all formats
+type row struct {
+	speed      int
+	tempo      int
+	tempoSlide int
+	jump       int
+	brk        int
+	loops      []loopCommand
+	delay      int
+	fineDelay  int
+}
+
+func newRow() row {
+	return row{
+		jump: noValue,
+		brk:  noValue,
+	}
+}
+
+// setDelay keeps the first pattern delay on a row, like the trackers do
+func (r *row) setDelay(rows int) {
+	if r.delay == 0 {
+		r.delay = rows
+	}
+}
+
+// song is a format-neutral view of the parts of a song that affect its timing
+type song struct {
+	orders   []int
+	patterns [][]row
+	speed    int
+	tempo    int
+	minTempo int
+	maxTempo int
+	restart  int
+}
+
+type position struct {
+	order int
+	row   int
+}
+
+// patternRows returns the rows of pattern `pat`, or nil when there is no such pattern
+func (s *song) patternRows(pat int) []row {
+	if pat < 0 || pat >= len(s.patterns) {
+		return nil
+	}
+	return s.patterns[pat]
+}
+
+// resolveOrder moves past skip markers, returning the order list position that plays next, or
+// noValue if the song ends there
+func (s *song) resolveOrder(order int) int {
+	for order >= 0 && order < len(s.orders) {
+		switch s.orders[order] {
+		case orderSkip:
+			order++
+		case orderEnd:
+			return noValue
+		default:
+			return order
+		}
+	}
+	return noValue
+}
+
+func (s *song) analyze() (*Info, error) {
+	// patterns past the end of the pattern list play as empty 64-row patterns
+	for _, o := range s.orders {
+		for len(s.patterns) <= o {
+			s.patterns = append(s.patterns, emptyRows(defaultRows))
+		}
+	}
+
+	info := Info{
+		OrderStarts: make(map[int]time.Duration),
+	}
+	speed := s.speed
+	tempo := s.tempo
+	var elapsed float64
+
+	visited := make(map[position]float64)
+	played := make([]bool, len(s.orders))
+	loopStart := make(map[int]int)
+	loopCount := make(map[int]int)
+
+	order := s.resolveOrder(0)
+	rowNum := 0
+	for n := 0; ; n++ {
+		if n >= maxRows {
+			return nil, ErrInfiniteLoop
+		}
+
+		if order == noValue {
+			info.Ended = true
+			info.LoopOrder = s.resolveOrder(s.restart)
+			if info.LoopOrder == noValue {
+				info.LoopOrder = s.resolveOrder(0)
+			}
+			info.LoopRow = 0
+			info.LoopStart = toDuration(elapsed)
+			if t, ok := visited[position{info.LoopOrder, 0}]; ok {
+				info.LoopStart = toDuration(t)
+			}
+			break
+		}
+
+		rows := s.patternRows(s.orders[order])
+		if rowNum >= len(rows) {
+			order = s.resolveOrder(order + 1)
+			rowNum = 0
+			loopStart = make(map[int]int)
+			loopCount = make(map[int]int)
+			continue
+		}
+
+		pos := position{order, rowNum}
+		if t, ok := visited[pos]; ok {
+			info.LoopOrder = order
+			info.LoopRow = rowNum
+			info.LoopStart = toDuration(t)
+			break
+		}
+		visited[pos] = elapsed
+		played[order] = true
+		if _, ok := info.OrderStarts[order]; !ok && rowNum == 0 {
+			info.OrderStarts[order] = toDuration(elapsed)
+		}
+
+		r := &rows[rowNum]
+		if r.speed > 0 {
+			speed = r.speed
+		}
+		if r.tempo > 0 {
+			tempo = r.tempo
+		}
+
+		// a tick lasts 2.5 seconds / tempo (in BPM), and a pattern delay repeats the whole row
+		ticks := speed*(1+r.delay) + r.fineDelay
+		for t := 0; t < ticks; t++ {
+			if r.tempoSlide != 0 && t%speed != 0 {
+				tempo += r.tempoSlide
+				if tempo < s.minTempo {
+					tempo = s.minTempo
+				} else if tempo > s.maxTempo {
+					tempo = s.maxTempo
+				}
+			}
+			elapsed += 2.5 / float64(tempo)
+		}
+
+		loopTo := noValue
+		for _, l := range r.loops {
+			if l.count == 0 {
+				loopStart[l.channel] = rowNum
+				continue
+			}
+			if loopCount[l.channel] == 0 {
+				loopCount[l.channel] = l.count
+				loopTo = loopStart[l.channel]
+			} else if loopCount[l.channel]--; loopCount[l.channel] > 0 {
+				loopTo = loopStart[l.channel]
+			}
+		}
+		if loopTo != noValue {
+			// the rows inside the loop get played again on purpose, so they do not count as a song loop
+			for i := loopTo; i <= rowNum; i++ {
+				delete(visited, position{order, i})
+			}
+			rowNum = loopTo
+			continue
+		}
+
+		if r.jump != noValue || r.brk != noValue {
+			next := order + 1
+			if r.jump != noValue {
+				next = r.jump
+			}
+			order = s.resolveOrder(next)
+			rowNum = 0
+			if r.brk != noValue && order != noValue && r.brk < len(s.patternRows(s.orders[order])) {
+				rowNum = r.brk
+			}
+			loopStart = make(map[int]int)
+			loopCount = make(map[int]int)
+			continue
+		}
+
+		rowNum++
+	}
+
+	info.Duration = toDuration(elapsed)
+	for i, o := range s.orders {
+		if o >= 0 && !played[i] {
+			info.Unreached = append(info.Unreached, i)
+		}
+	}
+	return &info, nil
+}
+
+func toDuration(seconds float64) time.Duration {
+	return time.Duration(math.Round(seconds * float64(time.Second)))
+}
+
+// bcd decodes the pattern break parameter of the formats that store it as a decimal number
+func bcd(v uint8) int {
+	return int(v>>4)*10 + int(v&0x0F)
+}
@@ -0,0 +1,323 @@
+package duration
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gotracker/goaudiofile/music/tracked/it"
+	"github.com/gotracker/goaudiofile/music/tracked/mod"
+	"github.com/gotracker/goaudiofile/music/tracked/s3m"
+	"github.com/gotracker/goaudiofile/music/tracked/xm"
+)
+
+// testRow is how long a row of the test songs lasts at their speed of 6 and tempo of 125
+const testRow = 6 * 20 * time.Millisecond
+
+type flowKind int
+
+const (
+	setSpeed = flowKind(iota)
+	setTempo
+	jump
+	patternBreak
+	patternLoop
+	patternDelay
+)
+
+// flow is a flow control effect at `row` of `channel` in pattern `pattern` of a test song
+type flow struct {
+	pattern, row, channel int
+	kind                  flowKind
+	value                 int
+}
+
+// toBCD encodes `v` the way MOD, XM and S3M pattern breaks store their row
+func toBCD(v int) uint8 {
+	return uint8(v/10<<4 | v%10)
+}
+
+// protrackerEffect returns the MOD and XM effect command and parameter for `f`
+func protrackerEffect(f flow) (uint8, uint8) {
+	switch f.kind {
+	case setSpeed, setTempo:
+		return 0x0F, uint8(f.value)
+	case jump:
+		return 0x0B, uint8(f.value)
+	case patternBreak:
+		return 0x0D, toBCD(f.value)
+	case patternLoop:
+		return 0x0E, 0x60 | uint8(f.value)
+	default:
+		return 0x0E, 0xE0 | uint8(f.value)
+	}
+}
+
+// screamTrackerEffect returns the S3M and IT effect command and parameter for `f`, where IT stores the row of a
+// pattern break as a plain number
+func screamTrackerEffect(f flow, bcdBreak bool) (uint8, uint8) {
+	switch f.kind {
+	case setSpeed:
+		return cmdSetSpeed, uint8(f.value)
+	case setTempo:
+		return cmdSetTempo, uint8(f.value)
+	case jump:
+		return cmdOrderJump, uint8(f.value)
+	case patternBreak:
+		if bcdBreak {
+			return cmdPatternBreak, toBCD(f.value)
+		}
+		return cmdPatternBreak, uint8(f.value)
+	case patternLoop:
+		return cmdSpecial, 0xB0 | uint8(f.value)
+	default:
+		return cmdSpecial, 0xE0 | uint8(f.value)
+	}
+}
+
+// numPatterns returns how many patterns a test song with `orders` has
+func numPatterns(orders []int) int {
+	n := 0
+	for _, o := range orders {
+		if o >= n {
+			n = o + 1
+		}
+	}
+	return n
+}
+
+func fromMOD(orders []int, flows []flow) (*Info, error) {
+	f := &mod.File{}
+	f.Head.SongLen = uint8(len(orders))
+	f.Head.RestartPos = 127
+	for i, o := range orders {
+		f.Head.Order[i] = uint8(o)
+	}
+	for i := 0; i < numPatterns(orders); i++ {
+		f.Patterns = append(f.Patterns, mod.NewPattern(2))
+	}
+	for _, fl := range flows {
+		effect, param := protrackerEffect(fl)
+		f.Patterns[fl.pattern][fl.row][fl.channel] = mod.Channel{0, 0, effect, param}
+	}
+	return FromMOD(f)
+}
+
+func fromXM(orders []int, flows []flow) (*Info, error) {
+	f := &xm.File{}
+	f.Head.SongLength = uint16(len(orders))
+	f.Head.DefaultSpeed = 6
+	f.Head.DefaultTempo = 125
+	for i, o := range orders {
+		f.Head.OrderTable[i] = uint8(o)
+	}
+	for i := 0; i < numPatterns(orders); i++ {
+		var p xm.Pattern
+		for r := 0; r < defaultRows; r++ {
+			p.Data = append(p.Data, make(xm.PatternRow, 2))
+		}
+		f.Patterns = append(f.Patterns, p)
+	}
+	for _, fl := range flows {
+		c := &f.Patterns[fl.pattern].Data[fl.row][fl.channel]
+		c.Effect, c.EffectParameter = protrackerEffect(fl)
+	}
+	return FromXM(f)
+}
+
+// packedRows packs the flows of pattern `pattern` into 64 rows, where `cell` encodes the effect of a flow
+func packedRows(pattern int, flows []flow, cell func(f flow) []byte) []byte {
+	var data []byte
+	for r := 0; r < defaultRows; r++ {
+		for _, fl := range flows {
+			if fl.pattern == pattern && fl.row == r {
+				data = append(data, cell(fl)...)
+			}
+		}
+		data = append(data, 0)
+	}
+	return data
+}
+
+func fromS3M(orders []int, flows []flow) (*Info, error) {
+	f := &s3m.File{}
+	f.Head.InitialSpeed = 6
+	f.Head.InitialTempo = 125
+	for _, o := range orders {
+		f.OrderList = append(f.OrderList, uint8(o))
+	}
+	for i := 0; i < numPatterns(orders); i++ {
+		data := packedRows(i, flows, func(fl flow) []byte {
+			command, info := screamTrackerEffect(fl, true)
+			return []byte{byte(s3m.PatternFlagCommand) | byte(fl.channel), command, info}
+		})
+		f.Patterns = append(f.Patterns, s3m.PackedPattern{Length: uint16(len(data)), Data: data})
+	}
+	return FromS3M(f)
+}
+
+func fromIT(orders []int, flows []flow) (*Info, error) {
+	f := &it.File{}
+	f.Head.InitialSpeed = 6
+	f.Head.InitialTempo = 125
+	for _, o := range orders {
+		f.OrderList = append(f.OrderList, uint8(o))
+	}
+	for i := 0; i < numPatterns(orders); i++ {
+		data := packedRows(i, flows, func(fl flow) []byte {
+			command, info := screamTrackerEffect(fl, false)
+			return []byte{0x80 | byte(fl.channel+1), byte(it.ChannelDataFlagCommand), command, info}
+		})
+		f.Patterns = append(f.Patterns, it.PackedPattern{Length: uint16(len(data)), Rows: defaultRows, Data: data})
+	}
+	return FromIT(f)
+}
+
+func TestFlow(t *testing.T) {
+	formats := []struct {
+		name string
+		from func(orders []int, flows []flow) (*Info, error)
+	}{
+		{"mod", fromMOD},
+		{"xm", fromXM},
+		{"s3m", fromS3M},
+		{"it", fromIT},
+	}
+	tests := []struct {
+		name   string
+		orders []int
+		flows  []flow
+		want   Info
+	}{
+		{
+			name:   "plays through",
+			orders: []int{0},
+			want: Info{
+				Duration:    64 * testRow,
+				Ended:       true,
+				OrderStarts: map[int]time.Duration{0: 0},
+			},
+		},
+		{
+			name:   "speed and tempo",
+			orders: []int{0},
+			flows: []flow{
+				{row: 0, channel: 0, kind: setSpeed, value: 3},
+				{row: 0, channel: 1, kind: setTempo, value: 250},
+				{row: 32, channel: 0, kind: setSpeed, value: 12},
+			},
+			want: Info{
+				Duration:    32*3*10*time.Millisecond + 32*12*10*time.Millisecond,
+				Ended:       true,
+				OrderStarts: map[int]time.Duration{0: 0},
+			},
+		},
+		{
+			name:   "pattern break",
+			orders: []int{0, 1},
+			flows: []flow{
+				{pattern: 0, row: 31, kind: patternBreak, value: 16},
+			},
+			want: Info{
+				Duration: (32 + 48) * testRow,
+				Ended:    true,
+				// the second order is only played from its row 16
+				OrderStarts: map[int]time.Duration{0: 0},
+			},
+		},
+		{
+			name:   "jump back to the start",
+			orders: []int{0, 1},
+			flows: []flow{
+				{pattern: 1, row: 63, kind: jump, value: 0},
+			},
+			want: Info{
+				Duration:    128 * testRow,
+				OrderStarts: map[int]time.Duration{0: 0, 1: 64 * testRow},
+			},
+		},
+		{
+			name:   "jump back to the middle",
+			orders: []int{0, 1, 2},
+			flows: []flow{
+				{pattern: 2, row: 63, kind: jump, value: 1},
+			},
+			want: Info{
+				Duration:    192 * testRow,
+				LoopOrder:   1,
+				LoopStart:   64 * testRow,
+				OrderStarts: map[int]time.Duration{0: 0, 1: 64 * testRow, 2: 128 * testRow},
+			},
+		},
+		{
+			name:   "jump over an order",
+			orders: []int{0, 1, 2},
+			flows: []flow{
+				{pattern: 0, row: 63, kind: jump, value: 2},
+			},
+			want: Info{
+				Duration:    128 * testRow,
+				Ended:       true,
+				Unreached:   []int{1},
+				OrderStarts: map[int]time.Duration{0: 0, 2: 64 * testRow},
+			},
+		},
+		{
+			name:   "pattern loop",
+			orders: []int{0},
+			flows: []flow{
+				{row: 4, channel: 1, kind: patternLoop, value: 0},
+				{row: 7, channel: 1, kind: patternLoop, value: 2},
+			},
+			want: Info{
+				// rows 4 to 7 play three times
+				Duration:    (64 + 2*4) * testRow,
+				Ended:       true,
+				OrderStarts: map[int]time.Duration{0: 0},
+			},
+		},
+		{
+			name:   "pattern delay",
+			orders: []int{0},
+			flows: []flow{
+				{row: 10, kind: patternDelay, value: 3},
+			},
+			want: Info{
+				Duration:    (64 + 3) * testRow,
+				Ended:       true,
+				OrderStarts: map[int]time.Duration{0: 0},
+			},
+		},
+	}
+
+	for _, format := range formats {
+		for _, tc := range tests {
+			t.Run(format.name+"/"+tc.name, func(t *testing.T) {
+				got, err := format.from(tc.orders, tc.flows)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !reflect.DeepEqual(*got, tc.want) {
+					t.Errorf("got %+v, want %+v", *got, tc.want)
+				}
+			})
+		}
+	}
+}
+
+// TestFromMODLongSongLength checks that a song length past the end of the order list plays the whole list
+func TestFromMODLongSongLength(t *testing.T) {
+	f := &mod.File{
+		Patterns: []mod.Pattern{mod.NewPattern(1)},
+	}
+	f.Head.SongLen = 200
+	got, err := FromMOD(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the length of every tick is added up as a float, so a long song can be off by a little
+	want := 128 * 64 * testRow
+	if d := got.Duration - want; d < -time.Millisecond || d > time.Millisecond {
+		t.Errorf("got %v, want %v", got.Duration, want)
+	}
+}
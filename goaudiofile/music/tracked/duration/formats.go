@@ -0,0 +1,324 @@
+package duration
+
+import (
+	"github.com/gotracker/goaudiofile/music/tracked/it"
+	"github.com/gotracker/goaudiofile/music/tracked/mod"
+	"github.com/gotracker/goaudiofile/music/tracked/s3m"
+	"github.com/gotracker/goaudiofile/music/tracked/xm"
+)
+
+const (
+	defaultSpeed = 6
+	defaultTempo = 125
+	defaultRows  = 64
+)
+
+// S3M and IT effect commands, which are stored as letters counting from A = 1
+const (
+	cmdSetSpeed     = 'A' - '@'
+	cmdOrderJump    = 'B' - '@'
+	cmdPatternBreak = 'C' - '@'
+	cmdSpecial      = 'S' - '@'
+	cmdSetTempo     = 'T' - '@'
+)
+
+// FromMOD analyzes the length of the MOD file `f`
+func FromMOD(f *mod.File) (*Info, error) {
+	s := song{
+		speed:    defaultSpeed,
+		tempo:    defaultTempo,
+		minTempo: 32,
+		maxTempo: 255,
+	}
+	// broken files can claim more orders than the order list holds
+	songLen := int(f.Head.SongLen)
+	if songLen > len(f.Head.Order) {
+		songLen = len(f.Head.Order)
+	}
+	for _, o := range f.Head.Order[:songLen] {
+		s.orders = append(s.orders, int(o))
+	}
+	// 127 is the usual 'no restart position' marker
+	if int(f.Head.RestartPos) < songLen {
+		s.restart = int(f.Head.RestartPos)
+	}
+
+	for _, p := range f.Patterns {
+		rows := make([]row, len(p))
+		for i, pr := range p {
+			r := newRow()
+			for ch, c := range pr {
+				param := c.EffectParameter()
+				switch c.Effect() {
+				case 0x0B:
+					r.jump = int(param)
+				case 0x0D:
+					r.brk = bcd(param)
+				case 0x0E:
+					switch param >> 4 {
+					case 0x6:
+						r.loops = append(r.loops, loopCommand{channel: ch, count: int(param & 0x0F)})
+					case 0xE:
+						r.setDelay(int(param & 0x0F))
+					}
+				case 0x0F:
+					if param >= 0x20 {
+						r.tempo = int(param)
+					} else if param != 0 {
+						r.speed = int(param)
+					}
+				}
+			}
+			rows[i] = r
+		}
+		s.patterns = append(s.patterns, rows)
+	}
+
+	return s.analyze()
+}
+
+// FromS3M analyzes the length of the S3M file `f`
+func FromS3M(f *s3m.File) (*Info, error) {
+	s := song{
+		speed:    int(f.Head.InitialSpeed),
+		tempo:    int(f.Head.InitialTempo),
+		minTempo: 33,
+		maxTempo: 255,
+	}
+	if s.speed == 0 || s.speed == 255 {
+		s.speed = defaultSpeed
+	}
+	if s.tempo < s.minTempo {
+		s.tempo = defaultTempo
+	}
+	s.orders = convertOrders(f.OrderList)
+
+	for _, p := range f.Patterns {
+		s.patterns = append(s.patterns, convertS3MPattern(f, &p))
+	}
+
+	return s.analyze()
+}
+
+func convertS3MPattern(f *s3m.File, p *s3m.PackedPattern) []row {
+	rows := emptyRows(defaultRows)
+	data := p.Data
+	for pos, rowNum := 0, 0; pos < len(data) && rowNum < len(rows); {
+		what := s3m.PatternFlags(data[pos])
+		pos++
+		if what == 0 {
+			rowNum++
+			continue
+		}
+
+		var command, info uint8
+		if what.HasNote() {
+			pos += 2
+		}
+		if what.HasVolume() {
+			pos++
+		}
+		if what.HasCommand() {
+			if pos+2 > len(data) {
+				break
+			}
+			command, info = data[pos], data[pos+1]
+			pos += 2
+		}
+
+		ch := int(what.Channel())
+		if command == 0 || !f.ChannelSettings[ch].IsEnabled() {
+			continue
+		}
+
+		r := &rows[rowNum]
+		switch command {
+		case cmdSetSpeed:
+			if info != 0 {
+				r.speed = int(info)
+			}
+		case cmdOrderJump:
+			r.jump = int(info)
+		case cmdPatternBreak:
+			r.brk = bcd(info)
+		case cmdSpecial:
+			switch info >> 4 {
+			case 0xB:
+				r.loops = append(r.loops, loopCommand{channel: ch, count: int(info & 0x0F)})
+			case 0xE:
+				r.setDelay(int(info & 0x0F))
+			}
+		case cmdSetTempo:
+			if info > 0x20 {
+				r.tempo = int(info)
+			}
+		}
+	}
+	return rows
+}
+
+// FromXM analyzes the length of the XM file `f`
+func FromXM(f *xm.File) (*Info, error) {
+	s := song{
+		speed:    int(f.Head.DefaultSpeed),
+		tempo:    int(f.Head.DefaultTempo),
+		minTempo: 32,
+		maxTempo: 255,
+	}
+	if s.speed == 0 {
+		s.speed = defaultSpeed
+	}
+	if s.tempo < s.minTempo {
+		s.tempo = defaultTempo
+	}
+	songLen := int(f.Head.SongLength)
+	if songLen > len(f.Head.OrderTable) {
+		songLen = len(f.Head.OrderTable)
+	}
+	for _, o := range f.Head.OrderTable[:songLen] {
+		s.orders = append(s.orders, int(o))
+	}
+	if int(f.Head.RestartPosition) < songLen {
+		s.restart = int(f.Head.RestartPosition)
+	}
+
+	for _, p := range f.Patterns {
+		rows := make([]row, len(p.Data))
+		for i, pr := range p.Data {
+			r := newRow()
+			for ch, c := range pr {
+				param := c.EffectParameter
+				switch c.Effect {
+				case 0x0B:
+					r.jump = int(param)
+				case 0x0D:
+					r.brk = bcd(param)
+				case 0x0E:
+					switch param >> 4 {
+					case 0x6:
+						r.loops = append(r.loops, loopCommand{channel: ch, count: int(param & 0x0F)})
+					case 0xE:
+						r.setDelay(int(param & 0x0F))
+					}
+				case 0x0F:
+					if param >= 0x20 {
+						r.tempo = int(param)
+					} else if param != 0 {
+						r.speed = int(param)
+					}
+				}
+			}
+			rows[i] = r
+		}
+		s.patterns = append(s.patterns, rows)
+	}
+
+	return s.analyze()
+}
+
+// FromIT analyzes the length of the IT file `f`
+func FromIT(f *it.File) (*Info, error) {
+	s := song{
+		speed:    int(f.Head.InitialSpeed),
+		tempo:    int(f.Head.InitialTempo),
+		minTempo: 32,
+		maxTempo: 255,
+	}
+	if s.speed == 0 {
+		s.speed = defaultSpeed
+	}
+	if s.tempo < s.minTempo {
+		s.tempo = defaultTempo
+	}
+	s.orders = convertOrders(f.OrderList)
+
+	for _, p := range f.Patterns {
+		rows, err := convertITPattern(&p)
+		if err != nil {
+			return nil, err
+		}
+		s.patterns = append(s.patterns, rows)
+	}
+
+	return s.analyze()
+}
+
+func convertITPattern(p *it.PackedPattern) ([]row, error) {
+	rows := emptyRows(int(p.Rows))
+	var rowMem [64]it.ChannelData
+
+	for pos, rowNum := 0, 0; pos < len(p.Data) && rowNum < len(rows); {
+		n, cd, err := p.ReadChannelData(pos, rowMem[:])
+		if err != nil {
+			return nil, err
+		}
+		if n == 0 {
+			break
+		}
+		pos += n
+		if cd == nil {
+			rowNum++
+			continue
+		}
+		if !cd.Flags.HasCommand() {
+			continue
+		}
+
+		r := &rows[rowNum]
+		ch := int(cd.ChannelNumber)
+		info := cd.CommandData
+		switch cd.Command {
+		case cmdSetSpeed:
+			if info != 0 {
+				r.speed = int(info)
+			}
+		case cmdOrderJump:
+			r.jump = int(info)
+		case cmdPatternBreak:
+			r.brk = int(info)
+		case cmdSpecial:
+			switch info >> 4 {
+			case 0x6:
+				r.fineDelay += int(info & 0x0F)
+			case 0xB:
+				r.loops = append(r.loops, loopCommand{channel: ch, count: int(info & 0x0F)})
+			case 0xE:
+				r.setDelay(int(info & 0x0F))
+			}
+		case cmdSetTempo:
+			switch info >> 4 {
+			case 0x0:
+				r.tempoSlide = -int(info & 0x0F)
+			case 0x1:
+				r.tempoSlide = int(info & 0x0F)
+			default:
+				r.tempo = int(info)
+			}
+		}
+	}
+	return rows, nil
+}
+
+// convertOrders converts an S3M or IT order list, where 254 is a skip marker and 255 ends the song
+func convertOrders(list []uint8) []int {
+	orders := make([]int, len(list))
+	for i, o := range list {
+		switch o {
+		case 254:
+			orders[i] = orderSkip
+		case 255:
+			orders[i] = orderEnd
+		default:
+			orders[i] = int(o)
+		}
+	}
+	return orders
+}
+
+func emptyRows(n int) []row {
+	rows := make([]row, n)
+	for i := range rows {
+		rows[i] = newRow()
+	}
+	return rows
+}
@@ -26,6 +26,8 @@ type File struct {
 	Samples            []FullSample
 	Patterns           []PackedPattern
 	Blocks             []block.Block
+	// Message is the song message, with its lines separated by "\n"
+	Message string
 }
 
 // FullSample is a full sample, header + data
@@ -115,6 +117,10 @@ blockReadLoop:
 		}
 	}
 
+	if f.Head.SpecialFlags.IsMessageAttached() {
+		f.Message = readMessage(data, f.Head.MessageOffset, f.Head.MessageLength)
+	}
+
 	for _, ptr := range f.InstrumentPointers {
 		if ptr < valPos {
 			return nil, ErrInvalidFileFormat
@@ -161,7 +167,8 @@ blockReadLoop:
 	}
 
 	for _, ptr := range f.PatternPointers {
-		if ptr < valPos {
+		// a pointer of 0 is an empty pattern
+		if ptr != 0 && ptr < valPos {
 			return nil, ErrInvalidFileFormat
 		}
 
@@ -174,3 +181,22 @@ blockReadLoop:
 
 	return &f, nil
 }
+
+// readMessage reads the song message of `length` bytes at `ptr`, which IT stores with its lines separated by
+// carriage returns and ends with a NUL
+func readMessage(data []byte, ptr ParaPointer32, length uint16) string {
+	start := ptr.Offset()
+	end := start + int(length)
+	if start < 0 || start >= len(data) {
+		return ""
+	}
+	if end > len(data) {
+		end = len(data)
+	}
+	msg := data[start:end]
+	if i := bytes.IndexByte(msg, 0); i >= 0 {
+		msg = msg[:i]
+	}
+	msg = bytes.ReplaceAll(msg, []byte("\r\n"), []byte("\n"))
+	return string(bytes.ReplaceAll(msg, []byte("\r"), []byte("\n")))
+}
@@ -0,0 +1,400 @@
+package it
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+
+	"github.com/gotracker/goaudiofile/music/tracked/it/block"
+)
+
+// testWriter writes the fields of a module in order
+type testWriter struct {
+	t   *testing.T
+	buf bytes.Buffer
+}
+
+func (w *testWriter) write(values ...interface{}) {
+	w.t.Helper()
+	for _, v := range values {
+		if err := binary.Write(&w.buf, binary.LittleEndian, v); err != nil {
+			w.t.Fatal(err)
+		}
+	}
+}
+
+// pos returns a pointer to where the next field is written
+func (w *testWriter) pos() ParaPointer32 {
+	return ParaPointer32(w.buf.Len())
+}
+
+// testPatternData is a pattern of 2 rows: the first row sets every field on channel 1 and the note and
+// instrument on channel 2, and the second row uses the last values on channel 2 and has a note off on
+// channel 1, with its mask taken from the last one of the channel
+var testPatternData = []uint8{
+	0x81, 0x0F, 60, 1, 64, 1, 6,
+	0x82, 0x03, 62, 2,
+	0,
+	0x82, 0xF0,
+	0x01, 255, 1, 32, 0x13, 0x80,
+	0,
+}
+
+// testPatternRows is what reading testPatternData gives
+var testPatternRows = [][]ChannelData{
+	{
+		{ChannelNumber: 0, Flags: 0x0F, Note: 60, Instrument: 1, VolPan: 64, Command: 1, CommandData: 6},
+		{ChannelNumber: 1, Flags: 0x03, Note: 62, Instrument: 2},
+	},
+	{
+		{ChannelNumber: 1, Flags: 0xFF, Note: 62, Instrument: 2},
+		{ChannelNumber: 0, Flags: 0x0F, Note: 255, Instrument: 1, VolPan: 32, Command: 0x13, CommandData: 0x80},
+	},
+}
+
+// testModule builds a module with instruments in the old format when `cmwt` is under 0x200 and in the new one
+// otherwise, with pattern names and a plugin between the header and the instruments, samples of every size,
+// a packed pattern and an empty one, and a message, along with the File that reading it should give
+// The edit history is included when `history` is set.
+func testModule(t *testing.T, cmwt uint16, history bool) ([]byte, *File) {
+	t.Helper()
+	w := &testWriter{t: t}
+	want := &File{
+		OrderList: []uint8{0, 0, 254, 255},
+	}
+
+	h := &want.Head
+	copy(h.IMPM[:], "IMPM")
+	copy(h.Name[:], "test module")
+	h.PHighlight = 0x1004
+	h.OrderCount = uint16(len(want.OrderList))
+	h.InstrumentCount = 2
+	h.SampleCount = 3
+	h.PatternCount = 2
+	h.TrackerVersion = cmwt
+	h.TrackerCompatVersion = cmwt
+	h.Flags = IMPMFlagStereo | IMPMFlagUseInstruments | IMPMFlagLinearSlides
+	h.SpecialFlags = IMPMSpecialFlagMessageAttached
+	if history {
+		h.SpecialFlags |= IMPMSpecialFlagHistoryIncluded
+	}
+	h.GlobalVolume = 128
+	h.MixingVolume = 48
+	h.InitialSpeed = 6
+	h.InitialTempo = 125
+	h.PanningSeparation = 128
+	for i := range h.ChannelPan {
+		h.ChannelPan[i] = PanValue(i % 65)
+		h.ChannelVol[i] = DefaultVolume - Volume(i%8)
+	}
+	h.ChannelPan[3] = 100
+	h.ChannelPan[63] |= 128
+
+	// the header and its tables are written once the pointers are known
+	w.buf.Write(make([]byte, binary.Size(h)+len(want.OrderList)+
+		4*int(h.InstrumentCount+h.SampleCount+h.PatternCount)))
+	if history {
+		w.write(uint16(1), [8]uint8{1, 2, 3, 4, 5, 6, 7, 8})
+	}
+
+	pnam := &block.PatternNames{Name: make([]block.PatternName, 3)}
+	copy(pnam.Identifier[:], "PNAM")
+	copy(pnam.Name[0][:], "intro")
+	copy(pnam.Name[1][:], "a name that takes all 32 bytes..")
+	copy(pnam.Name[2][:], "outro")
+	// the last name is cut short
+	pnam.BlockLen = 2*32 + 5
+	w.write(pnam.Identifier, pnam.BlockLen, pnam.Name[0], pnam.Name[1], pnam.Name[2][:5])
+
+	fx := &block.FX{
+		PluginType:   block.PluginTypeDMO,
+		UniqueID:     [4]byte{0xEF, 0xCA, 0x00, 0x10},
+		RoutingFlags: 1,
+		MixMode:      2,
+		GainFactor:   10,
+		Data:         []byte{0xDE, 0xAD, 0xBE},
+	}
+	copy(fx.Identifier[:], "FX00")
+	copy(fx.UserPluginName[:], "echo")
+	copy(fx.LibraryName[:], "Echo")
+	fx.DataLength = uint32(len(fx.Data))
+	fx.BlockLen = 132 + fx.DataLength
+	w.write(fx.Identifier, fx.BlockLen, fx.PluginType, fx.UniqueID, fx.RoutingFlags, fx.MixMode, fx.GainFactor,
+		fx.Reserved0B, fx.OutputRouting, fx.Reserved10, fx.UserPluginName, fx.LibraryName, fx.DataLength, fx.Data)
+	want.Blocks = []block.Block{pnam, fx}
+
+	for i, name := range []string{"pad", "lead"} {
+		want.InstrumentPointers = append(want.InstrumentPointers, w.pos())
+		var ins IMPIIntf
+		if cmwt < 0x200 {
+			ins = testInstrumentOld(name, uint8(i+1))
+		} else {
+			ins = testInstrument(name, uint8(i+1))
+		}
+		w.write(ins)
+		want.Instruments = append(want.Instruments, ins)
+	}
+
+	samples := []struct {
+		name  string
+		flags SampleFlags
+		data  []byte
+	}{
+		{"8-bit", SampleFlagSampleExists | SampleFlagUseLoop, []byte{0, 0x40, 0x7F, 0x40, 0, 0xC0}},
+		{"16-bit stereo", SampleFlagSampleExists | SampleFlag16Bit | SampleFlagStereo | SampleFlagPingPongLoop,
+			[]byte{0, 0, 0xFF, 0x7F, 0, 0x80, 0x34, 0x12, 1, 0, 2, 0}},
+		{"no data", 0, []byte{}},
+	}
+	for i, s := range samples {
+		fs := FullSample{Data: s.data}
+		sh := &fs.Header
+		copy(sh.IMPS[:], "IMPS")
+		copy(sh.Filename[:], s.name+".wav")
+		copy(sh.Name[:], s.name)
+		sh.GlobalVolume = DefaultVolume
+		sh.Flags = s.flags
+		sh.Volume = DefaultVolume - Volume(i)
+		sh.ConvertFlags = ConvertFlagSignedSamples
+		sh.DefaultPan = SamplePanValue(128 | 32)
+		sh.Length = uint32(len(s.data))
+		if s.flags.Is16Bit() {
+			sh.Length /= 2
+		}
+		if s.flags.IsStereo() {
+			sh.Length /= 2
+		}
+		sh.LoopBegin = 1
+		sh.LoopEnd = sh.Length
+		sh.C5Speed = 8363 * uint32(i+1)
+		sh.SustainLoopEnd = sh.Length
+		sh.VibratoSpeed, sh.VibratoDepth, sh.VibratoSweep, sh.VibratoType = 1, 2, 3, 4
+		want.Samples = append(want.Samples, fs)
+	}
+	for i := range want.Samples {
+		want.SamplePointers = append(want.SamplePointers, w.pos())
+		w.write(want.Samples[i].Header)
+	}
+	for i := range want.Samples {
+		want.Samples[i].Header.SamplePointer = w.pos()
+		w.write(want.Samples[i].Data)
+	}
+	// the sample pointers were only known once the sample data was written
+	for i, ptr := range want.SamplePointers {
+		var sh bytes.Buffer
+		if err := binary.Write(&sh, binary.LittleEndian, want.Samples[i].Header); err != nil {
+			t.Fatal(err)
+		}
+		copy(w.buf.Bytes()[ptr:], sh.Bytes())
+	}
+
+	// the second pattern is empty, and not stored
+	want.PatternPointers = []ParaPointer32{w.pos(), 0}
+	want.Patterns = []PackedPattern{
+		{
+			Length:     uint16(len(testPatternData)),
+			Rows:       uint16(len(testPatternRows)),
+			Reserved04: [4]byte{1, 2, 3, 4},
+			Data:       testPatternData,
+		},
+		{Length: 64, Rows: 64, Data: make([]uint8, 64)},
+	}
+	p := want.Patterns[0]
+	w.write(p.Length, p.Rows, p.Reserved04, p.Data)
+
+	message := "first line\rsecond line\r\nlast line\x00"
+	h.MessageOffset = w.pos()
+	h.MessageLength = uint16(len(message))
+	w.buf.WriteString(message)
+	want.Message = "first line\nsecond line\nlast line"
+
+	head := &testWriter{t: t}
+	head.write(h, want.OrderList, want.InstrumentPointers, want.SamplePointers, want.PatternPointers)
+	data := w.buf.Bytes()
+	copy(data, head.buf.Bytes())
+	return data, want
+}
+
+func testInstrumentOld(name string, sample uint8) *IMPIInstrumentOld {
+	ins := &IMPIInstrumentOld{
+		Flags:              IMPIOldFlagUseVolumeEnvelope | IMPIOldFlagUseSustainVolumeLoop,
+		VolumeLoopStart:    1,
+		VolumeLoopEnd:      2,
+		SustainLoopStart:   1,
+		SustainLoopEnd:     1,
+		Fadeout:            0x80,
+		NewNoteAction:      NewNoteActionFade,
+		DuplicateNoteCheck: DuplicateNoteCheckOn,
+		TrackerVersion:     0x0100,
+		SampleCount:        1,
+	}
+	copy(ins.IMPI[:], "IMPI")
+	copy(ins.Filename[:], name+".iti")
+	copy(ins.Name[:], name)
+	for i := range ins.NoteSampleKeyboard {
+		ins.NoteSampleKeyboard[i] = NoteSample{Note: Note(i), Sample: sample}
+	}
+	for i := range ins.VolumeEnvelope {
+		ins.VolumeEnvelope[i] = uint8(64 - i*64/len(ins.VolumeEnvelope))
+	}
+	ins.NodePoints[0] = NodePoint16{Tick: 0, Magnitude: 64}
+	ins.NodePoints[1] = NodePoint16{Tick: 100, Magnitude: 32}
+	ins.NodePoints[2] = NodePoint16{Tick: 199, Magnitude: 0}
+	for i := 3; i < len(ins.NodePoints); i++ {
+		ins.NodePoints[i] = NodePoint16{Tick: 0xFF, Magnitude: 0xFF}
+	}
+	return ins
+}
+
+func testInstrument(name string, sample uint8) *IMPIInstrument {
+	ins := &IMPIInstrument{
+		NewNoteAction:          NewNoteActionOff,
+		DuplicateCheckType:     DuplicateCheckTypeNote,
+		DuplicateCheckAction:   DuplicateCheckActionFade,
+		Fadeout:                0x100,
+		PitchPanSeparation:     -8,
+		PitchPanCenter:         60,
+		GlobalVolume:           128,
+		DefaultPan:             PanValue(128 | 32),
+		RandomVolumeVariation:  10,
+		RandomPanVariation:     20,
+		TrackerVersion:         0x0214,
+		SampleCount:            1,
+		InitialFilterCutoff:    0x80 | 100,
+		InitialFilterResonance: 0x80 | 20,
+		MidiChannel:            1,
+		MidiProgram:            2,
+		MidiBank:               0x0304,
+	}
+	copy(ins.IMPI[:], "IMPI")
+	copy(ins.Filename[:], name+".iti")
+	copy(ins.Name[:], name)
+	for i := range ins.NoteSampleKeyboard {
+		ins.NoteSampleKeyboard[i] = NoteSample{Note: Note(119 - i), Sample: sample}
+	}
+	for e, env := range []*Envelope{&ins.VolumeEnvelope, &ins.PanningEnvelope, &ins.PitchEnvelope} {
+		env.Flags = EnvelopeFlagEnvelopeOn | EnvelopeFlagLoopOn
+		env.Count = 3
+		env.LoopBegin = 0
+		env.LoopEnd = 2
+		env.SustainLoopBegin = 1
+		env.SustainLoopEnd = 1
+		env.NodePoints[0] = NodePoint24{Y: int8(e * 10), Tick: 0}
+		env.NodePoints[1] = NodePoint24{Y: -32, Tick: 10}
+		env.NodePoints[2] = NodePoint24{Y: 32, Tick: 300}
+	}
+	return ins
+}
+
+func TestRead(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		cmwt    uint16
+		history bool
+	}{
+		{"old instruments", 0x0100, false},
+		{"new instruments", 0x0214, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data, want := testModule(t, tc.cmwt, tc.history)
+			got, err := Read(bytes.NewReader(data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got.Head, want.Head) {
+				t.Errorf("header:\ngot  %+v\nwant %+v", got.Head, want.Head)
+			}
+			if !reflect.DeepEqual(got.OrderList, want.OrderList) {
+				t.Errorf("orders %v, want %v", got.OrderList, want.OrderList)
+			}
+			if !reflect.DeepEqual(got.InstrumentPointers, want.InstrumentPointers) {
+				t.Errorf("instrument pointers %v, want %v", got.InstrumentPointers, want.InstrumentPointers)
+			}
+			if !reflect.DeepEqual(got.SamplePointers, want.SamplePointers) {
+				t.Errorf("sample pointers %v, want %v", got.SamplePointers, want.SamplePointers)
+			}
+			if !reflect.DeepEqual(got.PatternPointers, want.PatternPointers) {
+				t.Errorf("pattern pointers %v, want %v", got.PatternPointers, want.PatternPointers)
+			}
+			if len(got.Blocks) != len(want.Blocks) {
+				t.Fatalf("%d blocks, want %d", len(got.Blocks), len(want.Blocks))
+			}
+			for i := range want.Blocks {
+				if !reflect.DeepEqual(got.Blocks[i], want.Blocks[i]) {
+					t.Errorf("block %d:\ngot  %+v\nwant %+v", i, got.Blocks[i], want.Blocks[i])
+				}
+			}
+			if len(got.Instruments) != len(want.Instruments) {
+				t.Fatalf("%d instruments, want %d", len(got.Instruments), len(want.Instruments))
+			}
+			for i := range want.Instruments {
+				if !reflect.DeepEqual(got.Instruments[i], want.Instruments[i]) {
+					t.Errorf("instrument %d:\ngot  %+v\nwant %+v", i, got.Instruments[i], want.Instruments[i])
+				}
+			}
+			if !reflect.DeepEqual(got.Samples, want.Samples) {
+				t.Errorf("samples:\ngot  %+v\nwant %+v", got.Samples, want.Samples)
+			}
+			if !reflect.DeepEqual(got.Patterns, want.Patterns) {
+				t.Errorf("patterns:\ngot  %+v\nwant %+v", got.Patterns, want.Patterns)
+			}
+			if got.Message != want.Message {
+				t.Errorf("message %q, want %q", got.Message, want.Message)
+			}
+		})
+	}
+}
+
+func TestReadBadInstrument(t *testing.T) {
+	data, want := testModule(t, 0x0214, false)
+	copy(data[want.InstrumentPointers[1]:], "IMPX")
+	if _, err := Read(bytes.NewReader(data)); err != ErrInvalidFileFormat {
+		t.Fatalf("got error %v from a bad instrument, want %v", err, ErrInvalidFileFormat)
+	}
+}
+
+func TestReadChannelData(t *testing.T) {
+	p := PackedPattern{Length: uint16(len(testPatternData)), Rows: 2, Data: testPatternData}
+	var rowMem [64]ChannelData
+	pos := 0
+	for r, want := range testPatternRows {
+		var row []ChannelData
+		for {
+			n, cd, err := p.ReadChannelData(pos, rowMem[:])
+			if err != nil {
+				t.Fatal(err)
+			}
+			pos += n
+			if cd == nil {
+				break
+			}
+			row = append(row, *cd)
+		}
+		if !reflect.DeepEqual(row, want) {
+			t.Errorf("row %d:\ngot  %+v\nwant %+v", r, row, want)
+		}
+	}
+	if pos != len(testPatternData) {
+		t.Errorf("read %d bytes of %d", pos, len(testPatternData))
+	}
+}
+
+func TestReadEmptyPattern(t *testing.T) {
+	p, err := readPackedPattern(nil, ParaPointer32(0), 0x0214)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &PackedPattern{Length: 64, Rows: 64, Data: make([]uint8, 64)}
+	if !reflect.DeepEqual(p, want) {
+		t.Fatalf("got %+v, want %+v", p, want)
+	}
+	// every row of the pattern is empty
+	var rowMem [64]ChannelData
+	for pos, r := 0, 0; r < int(p.Rows); r++ {
+		n, cd, err := p.ReadChannelData(pos, rowMem[:])
+		if err != nil || n != 1 || cd != nil {
+			t.Fatalf("row %d: read %d bytes, %+v, %v", r, n, cd, err)
+		}
+		pos += n
+	}
+}
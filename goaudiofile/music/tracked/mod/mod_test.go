@@ -0,0 +1,199 @@
+package mod
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// testCell returns the channel data the test modules have at `row` of channel `ch` in pattern `pat`, which is
+// different for every pattern, row and channel so that data read from the wrong place shows up
+func testCell(pat, row, ch int) Channel {
+	inst := uint8(1 + (pat*7+row+ch)%31)
+	period := Period(113 + (pat*64*32+row*32+ch)%(856-113))
+	return Channel{
+		inst&0xF0 | uint8(period>>8)&0x0F,
+		uint8(period),
+		inst<<4 | uint8(row+ch)&0x0F,
+		uint8(pat<<5 | ch),
+	}
+}
+
+// testModule builds a module with signature `sig` for `channels` channels, along with the File that reading
+// it should give
+// The order list plays patterns 0 and 1 and has pattern 2 after the end of the song, which still has to be
+// read; Startrekker FLT8 files number their patterns in pairs of 4-channel halves.
+func testModule(t *testing.T, sig string, channels int, flt8 bool) ([]byte, *File) {
+	t.Helper()
+	const numPatterns = 3
+
+	var h ModuleHeader
+	copy(h.Name[:], "test "+sig)
+	samples := [][]byte{
+		{0, 10, 20, 30, 40, 50, 60, 70},
+		nil,
+		{0x80, 0xFF, 0x7F, 0x01},
+	}
+	for i, s := range samples {
+		ins := &h.Instrument[i]
+		copy(ins.Name[:], []byte{'s', 'a', 'm', 'p', 'l', 'e', byte('1' + i)})
+		ins.Len = NewWordLength(len(s))
+		ins.FineTune = uint8(i * 5)
+		ins.Volume = uint8(64 - i*10)
+		ins.LoopStart = NewWordLength(2 * i)
+		ins.LoopEnd = NewWordLength(2 + 2*i)
+	}
+	h.SongLen = 2
+	h.RestartPos = 127
+	orders := []uint8{0, 1, 2}
+	for i, o := range orders {
+		h.Order[i] = o
+		if flt8 {
+			h.Order[i] = o * 2
+		}
+	}
+	copy(h.Sig[:], sig)
+
+	var buf bytes.Buffer
+	if err := binary.Write(&buf, binary.LittleEndian, &h); err != nil {
+		t.Fatal(err)
+	}
+	want := &File{
+		Head:     h,
+		Patterns: make([]Pattern, numPatterns),
+		Samples:  make([]SampleData, len(h.Instrument)),
+	}
+	for i := range want.Samples {
+		want.Samples[i] = SampleData{}
+		if i < len(samples) && samples[i] != nil {
+			want.Samples[i] = samples[i]
+		}
+	}
+	// only the orders of the song are rectified in the header
+	for i := 0; i < int(h.SongLen); i++ {
+		want.Head.Order[i] = orders[i]
+	}
+
+	for p := 0; p < numPatterns; p++ {
+		want.Patterns[p] = NewPattern(channels)
+		for r, row := range want.Patterns[p] {
+			for c := range row {
+				row[c] = testCell(p, r, c)
+			}
+		}
+		write := func(from, to int) {
+			for _, row := range want.Patterns[p] {
+				for c := from; c < to; c++ {
+					buf.Write(row[c][:])
+				}
+			}
+		}
+		if flt8 {
+			write(0, 4)
+			write(4, 8)
+		} else {
+			write(0, channels)
+		}
+	}
+	for _, s := range samples {
+		buf.Write(s)
+	}
+	return buf.Bytes(), want
+}
+
+func TestReadSignatures(t *testing.T) {
+	sigs := make([]string, 0, len(signatureLookup))
+	for sig := range signatureLookup {
+		sigs = append(sigs, sig)
+	}
+	sort.Strings(sigs)
+
+	for _, sig := range sigs {
+		details := signatureLookup[sig]
+		t.Run(sig, func(t *testing.T) {
+			flt8 := details.format == startrekker && details.channels == 8
+			data, want := testModule(t, sig, details.channels, flt8)
+			got, err := Read(bytes.NewReader(data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got.Head, want.Head) {
+				t.Errorf("header:\ngot  %+v\nwant %+v", got.Head, want.Head)
+			}
+			if !reflect.DeepEqual(got.Patterns, want.Patterns) {
+				t.Errorf("patterns differ")
+				comparePatterns(t, got.Patterns, want.Patterns)
+			}
+			if !reflect.DeepEqual(got.Samples, want.Samples) {
+				t.Errorf("samples:\ngot  %v\nwant %v", got.Samples, want.Samples)
+			}
+		})
+	}
+}
+
+// comparePatterns reports the first cell that differs, to make failures easier to read
+func comparePatterns(t *testing.T, got, want []Pattern) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Errorf("%d patterns, want %d", len(got), len(want))
+		return
+	}
+	for p := range want {
+		for r := range want[p] {
+			for c := range want[p][r] {
+				if c >= len(got[p][r]) || got[p][r][c] != want[p][r][c] {
+					t.Errorf("pattern %d row %d channel %d differs", p, r, c)
+					return
+				}
+			}
+		}
+	}
+}
+
+func TestReadUnknownSignature(t *testing.T) {
+	data, _ := testModule(t, "ABCD", 4, false)
+	if _, err := Read(bytes.NewReader(data)); err == nil {
+		t.Fatal("read a module with an unknown signature")
+	}
+}
+
+func TestReadTruncated(t *testing.T) {
+	data, _ := testModule(t, "M.K.", 4, false)
+	if _, err := Read(bytes.NewReader(data[:len(data)-1])); err == nil {
+		t.Fatal("read a module with its last sample cut short")
+	}
+}
+
+func TestChannel(t *testing.T) {
+	c := Channel{0x1A, 0xBC, 0x2D, 0x47}
+	if got := c.Instrument(); got != 0x12 {
+		t.Errorf("instrument %#x, want 0x12", got)
+	}
+	if got := c.Period(); got != 0xABC {
+		t.Errorf("period %#x, want 0xABC", got)
+	}
+	if got := c.Effect(); got != 0xD {
+		t.Errorf("effect %#x, want 0xD", got)
+	}
+	if got := c.EffectParameter(); got != 0x47 {
+		t.Errorf("effect parameter %#x, want 0x47", got)
+	}
+}
+
+func TestWordLength(t *testing.T) {
+	for _, n := range []int{0, 2, 510, 131070} {
+		if got := NewWordLength(n).Value(); got != n {
+			t.Errorf("length %d came back as %d", n, got)
+		}
+	}
+	// the word count is stored big-endian
+	var buf bytes.Buffer
+	if err := binary.Write(&buf, binary.LittleEndian, NewWordLength(0x1234*2)); err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.Bytes(); got[0] != 0x12 || got[1] != 0x34 {
+		t.Errorf("stored as % x, want 12 34", got)
+	}
+}
@@ -8,3 +8,8 @@ func (m WordLength) Value() int {
 	v := BE16ToLE16(uint16(m))
 	return int(v) << 1
 }
+
+// NewWordLength returns the WordLength describing a length of `bytes` bytes
+func NewWordLength(bytes int) WordLength {
+	return WordLength(BE16ToLE16(uint16(bytes >> 1)))
+}
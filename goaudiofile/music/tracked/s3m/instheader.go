@@ -53,7 +53,7 @@ type SCRSAncillaryHeader interface{}
 
 // SCRSNoneHeader is the remaining header for S3M none-type instrument
 type SCRSNoneHeader struct {
-	Reserved0D [19]byte
+	Reserved0D [15]byte
 	Volume     Volume
 	Reserved1D [3]byte
 	C2Spd      HiLo32
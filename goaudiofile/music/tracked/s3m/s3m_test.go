@@ -0,0 +1,253 @@
+package s3m
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+)
+
+// testWriter builds a module whose instruments, samples and patterns start on paragraph boundaries
+type testWriter struct {
+	t   *testing.T
+	buf bytes.Buffer
+}
+
+func (w *testWriter) write(v interface{}) {
+	w.t.Helper()
+	if err := binary.Write(&w.buf, binary.LittleEndian, v); err != nil {
+		w.t.Fatal(err)
+	}
+}
+
+// paragraph pads the module to the next paragraph and returns a pointer to it
+func (w *testWriter) paragraph() ParaPointer16 {
+	for w.buf.Len()%16 != 0 {
+		w.buf.WriteByte(0)
+	}
+	return ParaPointer16(w.buf.Len() >> 4)
+}
+
+// testModule builds a module with a digiplayer instrument for each kind of sample data, an Adlib instrument and
+// an empty one, a packed pattern and a pattern that is not stored, along with the File that reading it should
+// give
+// The panning table is only written, and read, when `defaultPanning` is set.
+func testModule(t *testing.T, defaultPanning bool) ([]byte, *File) {
+	t.Helper()
+	w := &testWriter{t: t}
+
+	want := &File{
+		OrderList: []uint8{0, 1, 0, 254, 255, 255},
+	}
+	h := &want.Head
+	copy(h.Name[:], "test module")
+	h.Reserved1C = 0x1A
+	h.Type = 16
+	h.OrderCount = uint16(len(want.OrderList))
+	h.InstrumentCount = 4
+	h.PatternCount = 2
+	h.Flags = 0x08
+	h.TrackerVersion = 0x1320
+	h.FileFormatInformation = 2
+	copy(h.SCRM[:], "SCRM")
+	h.GlobalVolume = 48
+	h.InitialSpeed = 6
+	h.InitialTempo = 125
+	h.MixingVolume = 0xB0
+	h.UltraClickRemoval = 16
+	if defaultPanning {
+		h.DefaultPanValueFlag = 0xFC
+	}
+	for i := range want.ChannelSettings {
+		switch {
+		case i < 4:
+			want.ChannelSettings[i] = MakeChannelSetting(true, ChannelCategoryPCMLeft+ChannelCategory(i%2), i/2)
+		case i == 4:
+			want.ChannelSettings[i] = MakeChannelSetting(true, ChannelCategoryOPL2Melody, 0)
+		default:
+			want.ChannelSettings[i] = ChannelSetting(0xFF)
+		}
+	}
+	if defaultPanning {
+		for i := range want.Panning {
+			want.Panning[i] = PanningFlagValid | PanningFlags(i%16)
+		}
+	}
+
+	// the instruments, samples and patterns go after the header and its tables
+	headerLen := binary.Size(h) + len(want.ChannelSettings) + len(want.OrderList) +
+		2*int(h.InstrumentCount) + 2*int(h.PatternCount)
+	if defaultPanning {
+		headerLen += len(want.Panning)
+	}
+	w.buf.Write(make([]byte, headerLen))
+
+	mono8 := []uint8{0x80, 0x90, 0xA0, 0xB0, 0xC0}
+	stereo16 := []uint8{0x00, 0x80, 0xFF, 0x7F, 0x34, 0x12, 0xCD, 0xAB, 1, 2, 3, 4}
+
+	digi := func(name string, flags SCRSFlags, sampleLen int) SCRSFull {
+		d := &SCRSDigiplayerHeader{
+			Length:    HiLo32{Lo: uint16(sampleLen)},
+			LoopBegin: HiLo32{Lo: 1},
+			LoopEnd:   HiLo32{Lo: uint16(sampleLen)},
+			Volume:    DefaultVolume - 4,
+			Flags:     flags,
+			C2Spd:     HiLo32{Lo: uint16(DefaultC2Spd)},
+		}
+		copy(d.SampleName[:], name)
+		copy(d.SCRS[:], "SCRS")
+		s := SCRSFull{SCRS: SCRS{Head: SCRSHeader{Type: SCRSTypeDigiplayer}, Ancillary: d}}
+		copy(s.Head.Filename[:], name+".smp")
+		return s
+	}
+	adlib := &SCRSAdlibHeader{
+		OPL2: OPL2Specs{
+			Modulat0: 0x21, Carrier0: 0x31, Modulat1: 0x4F, Carrier1: 0x00, Modulat2: 0xF2,
+			Carrier2: 0xF2, Modulat3: 0x52, Carrier3: 0x73, Modulat4: 0x01, Carrier4: 0x02, Global: 0x0B,
+		},
+		Volume: 60,
+		C2Spd:  HiLo32{Lo: 0x2000, Hi: 1},
+	}
+	copy(adlib.SampleName[:], "adlib")
+	copy(adlib.SCRI[:], "SCRI")
+	none := &SCRSNoneHeader{
+		Volume: DefaultVolume,
+		C2Spd:  HiLo32{Lo: uint16(DefaultC2Spd)},
+	}
+	copy(none.SampleName[:], "(c) nobody")
+
+	want.Instruments = []SCRSFull{
+		digi("mono8", SCRSFlagsLooped, len(mono8)),
+		digi("stereo16", SCRSFlagsStereo|SCRSFlags16Bit, len(stereo16)/4),
+		{SCRS: SCRS{Head: SCRSHeader{Type: SCRSTypeOPL2Melody}, Ancillary: adlib}},
+		{SCRS: SCRS{Head: SCRSHeader{Type: SCRSTypeNone}, Ancillary: none}},
+	}
+	for i := range want.Instruments {
+		ins := &want.Instruments[i]
+		want.InstrumentPointers = append(want.InstrumentPointers, w.paragraph())
+		w.write(ins.Head)
+		w.write(ins.Ancillary)
+	}
+	for i, data := range [][]uint8{mono8, stereo16} {
+		ptr := w.paragraph()
+		want.Instruments[i].Ancillary.(*SCRSDigiplayerHeader).MemSeg = ParaPointer24{Lo: ptr}
+		want.Instruments[i].Sample = data
+		w.buf.Write(data)
+	}
+	// the sample pointers were only known once the samples were written
+	for i, ins := range want.Instruments {
+		pos := want.InstrumentPointers[i].Offset() + binary.Size(ins.Head)
+		var anc bytes.Buffer
+		if err := binary.Write(&anc, binary.LittleEndian, ins.Ancillary); err != nil {
+			t.Fatal(err)
+		}
+		copy(w.buf.Bytes()[pos:], anc.Bytes())
+	}
+
+	// C-5 with instrument 1 on channel 0, volume 32 on channel 1 and effect A06 on channel 2, then note off on
+	// channel 0, each row ending with a 0
+	packed := []uint8{
+		0x20 | 0, 0x40, 1,
+		0x40 | 1, 32,
+		0x80 | 2, 1, 6,
+		0,
+		0x20 | 0, uint8(StopNote), 0,
+		0,
+	}
+	want.PatternPointers = []ParaPointer16{w.paragraph(), 0}
+	w.write(uint16(len(packed) + 2))
+	w.buf.Write(packed)
+	want.Patterns = []PackedPattern{
+		{Length: uint16(len(packed) + 2), Data: packed},
+		{Length: 66, Data: make([]byte, 64)},
+	}
+
+	head := &testWriter{t: t}
+	head.write(h)
+	head.write(want.ChannelSettings)
+	head.write(want.OrderList)
+	head.write(want.InstrumentPointers)
+	head.write(want.PatternPointers)
+	if defaultPanning {
+		head.write(want.Panning)
+	}
+	data := w.buf.Bytes()
+	copy(data, head.buf.Bytes())
+	return data, want
+}
+
+func TestRead(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		defaultPanning bool
+	}{
+		{"default panning", true},
+		{"no default panning", false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data, want := testModule(t, tc.defaultPanning)
+			got, err := Read(bytes.NewReader(data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got.Head, want.Head) {
+				t.Errorf("header:\ngot  %+v\nwant %+v", got.Head, want.Head)
+			}
+			if got.ChannelSettings != want.ChannelSettings {
+				t.Errorf("channel settings:\ngot  %v\nwant %v", got.ChannelSettings, want.ChannelSettings)
+			}
+			if !reflect.DeepEqual(got.OrderList, want.OrderList) {
+				t.Errorf("orders %v, want %v", got.OrderList, want.OrderList)
+			}
+			if !reflect.DeepEqual(got.InstrumentPointers, want.InstrumentPointers) {
+				t.Errorf("instrument pointers %v, want %v", got.InstrumentPointers, want.InstrumentPointers)
+			}
+			if !reflect.DeepEqual(got.PatternPointers, want.PatternPointers) {
+				t.Errorf("pattern pointers %v, want %v", got.PatternPointers, want.PatternPointers)
+			}
+			if got.Panning != want.Panning {
+				t.Errorf("panning:\ngot  %v\nwant %v", got.Panning, want.Panning)
+			}
+			if len(got.Instruments) != len(want.Instruments) {
+				t.Fatalf("%d instruments, want %d", len(got.Instruments), len(want.Instruments))
+			}
+			for i := range want.Instruments {
+				if !reflect.DeepEqual(got.Instruments[i], want.Instruments[i]) {
+					t.Errorf("instrument %d:\ngot  %+v %+v\nwant %+v %+v", i, got.Instruments[i],
+						got.Instruments[i].Ancillary, want.Instruments[i], want.Instruments[i].Ancillary)
+				}
+			}
+			if !reflect.DeepEqual(got.Patterns, want.Patterns) {
+				t.Errorf("patterns:\ngot  %v\nwant %v", got.Patterns, want.Patterns)
+			}
+		})
+	}
+}
+
+func TestReadBadSignature(t *testing.T) {
+	data, _ := testModule(t, false)
+	copy(data[0x2C:], "SCRN")
+	if _, err := Read(bytes.NewReader(data)); err == nil {
+		t.Fatal("read a module without the SCRM signature")
+	}
+}
+
+func TestParaPointer(t *testing.T) {
+	if got := ParaPointer16(0x123).Offset(); got != 0x1230 {
+		t.Errorf("16-bit pointer to %#x, want 0x1230", got)
+	}
+	if got := (ParaPointer24{Hi: 0x01, Lo: 0x2345}).Offset(); got != 0x123450 {
+		t.Errorf("24-bit pointer to %#x, want 0x123450", got)
+	}
+}
+
+func TestChannelSetting(t *testing.T) {
+	cs := MakeChannelSetting(true, ChannelCategoryPCMRight, 3)
+	if !cs.IsEnabled() || !cs.IsPCM() || cs.IsOPL2() || cs.GetChannel() != ChannelIDR4 {
+		t.Errorf("PCM right 4 came back as %#x", uint8(cs))
+	}
+	cs = MakeChannelSetting(false, ChannelCategoryOPL2Drums, 1)
+	if cs.IsEnabled() || cs.IsPCM() || !cs.IsOPL2() || cs.GetChannel() != ChannelIDOPL2Drums2 {
+		t.Errorf("disabled OPL2 drums 2 came back as %#x", uint8(cs))
+	}
+}
@@ -0,0 +1,343 @@
+package sample
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+
+	"github.com/gotracker/goaudiofile/audio/aiff"
+	"github.com/gotracker/goaudiofile/audio/wav"
+)
+
+var (
+	// ErrUnsupportedSampleFormat is for when the audio data cannot be converted into a tracker sample
+	ErrUnsupportedSampleFormat = errors.New("unsupported sample format")
+)
+
+// FromWAV converts a WAV file into a tracker sample
+// Sample data deeper than 16 bits is reduced to 16 bits, and more than 2 channels are mixed down to mono.
+// A single smpl loop becomes the sample loop - when there are two, the first one is the sustain loop.
+func FromWAV(f *wav.File) (*Sample, error) {
+	channels := int(f.Fmt.Channels)
+	bits := int(f.Fmt.BitsPerSample)
+	if channels < 1 || f.Fmt.BlockAlign == 0 {
+		return nil, ErrUnsupportedSampleFormat
+	}
+
+	var decode func([]byte) float64
+	switch {
+	case f.Fmt.Format == wav.FormatPCM && bits == 8:
+		decode = func(b []byte) float64 { return float64(int(b[0])-128) / 128 }
+	case f.Fmt.Format == wav.FormatPCM && bits == 16:
+		decode = func(b []byte) float64 { return float64(int16(binary.LittleEndian.Uint16(b))) / 32768 }
+	case f.Fmt.Format == wav.FormatPCM && bits == 24:
+		decode = func(b []byte) float64 {
+			return float64(int32(uint32(b[0])<<8|uint32(b[1])<<16|uint32(b[2])<<24)) / 2147483648
+		}
+	case f.Fmt.Format == wav.FormatPCM && bits == 32:
+		decode = func(b []byte) float64 { return float64(int32(binary.LittleEndian.Uint32(b))) / 2147483648 }
+	case f.Fmt.Format == wav.FormatIEEEFloat && bits == 32:
+		decode = func(b []byte) float64 { return float64(math.Float32frombits(binary.LittleEndian.Uint32(b))) }
+	case f.Fmt.Format == wav.FormatIEEEFloat && bits == 64:
+		decode = func(b []byte) float64 { return math.Float64frombits(binary.LittleEndian.Uint64(b)) }
+	default:
+		return nil, ErrUnsupportedSampleFormat
+	}
+
+	outBits := 16
+	if bits == 8 {
+		outBits = 8
+	}
+	s := Sample{
+		Name:       f.GetInfo(wav.InfoName),
+		Filename:   f.GetInfo(wav.InfoSource),
+		SampleRate: int(f.Fmt.SampleRate),
+	}
+	s.setFrames(f.Data, int(f.Fmt.BlockAlign), channels, (bits+7)/8, outBits, decode)
+
+	var loops []Loop
+	for _, l := range f.Loops {
+		lp := Loop{
+			Mode:  LoopModeForward,
+			Begin: int(l.Start),
+			End:   int(l.End) + 1,
+		}
+		if l.Type == wav.LoopTypePingPong {
+			lp.Mode = LoopModePingPong
+		}
+		loops = append(loops, lp)
+	}
+	switch {
+	case len(loops) == 1:
+		s.Loop = loops[0]
+	case len(loops) > 1:
+		s.SustainLoop = loops[0]
+		s.Loop = loops[1]
+	}
+	s.clampLoops()
+
+	return &s, nil
+}
+
+// FromAIFF converts an AIFF file into a tracker sample
+// The sustain loop of the INST chunk becomes the sample loop, unless there is also a release loop,
+// in which case they become the sustain loop and the sample loop, respectively.
+func FromAIFF(f *aiff.File) (*Sample, error) {
+	channels := int(f.Common.Channels)
+	bits := int(f.Common.BitsPerSample)
+	if channels < 1 || bits < 1 || bits > 32 {
+		return nil, ErrUnsupportedSampleFormat
+	}
+	bytesPerSample := (bits + 7) / 8
+
+	var order binary.ByteOrder = binary.BigEndian
+	if f.LittleEndian {
+		order = binary.LittleEndian
+	}
+
+	// samples are left-justified, so reading the top bytes as a signed integer is sufficient
+	decode := func(b []byte) float64 {
+		var v int32
+		if order == binary.BigEndian {
+			for i := 0; i < bytesPerSample; i++ {
+				v |= int32(b[i]) << (24 - 8*i)
+			}
+		} else {
+			for i := 0; i < bytesPerSample; i++ {
+				v |= int32(b[bytesPerSample-1-i]) << (24 - 8*i)
+			}
+		}
+		return float64(v) / 2147483648
+	}
+
+	outBits := 16
+	if bits <= 8 {
+		outBits = 8
+	}
+	s := Sample{
+		Name:       f.Name,
+		SampleRate: int(math.Round(f.Common.SampleRate)),
+	}
+	data := f.Data
+	if maxLen := int(f.Common.Frames) * channels * bytesPerSample; len(data) > maxLen {
+		data = data[:maxLen]
+	}
+	s.setFrames(data, channels*bytesPerSample, channels, bytesPerSample, outBits, decode)
+
+	toLoop := func(l aiff.Loop) Loop {
+		switch l.PlayMode {
+		case aiff.PlayModeForward:
+			return Loop{Mode: LoopModeForward, Begin: l.Begin, End: l.End}
+		case aiff.PlayModeForwardBackward:
+			return Loop{Mode: LoopModePingPong, Begin: l.Begin, End: l.End}
+		default:
+			return Loop{}
+		}
+	}
+	s.Loop = toLoop(f.SustainLoop)
+	if release := toLoop(f.ReleaseLoop); release.Enabled() {
+		s.SustainLoop = s.Loop
+		s.Loop = release
+	}
+	s.clampLoops()
+
+	return &s, nil
+}
+
+// setFrames decodes interleaved frames with `decode` and stores them as `outBits` signed PCM,
+// mixing down to mono when there are more than 2 channels
+func (s *Sample) setFrames(data []byte, blockAlign int, channels int, bytesPerSample int, outBits int, decode func([]byte) float64) {
+	outChannels := channels
+	if outChannels > 2 {
+		outChannels = 1
+	}
+	s.Channels = outChannels
+	s.BitsPerSample = outBits
+
+	frames := len(data) / blockAlign
+	out := make([]byte, frames*outChannels*(outBits/8))
+	pos := 0
+	for i := 0; i < frames; i++ {
+		frame := data[i*blockAlign:]
+		for c := 0; c < outChannels; c++ {
+			var v float64
+			if outChannels == channels {
+				v = decode(frame[c*bytesPerSample:])
+			} else {
+				for ic := 0; ic < channels; ic++ {
+					v += decode(frame[ic*bytesPerSample:])
+				}
+				v /= float64(channels)
+			}
+			pos += putSample(out[pos:], v, outBits)
+		}
+	}
+	s.Data = out
+}
+
+// ConvertTo8Bit reduces 16-bit sample data to 8-bit
+func (s *Sample) ConvertTo8Bit() {
+	if s.BitsPerSample != 16 {
+		return
+	}
+	out := make([]byte, len(s.Data)/2)
+	for i := range out {
+		out[i] = s.Data[i*2+1]
+	}
+	s.Data = out
+	s.BitsPerSample = 8
+}
+
+// ConvertToMono mixes stereo sample data down to a single channel
+func (s *Sample) ConvertToMono() {
+	if s.Channels != 2 {
+		return
+	}
+	frames := s.Frames()
+	bps := s.BitsPerSample / 8
+	out := make([]byte, frames*bps)
+	for i := 0; i < frames; i++ {
+		l := s.getSample(i, 0)
+		r := s.getSample(i, 1)
+		putSample(out[i*bps:], (l+r)/2, s.BitsPerSample)
+	}
+	s.Data = out
+	s.Channels = 1
+}
+
+// Resample changes the sample rate of the sample data (and the loop points) to `rate` using linear interpolation
+// When the rate goes down, the data is low-pass filtered first, so that the frequencies the new rate cannot
+// hold do not alias.
+func (s *Sample) Resample(rate int) {
+	if rate <= 0 || s.SampleRate <= 0 || rate == s.SampleRate {
+		return
+	}
+	frames := s.Frames()
+	ratio := float64(s.SampleRate) / float64(rate)
+	get := s.getSample
+	if ratio > 1 {
+		filtered := s.lowPass(lowPassCutoff / ratio)
+		get = func(frame int, channel int) float64 {
+			return filtered[frame*s.Channels+channel]
+		}
+	}
+	outFrames := int(float64(frames) / ratio)
+	bps := s.BitsPerSample / 8
+	out := make([]byte, outFrames*s.Channels*bps)
+	pos := 0
+	for i := 0; i < outFrames; i++ {
+		src := float64(i) * ratio
+		i0 := int(src)
+		i1 := i0 + 1
+		if i1 >= frames {
+			i1 = frames - 1
+		}
+		t := src - float64(i0)
+		for c := 0; c < s.Channels; c++ {
+			v := get(i0, c)*(1-t) + get(i1, c)*t
+			pos += putSample(out[pos:], v, s.BitsPerSample)
+		}
+	}
+
+	scale := func(l *Loop) {
+		l.Begin = int(math.Round(float64(l.Begin) / ratio))
+		l.End = int(math.Round(float64(l.End) / ratio))
+	}
+	scale(&s.Loop)
+	scale(&s.SustainLoop)
+
+	s.Data = out
+	s.SampleRate = rate
+	s.clampLoops()
+}
+
+const (
+	// lowPassCutoff is where the low-pass filter of Resample starts to cut, as a fraction of the sample rate it
+	// resamples to, which leaves room under half of the rate for the filter to roll off
+	lowPassCutoff = 0.45
+	// lowPassZeroCrossings is the number of zero crossings of the sinc on each side of the low-pass filter
+	lowPassZeroCrossings = 16
+)
+
+// lowPass returns the sample data as interleaved values from -1 to 1, low-pass filtered at `cutoff` cycles
+// per frame with a Blackman-windowed sinc
+// Frames past either end of the data count as the first or last frame.
+func (s *Sample) lowPass(cutoff float64) []float64 {
+	half := int(math.Ceil(lowPassZeroCrossings / (2 * cutoff)))
+	taps := make([]float64, 2*half+1)
+	var sum float64
+	for i := range taps {
+		x := float64(i - half)
+		v := 2 * cutoff
+		if x != 0 {
+			v = math.Sin(2*math.Pi*cutoff*x) / (math.Pi * x)
+		}
+		phase := math.Pi * float64(i) / float64(half)
+		taps[i] = v * (0.42 - 0.5*math.Cos(phase) + 0.08*math.Cos(2*phase))
+		sum += taps[i]
+	}
+	// the filter lets DC through as it is
+	for i := range taps {
+		taps[i] /= sum
+	}
+
+	frames := s.Frames()
+	out := make([]float64, frames*s.Channels)
+	for i := 0; i < frames; i++ {
+		for c := 0; c < s.Channels; c++ {
+			var v float64
+			for j, t := range taps {
+				k := i + j - half
+				if k < 0 {
+					k = 0
+				} else if k >= frames {
+					k = frames - 1
+				}
+				v += t * s.getSample(k, c)
+			}
+			out[i*s.Channels+c] = v
+		}
+	}
+	return out
+}
+
+func (s *Sample) getSample(frame int, channel int) float64 {
+	bps := s.BitsPerSample / 8
+	pos := (frame*s.Channels + channel) * bps
+	if bps == 2 {
+		return float64(int16(binary.LittleEndian.Uint16(s.Data[pos:]))) / 32768
+	}
+	return float64(int8(s.Data[pos])) / 128
+}
+
+func (s *Sample) clampLoops() {
+	frames := s.Frames()
+	for _, l := range []*Loop{&s.Loop, &s.SustainLoop} {
+		if l.End > frames {
+			l.End = frames
+		}
+		if !l.Enabled() {
+			*l = Loop{}
+		}
+	}
+}
+
+func putSample(out []byte, v float64, bits int) int {
+	if bits == 16 {
+		binary.LittleEndian.PutUint16(out, uint16(int16(clamp(math.Round(v*32768), -32768, 32767))))
+		return 2
+	}
+	out[0] = uint8(int8(clamp(math.Round(v*128), -128, 127)))
+	return 1
+}
+
+func clamp(v, lo, hi float64) float64 {
+	switch {
+	case v < lo:
+		return lo
+	case v > hi:
+		return hi
+	default:
+		return v
+	}
+}
@@ -0,0 +1,287 @@
+package sample
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/gotracker/goaudiofile/audio/aiff"
+	"github.com/gotracker/goaudiofile/audio/wav"
+)
+
+// pcm16 returns `values` as little-endian 16-bit sample data
+func pcm16(values ...int16) []byte {
+	data := make([]byte, 2*len(values))
+	for i, v := range values {
+		binary.LittleEndian.PutUint16(data[2*i:], uint16(v))
+	}
+	return data
+}
+
+// pcm8 returns `values` as signed 8-bit sample data
+func pcm8(values ...int8) []byte {
+	data := make([]byte, len(values))
+	for i, v := range values {
+		data[i] = uint8(v)
+	}
+	return data
+}
+
+func TestFromWAV(t *testing.T) {
+	float32s := func(values ...float32) []byte {
+		data := make([]byte, 4*len(values))
+		for i, v := range values {
+			binary.LittleEndian.PutUint32(data[4*i:], math.Float32bits(v))
+		}
+		return data
+	}
+
+	tests := []struct {
+		name string
+		file wav.File
+		want Sample
+	}{
+		{
+			name: "8-bit mono",
+			file: wav.File{
+				Fmt:  wav.NewFmtChunk(wav.FormatPCM, 1, 8363, 8),
+				Data: []byte{0x80, 0xC0, 0x40, 0x00},
+				Info: []wav.InfoEntry{{ID: wav.InfoName, Value: "kick"}, {ID: wav.InfoSource, Value: "kick.wav"}},
+			},
+			want: Sample{Name: "kick", Filename: "kick.wav", SampleRate: 8363, Channels: 1, BitsPerSample: 8,
+				Data: pcm8(0, 64, -64, -128)},
+		},
+		{
+			name: "16-bit stereo with a loop",
+			file: wav.File{
+				Fmt:   wav.NewFmtChunk(wav.FormatPCM, 2, 44100, 16),
+				Data:  pcm16(1, -1, 1000, -1000, 32767, -32768),
+				Loops: []wav.SampleLoop{{Type: wav.LoopTypePingPong, Start: 1, End: 2}},
+			},
+			want: Sample{SampleRate: 44100, Channels: 2, BitsPerSample: 16, Data: pcm16(1, -1, 1000, -1000, 32767, -32768),
+				Loop: Loop{Mode: LoopModePingPong, Begin: 1, End: 3}},
+		},
+		{
+			name: "24-bit mono with sustain and release loops",
+			file: wav.File{
+				Fmt:  wav.NewFmtChunk(wav.FormatPCM, 1, 48000, 24),
+				Data: []byte{0x00, 0x00, 0x40, 0xFF, 0xFF, 0xFF, 0x00, 0x00, 0x80, 0x12, 0x34, 0x10},
+				Loops: []wav.SampleLoop{
+					{Type: wav.LoopTypeForward, Start: 0, End: 1},
+					{Type: wav.LoopTypeForward, Start: 2, End: 9},
+				},
+			},
+			want: Sample{SampleRate: 48000, Channels: 1, BitsPerSample: 16, Data: pcm16(0x4000, 0, -32768, 0x1034),
+				SustainLoop: Loop{Mode: LoopModeForward, Begin: 0, End: 2},
+				// the release loop is clamped to the end of the data
+				Loop: Loop{Mode: LoopModeForward, Begin: 2, End: 4}},
+		},
+		{
+			name: "float stereo",
+			file: wav.File{
+				Fmt:  wav.NewFmtChunk(wav.FormatIEEEFloat, 2, 22050, 32),
+				Data: float32s(0.5, -0.5, 2, -2),
+			},
+			want: Sample{SampleRate: 22050, Channels: 2, BitsPerSample: 16, Data: pcm16(16384, -16384, 32767, -32768)},
+		},
+		{
+			name: "16-bit quad",
+			file: wav.File{
+				Fmt:  wav.NewFmtChunk(wav.FormatPCM, 4, 44100, 16),
+				Data: pcm16(100, 200, 300, 400, -4, -4, -4, -4),
+			},
+			want: Sample{SampleRate: 44100, Channels: 1, BitsPerSample: 16, Data: pcm16(250, -4)},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := FromWAV(&tc.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(*got, tc.want) {
+				t.Errorf("got %+v, want %+v", *got, tc.want)
+			}
+		})
+	}
+
+	adpcm := wav.File{Fmt: wav.NewFmtChunk(wav.Format(2), 1, 22050, 4), Data: []byte{0}}
+	if _, err := FromWAV(&adpcm); !errors.Is(err, ErrUnsupportedSampleFormat) {
+		t.Errorf("got %v, want %v", err, ErrUnsupportedSampleFormat)
+	}
+}
+
+func TestFromAIFF(t *testing.T) {
+	tests := []struct {
+		name string
+		file aiff.File
+		want Sample
+	}{
+		{
+			name: "16-bit big-endian with a sustain loop",
+			file: aiff.File{
+				Common:      aiff.CommonChunk{Channels: 1, Frames: 3, BitsPerSample: 16, SampleRate: 22050},
+				Data:        []byte{0x01, 0x00, 0xFF, 0xFE, 0x7F, 0xFF},
+				Name:        "pad",
+				SustainLoop: aiff.Loop{PlayMode: aiff.PlayModeForward, Begin: 1, End: 3},
+			},
+			want: Sample{Name: "pad", SampleRate: 22050, Channels: 1, BitsPerSample: 16, Data: pcm16(256, -2, 32767),
+				Loop: Loop{Mode: LoopModeForward, Begin: 1, End: 3}},
+		},
+		{
+			name: "16-bit little-endian stereo",
+			file: aiff.File{
+				Common:       aiff.CommonChunk{Channels: 2, Frames: 1, BitsPerSample: 16, SampleRate: 44100},
+				LittleEndian: true,
+				Data:         []byte{0x00, 0x01, 0xFE, 0xFF},
+			},
+			want: Sample{SampleRate: 44100, Channels: 2, BitsPerSample: 16, Data: pcm16(256, -2)},
+		},
+		{
+			name: "8-bit with sustain and release loops",
+			file: aiff.File{
+				Common:      aiff.CommonChunk{Channels: 1, Frames: 4, BitsPerSample: 8, SampleRate: 8363},
+				Data:        []byte{0x10, 0xF0, 0x7F, 0x80},
+				SustainLoop: aiff.Loop{PlayMode: aiff.PlayModeForward, Begin: 0, End: 2},
+				ReleaseLoop: aiff.Loop{PlayMode: aiff.PlayModeForwardBackward, Begin: 2, End: 4},
+			},
+			want: Sample{SampleRate: 8363, Channels: 1, BitsPerSample: 8, Data: pcm8(16, -16, 127, -128),
+				SustainLoop: Loop{Mode: LoopModeForward, Begin: 0, End: 2},
+				Loop:        Loop{Mode: LoopModePingPong, Begin: 2, End: 4}},
+		},
+		{
+			name: "24-bit with data past the frame count",
+			file: aiff.File{
+				Common: aiff.CommonChunk{Channels: 1, Frames: 2, BitsPerSample: 24, SampleRate: 48000},
+				Data:   []byte{0x40, 0x00, 0x00, 0xC0, 0x00, 0x00, 0x12, 0x34, 0x56},
+			},
+			want: Sample{SampleRate: 48000, Channels: 1, BitsPerSample: 16, Data: pcm16(0x4000, -0x4000)},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := FromAIFF(&tc.file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(*got, tc.want) {
+				t.Errorf("got %+v, want %+v", *got, tc.want)
+			}
+		})
+	}
+}
+
+// TestWAVRoundTrip checks that a sample written as a WAV file reads back the same
+func TestWAVRoundTrip(t *testing.T) {
+	samples := []Sample{
+		{Name: "lead", Filename: "lead.wav", SampleRate: 8363, Channels: 1, BitsPerSample: 8,
+			Data: pcm8(0, 50, 100, 50, 0, -50, -100, -50), Loop: Loop{Mode: LoopModeForward, Begin: 2, End: 8}},
+		{SampleRate: 44100, Channels: 2, BitsPerSample: 16, Data: pcm16(1, 2, 3, 4, 5, 6, 7, 8),
+			SustainLoop: Loop{Mode: LoopModeForward, Begin: 0, End: 2},
+			Loop:        Loop{Mode: LoopModePingPong, Begin: 2, End: 4}},
+	}
+	for _, s := range samples {
+		buf := &bytes.Buffer{}
+		if err := s.WriteWAV(buf); err != nil {
+			t.Fatal(err)
+		}
+		f, err := wav.Read(buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := FromWAV(f)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(*got, s) {
+			t.Errorf("got %+v, want %+v", *got, s)
+		}
+	}
+}
+
+// sine returns a 16-bit mono sample of `frames` frames of a sine at `freq` Hz and amplitude 0.5
+func sine(rate int, freq float64, frames int) *Sample {
+	data := make([]byte, 2*frames)
+	for i := 0; i < frames; i++ {
+		v := 0.5 * math.Sin(2*math.Pi*freq*float64(i)/float64(rate))
+		binary.LittleEndian.PutUint16(data[2*i:], uint16(int16(math.Round(v*32768))))
+	}
+	return &Sample{SampleRate: rate, Channels: 1, BitsPerSample: 16, Data: data}
+}
+
+// rms returns the RMS level of the frames of `s` from `from` to `to`
+func rms(s *Sample, from, to int) float64 {
+	var sum float64
+	for i := from; i < to; i++ {
+		v := s.getSample(i, 0)
+		sum += v * v
+	}
+	return math.Sqrt(sum / float64(to-from))
+}
+
+func TestResample(t *testing.T) {
+	const frames = 4410
+	tests := []struct {
+		name       string
+		from, to   int
+		freq       float64
+		wantFrames int
+		// level is the RMS level the sine is expected to keep, and tolerance how far it can be from it
+		level, tolerance float64
+	}{
+		{name: "up", from: 22050, to: 44100, freq: 1000, wantFrames: 2 * frames,
+			level: 0.5 / math.Sqrt2, tolerance: 0.01},
+		{name: "down, under the new Nyquist", from: 44100, to: 11025, freq: 1000, wantFrames: frames / 4,
+			level: 0.5 / math.Sqrt2, tolerance: 0.01},
+		// without the low-pass, the sine would alias down to 1025 Hz at close to its full level
+		{name: "down, over the new Nyquist", from: 44100, to: 11025, freq: 10000, wantFrames: frames / 4,
+			level: 0, tolerance: 0.005},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s := sine(tc.from, tc.freq, frames)
+			s.Loop = Loop{Mode: LoopModeForward, Begin: 100, End: 4000}
+			s.Resample(tc.to)
+
+			if s.SampleRate != tc.to {
+				t.Errorf("sample rate is %d, want %d", s.SampleRate, tc.to)
+			}
+			if s.Frames() != tc.wantFrames {
+				t.Errorf("got %d frames, want %d", s.Frames(), tc.wantFrames)
+			}
+			ratio := float64(tc.from) / float64(tc.to)
+			wantLoop := Loop{Mode: LoopModeForward, Begin: int(math.Round(100 / ratio)), End: int(math.Round(4000 / ratio))}
+			if s.Loop != wantLoop {
+				t.Errorf("got loop %+v, want %+v", s.Loop, wantLoop)
+			}
+			// the ends are left out, where the filter runs past the data
+			edge := s.Frames() / 10
+			if level := rms(s, edge, s.Frames()-edge); math.Abs(level-tc.level) > tc.tolerance {
+				t.Errorf("RMS level is %.4f, want %.4f", level, tc.level)
+			}
+		})
+	}
+}
+
+// TestResampleRoundTrip checks that a sample resampled down and back up again keeps what is under the lower
+// Nyquist frequency
+func TestResampleRoundTrip(t *testing.T) {
+	const frames = 4410
+	orig := sine(44100, 440, frames)
+	s := orig.clone()
+	s.Resample(22050)
+	s.Resample(44100)
+	if s.Frames() != frames {
+		t.Fatalf("got %d frames, want %d", s.Frames(), frames)
+	}
+	var worst float64
+	for i := 100; i < frames-100; i++ {
+		worst = math.Max(worst, math.Abs(s.getSample(i, 0)-orig.getSample(i, 0)))
+	}
+	if worst > 0.01 {
+		t.Errorf("differs from the original by up to %.4f", worst)
+	}
+}
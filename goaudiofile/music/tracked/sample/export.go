@@ -0,0 +1,225 @@
+package sample
+
+import (
+	"github.com/gotracker/goaudiofile/music/tracked/it"
+	"github.com/gotracker/goaudiofile/music/tracked/mod"
+	"github.com/gotracker/goaudiofile/music/tracked/s3m"
+	"github.com/gotracker/goaudiofile/music/tracked/xm"
+)
+
+// FromMOD extracts sample number `num` (0-based) from the MOD file `f`
+func FromMOD(f *mod.File, num int) (*Sample, error) {
+	if num < 0 || num >= len(f.Head.Instrument) || num >= len(f.Samples) {
+		return nil, ErrSampleOutOfRange
+	}
+
+	inst := &f.Head.Instrument[num]
+
+	// the finetune is a signed nibble, in 1/8ths of a semitone
+	fine := int(inst.FineTune & 0x0F)
+	if fine >= 8 {
+		fine -= 16
+	}
+
+	s := Sample{
+		Name:          inst.GetName(),
+		SampleRate:    finetuneToRate(fine, 8),
+		Channels:      1,
+		BitsPerSample: 8,
+		Data:          append([]byte(nil), f.Samples[num]...),
+	}
+
+	// a loop with a length of a single word is the 'no loop' marker
+	loopBegin := inst.LoopStart.Value()
+	loopLen := inst.LoopEnd.Value()
+	if loopLen > 2 {
+		s.Loop = Loop{
+			Mode:  LoopModeForward,
+			Begin: loopBegin,
+			End:   loopBegin + loopLen,
+		}
+		if s.Loop.End > len(s.Data) {
+			s.Loop.End = len(s.Data)
+		}
+	}
+
+	return &s, nil
+}
+
+// FromS3M extracts instrument number `num` (0-based) from the S3M file `f`
+func FromS3M(f *s3m.File, num int) (*Sample, error) {
+	if num < 0 || num >= len(f.Instruments) {
+		return nil, ErrSampleOutOfRange
+	}
+
+	inst := &f.Instruments[num]
+	si, ok := inst.Ancillary.(*s3m.SCRSDigiplayerHeader)
+	if !ok {
+		return nil, ErrNotPCM
+	}
+	if si.PackingScheme != s3m.PackingUnpacked {
+		return nil, ErrCompressedSample
+	}
+
+	s := Sample{
+		Name:          si.GetSampleName(),
+		Filename:      inst.Head.GetFilename(),
+		SampleRate:    int(si.C2Spd.Lo),
+		Channels:      1,
+		BitsPerSample: 8,
+	}
+	if s.SampleRate == 0 {
+		s.SampleRate = int(s3m.DefaultC2Spd)
+	}
+	if si.Flags.IsStereo() {
+		s.Channels = 2
+	}
+	if si.Flags.Is16BitSample() {
+		s.BitsPerSample = 16
+	}
+
+	data := append([]byte(nil), inst.Sample...)
+	// 1 = signed samples, 2 = unsigned samples
+	if f.Head.FileFormatInformation != 1 {
+		if s.BitsPerSample == 16 {
+			flipSign16(data)
+		} else {
+			flipSign8(data)
+		}
+	}
+	s.Data = interleave(data, s.Channels, s.BitsPerSample/8)
+
+	if si.Flags.IsLooped() {
+		s.Loop = Loop{
+			Mode:  LoopModeForward,
+			Begin: int(si.LoopBegin.Lo),
+			End:   int(si.LoopEnd.Lo),
+		}
+	}
+
+	return &s, nil
+}
+
+// FromXM extracts sample number `num` (0-based) of instrument number `inst` (0-based) from the XM file `f`
+func FromXM(f *xm.File, inst int, num int) (*Sample, error) {
+	if inst < 0 || inst >= len(f.Instruments) {
+		return nil, ErrSampleOutOfRange
+	}
+	ih := &f.Instruments[inst]
+	if num < 0 || num >= len(ih.Samples) {
+		return nil, ErrSampleOutOfRange
+	}
+	sh := &ih.Samples[num]
+
+	// the relative note and the finetune combine into 1/128ths of a semitone
+	s := Sample{
+		Name:          sh.GetName(),
+		SampleRate:    finetuneToRate(int(sh.RelativeNoteNumber)*128+int(sh.Finetune), 128),
+		Channels:      1,
+		BitsPerSample: 8,
+	}
+	if sh.Flags.IsStereo() {
+		s.Channels = 2
+	}
+	if sh.Flags.Is16Bit() {
+		s.BitsPerSample = 16
+	}
+
+	// the reader has already undone the delta encoding
+	s.Data = interleave(append([]byte(nil), sh.SampleData...), s.Channels, s.BitsPerSample/8)
+
+	// loop points are stored in bytes of a single channel
+	bytesPerSample := s.BitsPerSample / 8
+	loopMode := LoopModeDisabled
+	switch sh.Flags.LoopMode() {
+	case xm.SampleLoopModeEnabled:
+		loopMode = LoopModeForward
+	case xm.SampleLoopModePingPong:
+		loopMode = LoopModePingPong
+	}
+	if loopMode != LoopModeDisabled && sh.LoopLength > 0 {
+		begin := int(sh.LoopStart) / bytesPerSample
+		s.Loop = Loop{
+			Mode:  loopMode,
+			Begin: begin,
+			End:   begin + int(sh.LoopLength)/bytesPerSample,
+		}
+	}
+
+	return &s, nil
+}
+
+// FromIT extracts sample number `num` (0-based) from the IT file `f`
+func FromIT(f *it.File, num int) (*Sample, error) {
+	if num < 0 || num >= len(f.Samples) {
+		return nil, ErrSampleOutOfRange
+	}
+
+	fs := &f.Samples[num]
+	sh := &fs.Header
+	if !sh.Flags.DoesSampleExist() {
+		return nil, ErrNotPCM
+	}
+	if sh.Flags.IsCompressed() {
+		return nil, ErrCompressedSample
+	}
+
+	s := Sample{
+		Name:          sh.GetName(),
+		Filename:      sh.GetFilename(),
+		SampleRate:    int(sh.C5Speed),
+		Channels:      1,
+		BitsPerSample: 8,
+	}
+	if sh.Flags.IsStereo() {
+		s.Channels = 2
+	}
+	if sh.Flags.Is16Bit() {
+		s.BitsPerSample = 16
+	}
+
+	data := append([]byte(nil), fs.Data...)
+	if s.BitsPerSample == 16 {
+		if sh.ConvertFlags.IsBigEndian() {
+			swapEndian16(data)
+		}
+		if !sh.ConvertFlags.IsSignedSamples() {
+			flipSign16(data)
+		}
+		if sh.ConvertFlags.IsSampleDelta() {
+			deltaDecode16(data)
+		}
+	} else {
+		if !sh.ConvertFlags.IsSignedSamples() {
+			flipSign8(data)
+		}
+		if sh.ConvertFlags.IsSampleDelta() {
+			deltaDecode8(data)
+		}
+	}
+	s.Data = interleave(data, s.Channels, s.BitsPerSample/8)
+
+	if sh.Flags.IsLoopEnabled() {
+		s.Loop = Loop{
+			Mode:  LoopModeForward,
+			Begin: int(sh.LoopBegin),
+			End:   int(sh.LoopEnd),
+		}
+		if sh.Flags.IsLoopPingPong() {
+			s.Loop.Mode = LoopModePingPong
+		}
+	}
+
+	if sh.Flags.IsSustainLoopEnabled() {
+		s.SustainLoop = Loop{
+			Mode:  LoopModeForward,
+			Begin: int(sh.SustainLoopBegin),
+			End:   int(sh.SustainLoopEnd),
+		}
+		if sh.Flags.IsSustainLoopPingPong() {
+			s.SustainLoop.Mode = LoopModePingPong
+		}
+	}
+
+	return &s, nil
+}
@@ -0,0 +1,312 @@
+package sample
+
+import (
+	"errors"
+	"math"
+
+	"github.com/gotracker/goaudiofile/music/tracked/it"
+	"github.com/gotracker/goaudiofile/music/tracked/mod"
+	"github.com/gotracker/goaudiofile/music/tracked/s3m"
+	"github.com/gotracker/goaudiofile/music/tracked/xm"
+)
+
+var (
+	// ErrSampleTooLong is for when the sample data does not fit in the length field of the target format
+	ErrSampleTooLong = errors.New("sample is too long for the target format")
+)
+
+const (
+	maxMODSampleBytes = 0xFFFF * 2
+	maxS3MFrames      = 0xFFFF
+	maxS3MC2Spd       = 0xFFFF
+)
+
+// ToMOD replaces sample number `num` (0-based) in the MOD file `f` with `s`
+// The data is converted to 8-bit mono, and the sample rate is stored as a finetune. When the rate
+// is more than a semitone away from the MOD base rate, the data is resampled to fit.
+func ToMOD(f *mod.File, num int, s *Sample) error {
+	if num < 0 || num >= len(f.Head.Instrument) || num >= len(f.Samples) {
+		return ErrSampleOutOfRange
+	}
+
+	c := s.clone()
+	c.ConvertToMono()
+	c.ConvertTo8Bit()
+
+	fine := rateToFinetune(c.SampleRate, 8)
+	if fine < -8 || fine > 7 {
+		fine = int(clamp(float64(fine), -8, 7))
+		c.Resample(finetuneToRate(fine, 8))
+	}
+
+	data := c.Data
+	if len(data)%2 != 0 {
+		data = append(data, 0)
+	}
+	if len(data) > maxMODSampleBytes {
+		return ErrSampleTooLong
+	}
+
+	inst := &f.Head.Instrument[num]
+	if inst.Len.Value() == 0 {
+		inst.Volume = 64
+	}
+	copyName(inst.Name[:], c.Name)
+	inst.Len = mod.NewWordLength(len(data))
+	inst.FineTune = uint8(fine) & 0x0F
+
+	// MOD only supports forward loops, which have to start and end on a word boundary
+	if c.Loop.Enabled() {
+		begin := c.Loop.Begin &^ 1
+		inst.LoopStart = mod.NewWordLength(begin)
+		inst.LoopEnd = mod.NewWordLength(c.Loop.End - begin)
+	} else {
+		inst.LoopStart = mod.NewWordLength(0)
+		inst.LoopEnd = mod.NewWordLength(2)
+	}
+
+	f.Samples[num] = data
+	return nil
+}
+
+// ToS3M replaces instrument number `num` (0-based) in the S3M file `f` with `s`, or adds it as a
+// new instrument when `num` is equal to the number of instruments
+// The data is mixed down to mono and stored with the signedness the file declares.
+func ToS3M(f *s3m.File, num int, s *Sample) error {
+	if num < 0 || num > len(f.Instruments) {
+		return ErrSampleOutOfRange
+	}
+
+	c := s.clone()
+	c.ConvertToMono()
+	if c.SampleRate > maxS3MC2Spd {
+		c.Resample(maxS3MC2Spd)
+	}
+	frames := c.Frames()
+	if frames > maxS3MFrames {
+		return ErrSampleTooLong
+	}
+
+	data := c.Data
+	// 1 = signed samples, 2 = unsigned samples
+	if f.Head.FileFormatInformation != 1 {
+		if c.BitsPerSample == 16 {
+			flipSign16(data)
+		} else {
+			flipSign8(data)
+		}
+	}
+
+	si := s3m.SCRSDigiplayerHeader{
+		Volume: s3m.DefaultVolume,
+	}
+	if num < len(f.Instruments) {
+		if old, ok := f.Instruments[num].Ancillary.(*s3m.SCRSDigiplayerHeader); ok {
+			si.MemSeg = old.MemSeg
+			si.Volume = old.Volume
+		}
+	}
+	si.Length = s3m.HiLo32{Lo: uint16(frames)}
+	si.PackingScheme = s3m.PackingUnpacked
+	si.C2Spd = s3m.HiLo32{Lo: uint16(c.SampleRate)}
+	if c.BitsPerSample == 16 {
+		si.Flags |= s3m.SCRSFlags16Bit
+	}
+	// ST3 only supports forward loops
+	if c.Loop.Enabled() {
+		si.Flags |= s3m.SCRSFlagsLooped
+		si.LoopBegin = s3m.HiLo32{Lo: uint16(c.Loop.Begin)}
+		si.LoopEnd = s3m.HiLo32{Lo: uint16(c.Loop.End)}
+	}
+	copyName(si.SampleName[:], c.Name)
+	copy(si.SCRS[:], "SCRS")
+
+	inst := s3m.SCRSFull{
+		SCRS: s3m.SCRS{
+			Head: s3m.SCRSHeader{
+				Type: s3m.SCRSTypeDigiplayer,
+			},
+			Ancillary: &si,
+		},
+		Sample: data,
+	}
+	copyName(inst.Head.Filename[:], c.Filename)
+
+	if num == len(f.Instruments) {
+		f.Instruments = append(f.Instruments, inst)
+		f.InstrumentPointers = append(f.InstrumentPointers, 0)
+		f.Head.InstrumentCount = uint16(len(f.Instruments))
+		return nil
+	}
+	f.Instruments[num] = inst
+	return nil
+}
+
+// ToXM replaces sample number `num` (0-based) of instrument number `inst` (0-based) in the XM file `f`
+// with `s`, or adds it as a new sample of the instrument when `num` is equal to its number of samples
+// The data is mixed down to mono, and the sample rate is stored as a relative note and finetune.
+func ToXM(f *xm.File, inst int, num int, s *Sample) error {
+	if inst < 0 || inst >= len(f.Instruments) {
+		return ErrSampleOutOfRange
+	}
+	ih := &f.Instruments[inst]
+	if num < 0 || num > len(ih.Samples) {
+		return ErrSampleOutOfRange
+	}
+
+	c := s.clone()
+	c.ConvertToMono()
+
+	// split the tuning (in 1/128ths of a semitone) into the relative note and the finetune
+	tune := rateToFinetune(c.SampleRate, 128)
+	rel := int(clamp(math.Round(float64(tune)/128), -128, 127))
+	fine := int(clamp(float64(tune-rel*128), -128, 127))
+
+	sh := xm.SampleHeader{
+		Volume:  64,
+		Panning: 128,
+	}
+	if num < len(ih.Samples) {
+		sh.Volume = ih.Samples[num].Volume
+		sh.Panning = ih.Samples[num].Panning
+	}
+	sh.Length = uint32(len(c.Data))
+	sh.Finetune = int8(fine)
+	sh.RelativeNoteNumber = int8(rel)
+	if c.BitsPerSample == 16 {
+		sh.Flags |= xm.SampleFlag16Bit
+	}
+	if c.Loop.Enabled() {
+		bps := uint32(c.BitsPerSample / 8)
+		sh.LoopStart = uint32(c.Loop.Begin) * bps
+		sh.LoopLength = uint32(c.Loop.End-c.Loop.Begin) * bps
+		if c.Loop.Mode == LoopModePingPong {
+			sh.Flags |= xm.SampleFlags(xm.SampleLoopModePingPong)
+		} else {
+			sh.Flags |= xm.SampleFlags(xm.SampleLoopModeEnabled)
+		}
+	}
+	copyName(sh.Name[:], c.Name)
+	sh.SampleData = c.Data
+
+	if num == len(ih.Samples) {
+		ih.Samples = append(ih.Samples, sh)
+		ih.SamplesCount = uint16(len(ih.Samples))
+		if ih.SampleHeaderSize == 0 {
+			ih.SampleHeaderSize = 40
+		}
+		return nil
+	}
+	ih.Samples[num] = sh
+	return nil
+}
+
+// ToIT replaces sample number `num` (0-based) in the IT file `f` with `s`, or adds it as a new sample
+// when `num` is equal to the number of samples
+func ToIT(f *it.File, num int, s *Sample) error {
+	if num < 0 || num > len(f.Samples) {
+		return ErrSampleOutOfRange
+	}
+
+	c := s.clone()
+
+	sh := it.Sample{
+		GlobalVolume: it.DefaultVolume,
+		Volume:       it.DefaultVolume,
+		DefaultPan:   32,
+	}
+	if num < len(f.Samples) {
+		old := &f.Samples[num].Header
+		sh.GlobalVolume = old.GlobalVolume
+		sh.Volume = old.Volume
+		sh.DefaultPan = old.DefaultPan
+		sh.SamplePointer = old.SamplePointer
+		sh.VibratoSpeed = old.VibratoSpeed
+		sh.VibratoDepth = old.VibratoDepth
+		sh.VibratoSweep = old.VibratoSweep
+		sh.VibratoType = old.VibratoType
+	}
+	copy(sh.IMPS[:], "IMPS")
+	copyName(sh.Filename[:], c.Filename)
+	copyName(sh.Name[:], c.Name)
+	sh.Flags = it.SampleFlagSampleExists
+	if c.BitsPerSample == 16 {
+		sh.Flags |= it.SampleFlag16Bit
+	}
+	if c.Channels == 2 {
+		sh.Flags |= it.SampleFlagStereo
+	}
+	if c.Loop.Enabled() {
+		sh.Flags |= it.SampleFlagUseLoop
+		if c.Loop.Mode == LoopModePingPong {
+			sh.Flags |= it.SampleFlagPingPongLoop
+		}
+		sh.LoopBegin = uint32(c.Loop.Begin)
+		sh.LoopEnd = uint32(c.Loop.End)
+	}
+	if c.SustainLoop.Enabled() {
+		sh.Flags |= it.SampleFlagUseSustainLoop
+		if c.SustainLoop.Mode == LoopModePingPong {
+			sh.Flags |= it.SampleFlagPingPongSustainLoop
+		}
+		sh.SustainLoopBegin = uint32(c.SustainLoop.Begin)
+		sh.SustainLoopEnd = uint32(c.SustainLoop.End)
+	}
+	sh.ConvertFlags = it.ConvertFlagSignedSamples
+	sh.Length = uint32(c.Frames())
+	sh.C5Speed = uint32(c.SampleRate)
+
+	fs := it.FullSample{
+		Header: sh,
+		Data:   deinterleave(c.Data, c.Channels, c.BitsPerSample/8),
+	}
+
+	if num == len(f.Samples) {
+		f.Samples = append(f.Samples, fs)
+		f.SamplePointers = append(f.SamplePointers, 0)
+		f.Head.SampleCount = uint16(len(f.Samples))
+		return nil
+	}
+	f.Samples[num] = fs
+	return nil
+}
+
+func (s *Sample) clone() *Sample {
+	c := *s
+	c.Data = append([]byte(nil), s.Data...)
+	return &c
+}
+
+// rateToFinetune converts a playback rate into a tuning offset in 1/`stepsPerSemitone`ths of a semitone
+func rateToFinetune(rate int, stepsPerSemitone int) int {
+	if rate <= 0 {
+		return 0
+	}
+	return int(math.Round(math.Log2(float64(rate)/DefaultC2Spd) * float64(12*stepsPerSemitone)))
+}
+
+// deinterleave converts interleaved sample data into planar sample data
+func deinterleave(data []byte, channels int, bytesPerSample int) []byte {
+	if channels < 2 {
+		return data
+	}
+	frames := len(data) / (channels * bytesPerSample)
+	planeLen := frames * bytesPerSample
+	out := make([]byte, planeLen*channels)
+	for i := 0; i < frames; i++ {
+		for c := 0; c < channels; c++ {
+			src := (i*channels + c) * bytesPerSample
+			dst := c*planeLen + i*bytesPerSample
+			copy(out[dst:dst+bytesPerSample], data[src:src+bytesPerSample])
+		}
+	}
+	return out
+}
+
+// copyName copies a string into a fixed length, zero-padded name field
+func copyName(dst []byte, name string) {
+	for i := range dst {
+		dst[i] = 0
+	}
+	copy(dst, name)
+}
@@ -0,0 +1,203 @@
+package sample
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/gotracker/goaudiofile/music/tracked/it"
+	"github.com/gotracker/goaudiofile/music/tracked/mod"
+	"github.com/gotracker/goaudiofile/music/tracked/s3m"
+	"github.com/gotracker/goaudiofile/music/tracked/xm"
+)
+
+// stereoSample returns a 16-bit stereo sample whose channels are both `values`, shifted up to 16 bits, so
+// that it converts to 8-bit mono without rounding
+func stereoSample(rate int, values ...int8) *Sample {
+	var data []int16
+	for _, v := range values {
+		data = append(data, int16(v)<<8, int16(v)<<8)
+	}
+	return &Sample{
+		Name:          "lead",
+		Filename:      "lead.wav",
+		SampleRate:    rate,
+		Channels:      2,
+		BitsPerSample: 16,
+		Data:          pcm16(data...),
+	}
+}
+
+func TestToMOD(t *testing.T) {
+	f := &mod.File{Samples: make([]mod.SampleData, len(mod.ModuleHeader{}.Instrument))}
+	s := stereoSample(DefaultC2Spd, 0, 10, 20, 30, 20, 10)
+	s.Loop = Loop{Mode: LoopModePingPong, Begin: 2, End: 6}
+	if err := ToMOD(f, 3, s); err != nil {
+		t.Fatal(err)
+	}
+	got, err := FromMOD(f, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Sample{
+		Name:          "lead",
+		SampleRate:    DefaultC2Spd,
+		Channels:      1,
+		BitsPerSample: 8,
+		Data:          pcm8(0, 10, 20, 30, 20, 10),
+		// MOD only has forward loops
+		Loop: Loop{Mode: LoopModeForward, Begin: 2, End: 6},
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("got %+v, want %+v", *got, want)
+	}
+	if v := f.Head.Instrument[3].Volume; v != 64 {
+		t.Errorf("volume of a new sample is %d, want 64", v)
+	}
+}
+
+// TestToMODRate checks that a rate more than a semitone from the MOD base rate is resampled to the nearest
+// finetune
+func TestToMODRate(t *testing.T) {
+	f := &mod.File{Samples: make([]mod.SampleData, len(mod.ModuleHeader{}.Instrument))}
+	if err := ToMOD(f, 0, sine(22050, 440, 2205)); err != nil {
+		t.Fatal(err)
+	}
+	got, err := FromMOD(f, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rate := finetuneToRate(7, 8)
+	if got.SampleRate != rate {
+		t.Errorf("rate is %d, want %d", got.SampleRate, rate)
+	}
+	if frames := 2205 * rate / 22050; got.Frames() < frames || got.Frames() > frames+1 {
+		t.Errorf("got %d frames, want %d", got.Frames(), frames)
+	}
+}
+
+func TestToMODErrors(t *testing.T) {
+	f := &mod.File{Samples: make([]mod.SampleData, len(mod.ModuleHeader{}.Instrument))}
+	if err := ToMOD(f, 31, sine(DefaultC2Spd, 440, 10)); !errors.Is(err, ErrSampleOutOfRange) {
+		t.Errorf("got %v, want %v", err, ErrSampleOutOfRange)
+	}
+	if err := ToMOD(f, 0, sine(DefaultC2Spd, 440, maxMODSampleBytes+2)); !errors.Is(err, ErrSampleTooLong) {
+		t.Errorf("got %v, want %v", err, ErrSampleTooLong)
+	}
+}
+
+func TestToS3M(t *testing.T) {
+	for _, format := range []uint16{1, 2} {
+		f := &s3m.File{}
+		f.Head.FileFormatInformation = format
+		s := stereoSample(16000, 0, -10, 20, -30)
+		s.Loop = Loop{Mode: LoopModeForward, Begin: 1, End: 4}
+		if err := ToS3M(f, 0, s); err != nil {
+			t.Fatal(err)
+		}
+		if f.Head.InstrumentCount != 1 || len(f.InstrumentPointers) != 1 {
+			t.Errorf("format %d: %d instruments and %d pointers, want 1", format, f.Head.InstrumentCount,
+				len(f.InstrumentPointers))
+		}
+		got, err := FromS3M(f, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := Sample{
+			Name:          "lead",
+			Filename:      "lead.wav",
+			SampleRate:    16000,
+			Channels:      1,
+			BitsPerSample: 16,
+			Data:          pcm16(0, -10<<8, 20<<8, -30<<8),
+			Loop:          Loop{Mode: LoopModeForward, Begin: 1, End: 4},
+		}
+		if !reflect.DeepEqual(*got, want) {
+			t.Errorf("format %d: got %+v, want %+v", format, *got, want)
+		}
+	}
+
+	f := &s3m.File{}
+	if err := ToS3M(f, 0, sine(96000, 440, 960)); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := FromS3M(f, 0); got.SampleRate != maxS3MC2Spd {
+		t.Errorf("rate is %d, want %d", got.SampleRate, maxS3MC2Spd)
+	}
+	if err := ToS3M(f, 2, sine(8363, 440, 10)); !errors.Is(err, ErrSampleOutOfRange) {
+		t.Errorf("got %v, want %v", err, ErrSampleOutOfRange)
+	}
+}
+
+func TestToXM(t *testing.T) {
+	f := &xm.File{Instruments: make([]xm.InstrumentHeader, 1)}
+	s := stereoSample(2*DefaultC2Spd, 0, 10, 20, 30)
+	s.Loop = Loop{Mode: LoopModePingPong, Begin: 1, End: 3}
+	if err := ToXM(f, 0, 0, s); err != nil {
+		t.Fatal(err)
+	}
+	if ih := f.Instruments[0]; ih.SamplesCount != 1 || ih.SampleHeaderSize != 40 {
+		t.Errorf("instrument has %d samples and a sample header size of %d", ih.SamplesCount, ih.SampleHeaderSize)
+	}
+	sh := f.Instruments[0].Samples[0]
+	if sh.RelativeNoteNumber != 12 || sh.Finetune != 0 {
+		t.Errorf("tuned to note %+d, finetune %+d, want an octave up", sh.RelativeNoteNumber, sh.Finetune)
+	}
+	got, err := FromXM(f, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Sample{
+		Name:          "lead",
+		SampleRate:    2 * DefaultC2Spd,
+		Channels:      1,
+		BitsPerSample: 16,
+		Data:          pcm16(0, 10<<8, 20<<8, 30<<8),
+		Loop:          Loop{Mode: LoopModePingPong, Begin: 1, End: 3},
+	}
+	if !reflect.DeepEqual(*got, want) {
+		t.Errorf("got %+v, want %+v", *got, want)
+	}
+
+	if err := ToXM(f, 1, 0, s); !errors.Is(err, ErrSampleOutOfRange) {
+		t.Errorf("got %v, want %v", err, ErrSampleOutOfRange)
+	}
+	if err := ToXM(f, 0, 2, s); !errors.Is(err, ErrSampleOutOfRange) {
+		t.Errorf("got %v, want %v", err, ErrSampleOutOfRange)
+	}
+}
+
+func TestToIT(t *testing.T) {
+	f := &it.File{}
+	s := &Sample{
+		Name:          "pad",
+		Filename:      "pad.wav",
+		SampleRate:    44100,
+		Channels:      2,
+		BitsPerSample: 16,
+		Data:          pcm16(1, -1, 2, -2, 3, -3, 4, -4),
+		SustainLoop:   Loop{Mode: LoopModeForward, Begin: 0, End: 2},
+		Loop:          Loop{Mode: LoopModePingPong, Begin: 1, End: 4},
+	}
+	if err := ToIT(f, 0, s); err != nil {
+		t.Fatal(err)
+	}
+	if f.Head.SampleCount != 1 || len(f.SamplePointers) != 1 {
+		t.Errorf("%d samples and %d pointers, want 1", f.Head.SampleCount, len(f.SamplePointers))
+	}
+	// IT keeps the channels one after the other
+	if want := pcm16(1, 2, 3, 4, -1, -2, -3, -4); !reflect.DeepEqual(f.Samples[0].Data, want) {
+		t.Errorf("stored % x, want % x", f.Samples[0].Data, want)
+	}
+	got, err := FromIT(f, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(*got, *s) {
+		t.Errorf("got %+v, want %+v", *got, *s)
+	}
+
+	if err := ToIT(f, 2, s); !errors.Is(err, ErrSampleOutOfRange) {
+		t.Errorf("got %v, want %v", err, ErrSampleOutOfRange)
+	}
+}
@@ -0,0 +1,185 @@
+package sample
+
+import (
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+
+	"github.com/gotracker/goaudiofile/audio/wav"
+)
+
+var (
+	// ErrSampleOutOfRange is for when a sample number does not exist in the file
+	ErrSampleOutOfRange = errors.New("sample number out of range")
+	// ErrNotPCM is for when the requested sample is not backed by PCM data (e.g.: an Adlib/OPL2 instrument)
+	ErrNotPCM = errors.New("sample is not a pcm sample")
+	// ErrCompressedSample is for when the sample data is stored compressed and cannot be used directly
+	ErrCompressedSample = errors.New("compressed samples are not supported")
+)
+
+// DefaultC2Spd is the playback rate of an untuned tracker sample at its base note
+const DefaultC2Spd = 8363
+
+// LoopMode is the way a sample loop repeats
+type LoopMode uint8
+
+const (
+	// LoopModeDisabled is no loop
+	LoopModeDisabled = LoopMode(iota)
+	// LoopModeForward repeats from the loop begin once the loop end is reached
+	LoopModeForward
+	// LoopModePingPong reverses direction every time the loop begin or end is reached
+	LoopModePingPong
+)
+
+// Loop is a sample loop, in frames
+// The End position is exclusive (it is the first frame after the loop)
+type Loop struct {
+	Mode  LoopMode
+	Begin int
+	End   int
+}
+
+// Enabled returns true if the loop is active
+func (l Loop) Enabled() bool {
+	return l.Mode != LoopModeDisabled && l.End > l.Begin
+}
+
+// Sample is a tracker module sample in a format-neutral representation
+// Data is interleaved (one frame per channel) signed PCM: 8-bit samples are stored as int8 values
+// and 16-bit samples are stored as little-endian int16 values
+type Sample struct {
+	Name          string
+	Filename      string
+	SampleRate    int
+	Channels      int
+	BitsPerSample int
+	Data          []byte
+	Loop          Loop
+	SustainLoop   Loop
+}
+
+// BytesPerFrame returns the size of a single multi-channel frame of sample data
+func (s *Sample) BytesPerFrame() int {
+	return s.Channels * (s.BitsPerSample / 8)
+}
+
+// Frames returns the number of frames in the sample data
+func (s *Sample) Frames() int {
+	bpf := s.BytesPerFrame()
+	if bpf == 0 {
+		return 0
+	}
+	return len(s.Data) / bpf
+}
+
+// WAV converts the sample into a WAV file representation
+func (s *Sample) WAV() *wav.File {
+	f := wav.File{
+		Fmt:  wav.NewFmtChunk(wav.FormatPCM, s.Channels, s.SampleRate, s.BitsPerSample),
+		Data: make([]byte, len(s.Data)),
+	}
+
+	copy(f.Data, s.Data)
+	if s.BitsPerSample == 8 {
+		// WAV stores 8-bit samples as unsigned
+		flipSign8(f.Data)
+	}
+
+	// the sustain loop goes first, since it is the one a sampler plays while the key is held
+	for _, l := range []Loop{s.SustainLoop, s.Loop} {
+		if !l.Enabled() {
+			continue
+		}
+		wl := wav.SampleLoop{
+			CuePointID: uint32(len(f.Loops)),
+			Type:       wav.LoopTypeForward,
+			Start:      uint32(l.Begin),
+			End:        uint32(l.End - 1),
+		}
+		if l.Mode == LoopModePingPong {
+			wl.Type = wav.LoopTypePingPong
+		}
+		f.Loops = append(f.Loops, wl)
+	}
+
+	if len(f.Loops) != 0 && s.SampleRate > 0 {
+		f.Sampler = &wav.SamplerChunk{
+			SamplePeriod:  uint32(math.Round(1e9 / float64(s.SampleRate))),
+			MIDIUnityNote: 60,
+		}
+	}
+
+	if s.Name != "" {
+		f.Info = append(f.Info, wav.InfoEntry{ID: wav.InfoName, Value: s.Name})
+	}
+	if s.Filename != "" {
+		f.Info = append(f.Info, wav.InfoEntry{ID: wav.InfoSource, Value: s.Filename})
+	}
+
+	return &f
+}
+
+// WriteWAV writes the sample to the writer `w` as a RIFF WAV file
+func (s *Sample) WriteWAV(w io.Writer) error {
+	return wav.Write(w, s.WAV())
+}
+
+// finetuneToRate converts a tuning offset in 1/`stepsPerSemitone`ths of a semitone into a playback rate
+func finetuneToRate(fine int, stepsPerSemitone int) int {
+	return int(math.Round(DefaultC2Spd * math.Pow(2, float64(fine)/float64(12*stepsPerSemitone))))
+}
+
+// interleave converts planar (all of the left channel, then all of the right channel) sample data
+// into interleaved sample data
+func interleave(data []byte, channels int, bytesPerSample int) []byte {
+	if channels < 2 {
+		return data
+	}
+	planeLen := len(data) / channels
+	frames := planeLen / bytesPerSample
+	out := make([]byte, frames*channels*bytesPerSample)
+	for i := 0; i < frames; i++ {
+		for c := 0; c < channels; c++ {
+			src := c*planeLen + i*bytesPerSample
+			dst := (i*channels + c) * bytesPerSample
+			copy(out[dst:dst+bytesPerSample], data[src:src+bytesPerSample])
+		}
+	}
+	return out
+}
+
+func flipSign8(data []byte) {
+	for i := range data {
+		data[i] ^= 0x80
+	}
+}
+
+func flipSign16(data []byte) {
+	for i := 0; i+1 < len(data); i += 2 {
+		data[i+1] ^= 0x80
+	}
+}
+
+func swapEndian16(data []byte) {
+	for i := 0; i+1 < len(data); i += 2 {
+		data[i], data[i+1] = data[i+1], data[i]
+	}
+}
+
+func deltaDecode8(data []byte) {
+	old := int8(0)
+	for i, s := range data {
+		old += int8(s)
+		data[i] = uint8(old)
+	}
+}
+
+func deltaDecode16(data []byte) {
+	old := int16(0)
+	for i := 0; i+1 < len(data); i += 2 {
+		old += int16(binary.LittleEndian.Uint16(data[i:]))
+		binary.LittleEndian.PutUint16(data[i:], uint16(old))
+	}
+}
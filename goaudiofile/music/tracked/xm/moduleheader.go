@@ -39,7 +39,7 @@ func (mh *ModuleHeader) GetName() string {
 
 // GetTrackerName returns a string representation of the data stored in the TrackerName field
 func (mh *ModuleHeader) GetTrackerName() string {
-	return util.GetString(mh.Name[:])
+	return util.GetString(mh.TrackerName[:])
 }
 
 // HeaderFlags is the set of flags for an XM header
@@ -0,0 +1,255 @@
+package xm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+)
+
+const testChannels = 3
+
+// testWriter writes the fields of a module in order
+type testWriter struct {
+	t   *testing.T
+	buf bytes.Buffer
+}
+
+func (w *testWriter) write(values ...interface{}) {
+	w.t.Helper()
+	for _, v := range values {
+		if err := binary.Write(&w.buf, binary.LittleEndian, v); err != nil {
+			w.t.Fatal(err)
+		}
+	}
+}
+
+// testModule builds a module in the format of `version` with a packed pattern, an empty one and an instrument
+// with an 8-bit and a 16-bit sample, and one without samples, along with the File that reading it should give
+func testModule(t *testing.T, version uint16) ([]byte, *File) {
+	t.Helper()
+	w := &testWriter{t: t}
+	want := &File{}
+
+	h := &want.Head
+	copy(h.IDText[:], "Extended Module: ")
+	copy(h.Name[:], "test module")
+	h.Reserved1A = 0x1A
+	copy(h.TrackerName[:], "FastTracker v2.00")
+	h.VersionNumber = version
+	h.HeaderSize = 276
+	h.SongLength = 3
+	h.RestartPosition = 1
+	h.NumChannels = testChannels
+	h.NumPatterns = 2
+	h.NumInstruments = 2
+	h.Flags = HeaderFlagLinearSlides
+	h.DefaultSpeed = 6
+	h.DefaultTempo = 125
+	copy(h.OrderTable[:], []uint8{0, 1, 0})
+	w.write(h)
+
+	// the first row has a note stored without packing on channel 0, a note and an effect on channel 1 and
+	// nothing on channel 2, and the second row has a volume, an instrument and a note off
+	packed := []uint8{
+		49, 1, 0x40, 0x0C, 0x20,
+		0x80 | 0x01 | 0x08 | 0x10, 50, 0x0F, 0x06,
+		0x80,
+		0x80 | 0x04, 0x30,
+		0x80 | 0x02, 2,
+		0x80 | 0x01, 97,
+	}
+	want.Patterns = []Pattern{
+		{
+			PatternFileFormat: PatternFileFormat{
+				Header:     PatternHeader{PackingType: 0, NumRows: 2, PackedPatternDataSize: uint16(len(packed))},
+				PackedData: packed,
+			},
+			Data: []PatternRow{
+				{
+					{Flags: ChannelFlagsAll, Note: 49, Instrument: 1, Volume: 0x40, Effect: 0x0C, EffectParameter: 0x20},
+					{Flags: 0x99, Note: 50, Effect: 0x0F, EffectParameter: 0x06},
+					{Flags: ChannelFlagValid},
+				},
+				{
+					{Flags: 0x84, Volume: 0x30},
+					{Flags: 0x82, Instrument: 2},
+					{Flags: 0x81, Note: 97},
+				},
+			},
+		},
+		{
+			PatternFileFormat: PatternFileFormat{
+				Header:     PatternHeader{NumRows: 64},
+				PackedData: []byte{},
+			},
+			Data: make([]PatternRow, 64),
+		},
+	}
+	for i := range want.Patterns[1].Data {
+		want.Patterns[1].Data[i] = make(PatternRow, testChannels)
+	}
+	for i := range want.Patterns {
+		ph := &want.Patterns[i].Header
+		// version 1.02 stores the number of rows less one in a byte
+		if version == 0x0102 {
+			ph.PatternHeaderLength = 8
+			w.write(ph.PatternHeaderLength, ph.PackingType, uint8(ph.NumRows-1), ph.PackedPatternDataSize)
+		} else {
+			ph.PatternHeaderLength = 9
+			w.write(ph.PatternHeaderLength, ph.PackingType, ph.NumRows, ph.PackedPatternDataSize)
+		}
+		w.write(want.Patterns[i].PackedData)
+	}
+
+	samples8 := []int8{0, 10, 20, 5, -16, -128, 127, 0}
+	samples16 := []int16{0, 1000, -1000, 32767, -32768, 12345}
+
+	ins := InstrumentHeader{
+		Size:             263,
+		SamplesCount:     2,
+		SampleHeaderSize: 40,
+		VolPoints:        3,
+		PanPoints:        2,
+		VolSustainPoint:  1,
+		VolLoopEndPoint:  2,
+		PanLoopEndPoint:  1,
+		VolFlags:         EnvelopeFlagEnabled | EnvelopeFlagSustainEnabled,
+		PanFlags:         EnvelopeFlagEnabled | EnvelopeFlagLoopEnabled,
+		VibratoType:      1,
+		VibratoSweep:     2,
+		VibratoDepth:     3,
+		VibratoRate:      4,
+		VolumeFadeout:    0x200,
+		Samples: []SampleHeader{
+			{
+				Length:             uint32(len(samples8)),
+				LoopStart:          2,
+				LoopLength:         4,
+				Volume:             64,
+				Finetune:           -16,
+				Flags:              SampleFlags(SampleLoopModeEnabled),
+				Panning:            0x80,
+				RelativeNoteNumber: 12,
+			},
+			{
+				Length:             uint32(2 * len(samples16)),
+				LoopLength:         2 * uint32(len(samples16)),
+				Volume:             32,
+				Finetune:           5,
+				Flags:              SampleFlag16Bit | SampleFlags(SampleLoopModePingPong),
+				Panning:            0x20,
+				RelativeNoteNumber: -3,
+			},
+		},
+	}
+	copy(ins.Name[:], "two samples")
+	for i := range ins.SampleNumber {
+		ins.SampleNumber[i] = uint8(i / 48)
+	}
+	ins.VolEnv[0], ins.VolEnv[1], ins.VolEnv[2] = EnvPoint{0, 64}, EnvPoint{10, 32}, EnvPoint{40, 0}
+	ins.PanEnv[0], ins.PanEnv[1] = EnvPoint{0, 0}, EnvPoint{20, 64}
+	for i := range ins.ReservedP241 {
+		ins.ReservedP241[i] = uint16(i)
+	}
+	copy(ins.Samples[0].Name[:], "8-bit")
+	copy(ins.Samples[1].Name[:], "16-bit")
+
+	// the samples are stored as the differences between their samples
+	delta8 := make([]uint8, len(samples8))
+	want8 := make([]uint8, len(samples8))
+	var last8 int8
+	for i, s := range samples8 {
+		delta8[i] = uint8(s - last8)
+		want8[i] = uint8(s)
+		last8 = s
+	}
+	delta16 := make([]uint8, 2*len(samples16))
+	want16 := make([]uint8, 2*len(samples16))
+	var last16 int16
+	for i, s := range samples16 {
+		binary.LittleEndian.PutUint16(delta16[2*i:], uint16(s-last16))
+		binary.LittleEndian.PutUint16(want16[2*i:], uint16(s))
+		last16 = s
+	}
+	ins.Samples[0].SampleData = want8
+	ins.Samples[1].SampleData = want16
+
+	w.write(ins.Size, ins.Name, ins.Type, ins.SamplesCount, ins.SampleHeaderSize, ins.SampleNumber, ins.VolEnv,
+		ins.PanEnv, ins.VolPoints, ins.PanPoints, ins.VolSustainPoint, ins.VolLoopStartPoint, ins.VolLoopEndPoint,
+		ins.PanSustainPoint, ins.PanLoopStartPoint, ins.PanLoopEndPoint, ins.VolFlags, ins.PanFlags,
+		ins.VibratoType, ins.VibratoSweep, ins.VibratoDepth, ins.VibratoRate, ins.VolumeFadeout, ins.ReservedP241)
+	for _, s := range ins.Samples {
+		w.write(s.Length, s.LoopStart, s.LoopLength, s.Volume, s.Finetune, s.Flags, s.Panning,
+			s.RelativeNoteNumber, s.ReservedP17, s.Name)
+	}
+	w.write(delta8, delta16)
+
+	// an instrument without samples only stores the start of its header
+	empty := InstrumentHeader{Size: 29}
+	copy(empty.Name[:], "empty")
+	w.write(empty.Size, empty.Name, empty.Type, empty.SamplesCount)
+
+	want.Instruments = []InstrumentHeader{ins, empty}
+	return w.buf.Bytes(), want
+}
+
+func TestRead(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		version uint16
+	}{
+		{"1.04", 0x0104},
+		{"1.02", 0x0102},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			data, want := testModule(t, tc.version)
+			got, err := Read(bytes.NewReader(data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got.Head, want.Head) {
+				t.Errorf("header:\ngot  %+v\nwant %+v", got.Head, want.Head)
+			}
+			if len(got.Patterns) != len(want.Patterns) {
+				t.Fatalf("%d patterns, want %d", len(got.Patterns), len(want.Patterns))
+			}
+			for i := range want.Patterns {
+				if !reflect.DeepEqual(got.Patterns[i], want.Patterns[i]) {
+					t.Errorf("pattern %d:\ngot  %+v\nwant %+v", i, got.Patterns[i], want.Patterns[i])
+				}
+			}
+			if len(got.Instruments) != len(want.Instruments) {
+				t.Fatalf("%d instruments, want %d", len(got.Instruments), len(want.Instruments))
+			}
+			for i := range want.Instruments {
+				if !reflect.DeepEqual(got.Instruments[i], want.Instruments[i]) {
+					t.Errorf("instrument %d:\ngot  %+v\nwant %+v", i, got.Instruments[i], want.Instruments[i])
+				}
+			}
+		})
+	}
+}
+
+func TestReadBadHeaders(t *testing.T) {
+	data, _ := testModule(t, 0x0104)
+	for _, tc := range []struct {
+		name   string
+		change func(data []byte)
+	}{
+		{"no channels", func(data []byte) { binary.LittleEndian.PutUint16(data[68:], 0) }},
+		{"33 channels", func(data []byte) { binary.LittleEndian.PutUint16(data[68:], 33) }},
+		{"257 patterns", func(data []byte) { binary.LittleEndian.PutUint16(data[70:], 257) }},
+		{"129 instruments", func(data []byte) { binary.LittleEndian.PutUint16(data[72:], 129) }},
+		{"packed pattern", func(data []byte) { data[336+4] = 1 }},
+		{"no rows", func(data []byte) { binary.LittleEndian.PutUint16(data[336+5:], 0) }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			bad := append([]byte(nil), data...)
+			tc.change(bad)
+			if _, err := Read(bytes.NewReader(bad)); err == nil {
+				t.Fatal("read a module with a bad header")
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+
+	"github.com/eliasdaler/ebiten-tracker-demo/songinfo"
+)
+
+// infoView shows the details of a song, such as its instruments and message, scrolled a line at a time
+type infoView struct {
+	// name is the song the view is scrolled through, and scroll is the first line shown
+	name   string
+	scroll int
+	// infos and lines hold the details of every song and their text, by name
+	infos map[string]*songinfo.Info
+	lines map[string][]string
+	// height is the number of lines that fit in the view when it was drawn last
+	height int
+}
+
+func newInfoView() *infoView {
+	return &infoView{
+		infos: map[string]*songinfo.Info{},
+		lines: map[string][]string{},
+	}
+}
+
+// Add reads the details of song `name`, which is in `data`, in format `format`
+// A song whose details cannot be read still plays, so the error is shown in place of the details.
+func (v *infoView) Add(name, format string, data []byte) {
+	info, err := songinfo.Read(format, data)
+	if err != nil {
+		delete(v.infos, name)
+		v.lines[name] = []string{"Cannot read the song details: " + err.Error()}
+		return
+	}
+	v.infos[name] = info
+	v.lines[name] = info.Lines()
+}
+
+// Info returns the details of song `name`, or nil if they could not be read
+func (v *infoView) Info(name string) *songinfo.Info {
+	return v.infos[name]
+}
+
+// HandleInput scrolls the view with the arrow keys, PgUp/PgDn and the mouse wheel, and returns true if it
+// used any of them
+func (v *infoView) HandleInput() bool {
+	page := v.height - 1
+	if page < 1 {
+		page = 1
+	}
+	_, wheel := ebiten.Wheel()
+	switch {
+	case inpututil.IsKeyJustPressed(ebiten.KeyUp):
+		v.scroll--
+	case inpututil.IsKeyJustPressed(ebiten.KeyDown):
+		v.scroll++
+	case inpututil.IsKeyJustPressed(ebiten.KeyPageUp):
+		v.scroll -= page
+	case inpututil.IsKeyJustPressed(ebiten.KeyPageDown):
+		v.scroll += page
+	case wheel != 0:
+		v.scroll -= int(wheel * 3)
+	default:
+		return false
+	}
+	return true
+}
+
+// Draw draws the details of song `name` into `height` pixels of `screen`, starting at `y`
+func (v *infoView) Draw(screen *ebiten.Image, name string, y, height int) {
+	if name != v.name {
+		v.name = name
+		v.scroll = 0
+	}
+	lines := v.lines[name]
+	v.height = height / glyphHeight
+	if v.scroll > len(lines)-v.height {
+		v.scroll = len(lines) - v.height
+	}
+	if v.scroll < 0 {
+		v.scroll = 0
+	}
+
+	for i := v.scroll; i < len(lines) && i < v.scroll+v.height; i++ {
+		ebitenutil.DebugPrintAt(screen, lines[i], 0, y+(i-v.scroll)*glyphHeight)
+	}
+}
@@ -0,0 +1,377 @@
+// Package fixture builds small songs in memory for tests, so that what they play is plain to see
+package fixture
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+
+	"github.com/gotracker/goaudiofile/music/tracked/it"
+	"github.com/gotracker/goaudiofile/music/tracked/mod"
+)
+
+// The MOD and IT songs are small songs that go through the common effects of their formats.
+
+// MOD note periods, at finetune 0
+const (
+	periodC1 = 856
+	periodF1 = 640
+	periodG1 = 570
+	periodA1 = 508
+	periodC2 = 428
+	periodE2 = 339
+	periodG2 = 285
+	periodC3 = 214
+	periodE3 = 170
+)
+
+// modCell is a note of a MOD pattern
+type modCell struct {
+	period     uint16
+	instrument uint8
+	effect     uint8
+	param      uint8
+}
+
+func (c modCell) bytes() []byte {
+	return []byte{
+		c.instrument&0xF0 | uint8(c.period>>8)&0x0F,
+		uint8(c.period),
+		c.instrument<<4 | c.effect&0x0F,
+		c.param,
+	}
+}
+
+// modPattern is a MOD pattern of 4 channels
+type modPattern [64][4]modCell
+
+// MOD returns a 4-channel ProTracker song with a looped square lead, a looped saw bass and an unlooped
+// noise drum, playing arpeggio, vibrato, volume slides, portamento, note cuts, retriggers and a pattern break
+func MOD() []byte {
+	var h mod.ModuleHeader
+	copy(h.Name[:], "golden fixture")
+
+	square := make([]byte, 64)
+	for i := range square {
+		square[i] = 64
+		if i >= len(square)/2 {
+			square[i] = 0xC0 // -64
+		}
+	}
+	saw := make([]byte, 128)
+	for i := range saw {
+		saw[i] = byte(int8(96 - i*3/2))
+	}
+	noise := make([]byte, 2048)
+	seed := uint32(1)
+	for i := range noise {
+		seed = seed*1103515245 + 12345
+		noise[i] = byte(seed >> 24)
+	}
+	samples := []struct {
+		name     string
+		data     []byte
+		volume   uint8
+		finetune uint8
+		looped   bool
+	}{
+		{"square", square, 64, 0, true},
+		{"saw", saw, 48, 3, true},
+		{"noise", noise, 40, 0, false},
+	}
+	for i, s := range samples {
+		ins := &h.Instrument[i]
+		copy(ins.Name[:], s.name)
+		ins.Len = mod.NewWordLength(len(s.data))
+		ins.Volume = s.volume
+		ins.FineTune = s.finetune
+		ins.LoopEnd = mod.NewWordLength(2)
+		if s.looped {
+			ins.LoopEnd = mod.NewWordLength(len(s.data))
+		}
+	}
+	h.SongLen = 2
+	h.RestartPos = 127
+	h.Order[0], h.Order[1] = 0, 1
+	copy(h.Sig[:], "M.K.")
+
+	var p0 modPattern
+	p0[0][0] = modCell{periodC2, 1, 0xF, 5}
+	for r := 8; r < 16; r++ {
+		p0[r][0] = modCell{effect: 0x0, param: 0x47}
+	}
+	p0[8][0].period, p0[8][0].instrument = periodE2, 1
+	for r := 16; r < 24; r++ {
+		p0[r][0] = modCell{effect: 0x4, param: 0x46}
+	}
+	p0[16][0].period, p0[16][0].instrument = periodG2, 1
+	for r := 24; r < 32; r++ {
+		p0[r][0] = modCell{effect: 0xA, param: 0x04}
+	}
+	p0[24][0].period, p0[24][0].instrument = periodC3, 1
+	p0[32][0] = modCell{periodC2, 1, 0, 0}
+	p0[33][0] = modCell{periodG2, 0, 0x3, 0x08}
+	for r := 34; r < 40; r++ {
+		p0[r][0] = modCell{effect: 0x3}
+	}
+	p0[0][1] = modCell{periodC1, 2, 0, 0}
+	p0[16][1] = modCell{periodG1, 2, 0, 0}
+	p0[32][1] = modCell{periodA1, 2, 0, 0}
+	p0[48][1] = modCell{periodF1, 2, 0, 0}
+	for r := 49; r < 56; r++ {
+		p0[r][1] = modCell{effect: 0x1, param: 0x02}
+	}
+	for r := 4; r < 64; r += 8 {
+		p0[r][2] = modCell{periodC3, 3, 0xC, 0x20}
+	}
+	p0[60][2] = modCell{periodC3, 3, 0xE, 0xC2}
+	p0[0][3] = modCell{periodE3, 1, 0xC, 0x18}
+
+	var p1 modPattern
+	for r, period := range []uint16{periodC3, periodG2, periodE2, periodC2} {
+		p1[r*4][0] = modCell{period, 1, 0xE, 0x93}
+	}
+	p1[0][1] = modCell{periodC1, 2, 0, 0}
+	p1[8][2] = modCell{periodC3, 3, 0, 0}
+	p1[16][3] = modCell{effect: 0xD}
+
+	var buf bytes.Buffer
+	mustWrite(&buf, &h)
+	for _, p := range []modPattern{p0, p1} {
+		for _, row := range p {
+			for _, c := range row {
+				buf.Write(c.bytes())
+			}
+		}
+	}
+	for _, s := range samples {
+		buf.Write(s.data)
+	}
+	return buf.Bytes()
+}
+
+// IT effect commands, which are numbered from A
+const (
+	itVolumeSlide = 'D' - 'A' + 1
+	itTonePorta   = 'G' - 'A' + 1
+	itVibrato     = 'H' - 'A' + 1
+	itArpeggio    = 'J' - 'A' + 1
+	itRetrigger   = 'Q' - 'A' + 1
+	itSetPan      = 'X' - 'A' + 1
+)
+
+// IT notes, where C-5 is 60
+const (
+	itNoteCut = 254
+	itNoteOff = 255
+)
+
+// itCell is a note of an IT pattern, where zero fields are left out
+// The volume column is stored plus one, so that a volume of 0 can be told apart from no volume.
+type itCell struct {
+	note, instrument, volume, command, param uint8
+}
+
+// packITPattern packs the rows of 4 channels the way IT stores patterns
+func packITPattern(rows [][4]itCell) []byte {
+	var data []byte
+	for _, row := range rows {
+		for ch, c := range row {
+			var mask uint8
+			if c.note != 0 {
+				mask |= 1
+			}
+			if c.instrument != 0 {
+				mask |= 2
+			}
+			if c.volume != 0 {
+				mask |= 4
+			}
+			if c.command != 0 {
+				mask |= 8
+			}
+			if mask == 0 {
+				continue
+			}
+			data = append(data, uint8(ch+1)|0x80, mask)
+			if mask&1 != 0 {
+				data = append(data, c.note)
+			}
+			if mask&2 != 0 {
+				data = append(data, c.instrument)
+			}
+			if mask&4 != 0 {
+				data = append(data, c.volume-1)
+			}
+			if mask&8 != 0 {
+				data = append(data, c.command, c.param)
+			}
+		}
+		data = append(data, 0)
+	}
+	return data
+}
+
+// IT returns a 4-channel Impulse Tracker song in instrument mode, with a pad instrument on an 8-bit
+// sine that has volume and panning envelopes and fades out its old notes, and a lead instrument on a 16-bit
+// triangle that plays vibrato, tone portamento, arpeggio, a volume slide, panning and retriggers
+func IT() []byte {
+	orders := []uint8{0, 254, 0, 255}
+
+	sine := make([]byte, 64)
+	for i := range sine {
+		sine[i] = byte(int8(100 * math.Sin(2*math.Pi*float64(i)/float64(len(sine)))))
+	}
+	triangle := make([]byte, 2*256)
+	for i := 0; i < 256; i++ {
+		v := -32768 + i*512
+		if i >= 128 {
+			v = 32767 - (i-128)*512
+		}
+		binary.LittleEndian.PutUint16(triangle[2*i:], uint16(int16(v)))
+	}
+
+	var rows [32][4]itCell
+	rows[0][0] = itCell{note: 48, instrument: 1, volume: 1 + 48}
+	rows[8][0] = itCell{note: 52, instrument: 1}
+	rows[16][0] = itCell{note: 55, instrument: 1}
+	rows[24][0] = itCell{note: itNoteOff}
+	rows[0][1] = itCell{note: 64, instrument: 1}
+	rows[16][1] = itCell{note: 60, instrument: 1}
+	rows[24][1] = itCell{note: itNoteCut}
+	rows[0][2] = itCell{note: 72, instrument: 2, command: itVibrato, param: 0x48}
+	for r := 1; r < 8; r++ {
+		rows[r][2] = itCell{command: itVibrato}
+	}
+	rows[8][2] = itCell{note: 79, instrument: 2}
+	rows[9][2] = itCell{note: 76, command: itTonePorta, param: 0x10}
+	rows[10][2] = itCell{command: itTonePorta}
+	rows[11][2] = itCell{command: itTonePorta}
+	for r := 12; r < 16; r++ {
+		rows[r][2] = itCell{command: itArpeggio, param: 0x37}
+	}
+	rows[16][2] = itCell{note: 76, instrument: 2, command: itVolumeSlide, param: 0x04}
+	for r := 17; r < 24; r++ {
+		rows[r][2] = itCell{command: itVolumeSlide}
+	}
+	rows[20][3] = itCell{note: 67, instrument: 2, volume: 1 + 128 + 16, command: itSetPan, param: 0xE0}
+	rows[28][3] = itCell{note: 67, instrument: 2, command: itRetrigger, param: 0x33}
+	pattern := packITPattern(rows[:])
+
+	h := it.ModuleHeader{
+		OrderCount:           uint16(len(orders)),
+		InstrumentCount:      2,
+		SampleCount:          2,
+		PatternCount:         1,
+		TrackerVersion:       0x0214,
+		TrackerCompatVersion: 0x0200,
+		Flags:                it.IMPMFlagStereo | it.IMPMFlagUseInstruments | it.IMPMFlagLinearSlides,
+		GlobalVolume:         128,
+		MixingVolume:         48,
+		InitialSpeed:         4,
+		InitialTempo:         150,
+		PanningSeparation:    128,
+	}
+	copy(h.IMPM[:], "IMPM")
+	copy(h.Name[:], "golden fixture")
+	for i := range h.ChannelPan {
+		h.ChannelPan[i] = 32
+		if i >= 4 {
+			h.ChannelPan[i] |= 128
+		}
+		h.ChannelVol[i] = 64
+	}
+
+	pad := newITInstrument("pad", 1)
+	pad.NewNoteAction = it.NewNoteActionFade
+	pad.Fadeout = 512
+	pad.VolumeEnvelope = itEnvelope(it.EnvelopeFlagEnvelopeOn|it.EnvelopeFlagSustainLoopOn, 2,
+		it.NodePoint24{Y: 0, Tick: 0}, it.NodePoint24{Y: 64, Tick: 8}, it.NodePoint24{Y: 40, Tick: 30},
+		it.NodePoint24{Y: 0, Tick: 60})
+	pad.PanningEnvelope = itEnvelope(it.EnvelopeFlagEnvelopeOn, 0,
+		it.NodePoint24{Y: -32, Tick: 0}, it.NodePoint24{Y: 32, Tick: 48})
+	lead := newITInstrument("lead", 2)
+
+	instSize := binary.Size(pad)
+	sampleSize := binary.Size(it.Sample{})
+	offset := binary.Size(h) + len(orders) + 4*(2+2+1)
+	instOffsets := []uint32{uint32(offset), uint32(offset + instSize)}
+	offset += 2 * instSize
+	sampleOffsets := []uint32{uint32(offset), uint32(offset + sampleSize)}
+	offset += 2 * sampleSize
+	sineOffset := offset
+	triangleOffset := sineOffset + len(sine)
+	patternOffset := triangleOffset + len(triangle)
+
+	samples := []it.Sample{
+		newITSample("sine", it.SampleFlagSampleExists|it.SampleFlagUseLoop, len(sine), 8363, sineOffset),
+		newITSample("triangle", it.SampleFlagSampleExists|it.SampleFlagUseLoop|it.SampleFlag16Bit, 256, 22050,
+			triangleOffset),
+	}
+
+	var buf bytes.Buffer
+	for _, v := range []interface{}{&h, orders, instOffsets, sampleOffsets, []uint32{uint32(patternOffset)}, pad, lead,
+		samples} {
+		mustWrite(&buf, v)
+	}
+	buf.Write(sine)
+	buf.Write(triangle)
+	mustWrite(&buf, [4]uint16{uint16(len(pattern)), uint16(len(rows))})
+	buf.Write(pattern)
+	return buf.Bytes()
+}
+
+// newITInstrument returns an instrument that plays sample `sample` on every note, without envelopes
+func newITInstrument(name string, sample uint8) *it.IMPIInstrument {
+	inst := &it.IMPIInstrument{
+		GlobalVolume:   128,
+		DefaultPan:     32 | 128,
+		TrackerVersion: 0x0214,
+		SampleCount:    1,
+	}
+	copy(inst.IMPI[:], "IMPI")
+	copy(inst.Name[:], name)
+	for n := range inst.NoteSampleKeyboard {
+		inst.NoteSampleKeyboard[n] = it.NoteSample{Note: it.Note(n), Sample: sample}
+	}
+	return inst
+}
+
+// itEnvelope returns an envelope through `nodes`, which holds at node `sustain` while the note is held if
+// `flags` has a sustain loop
+func itEnvelope(flags it.EnvelopeFlags, sustain uint8, nodes ...it.NodePoint24) it.Envelope {
+	env := it.Envelope{
+		Flags:            flags,
+		Count:            uint8(len(nodes)),
+		SustainLoopBegin: sustain,
+		SustainLoopEnd:   sustain,
+	}
+	copy(env.NodePoints[:], nodes)
+	return env
+}
+
+// newITSample returns the header of a signed sample of `length` frames that loops all the way through, with
+// its data at `offset` in the file
+func newITSample(name string, flags it.SampleFlags, length int, c5Speed uint32, offset int) it.Sample {
+	s := it.Sample{
+		GlobalVolume:  64,
+		Flags:         flags,
+		Volume:        64,
+		ConvertFlags:  it.ConvertFlagSignedSamples,
+		DefaultPan:    32,
+		Length:        uint32(length),
+		LoopEnd:       uint32(length),
+		C5Speed:       c5Speed,
+		SamplePointer: it.ParaPointer32(offset),
+	}
+	copy(s.IMPS[:], "IMPS")
+	copy(s.Name[:], name)
+	return s
+}
+
+// mustWrite writes `v` to `buf` in little-endian order, which only fails for types binary cannot write
+func mustWrite(buf *bytes.Buffer, v interface{}) {
+	if err := binary.Write(buf, binary.LittleEndian, v); err != nil {
+		panic(err)
+	}
+}
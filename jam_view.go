@@ -0,0 +1,236 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/audio"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
+
+	"github.com/gotracker/playback"
+	"github.com/gotracker/playback/note"
+	"github.com/gotracker/playback/player/jam"
+
+	"github.com/eliasdaler/ebiten-tracker-demo/render"
+	"github.com/eliasdaler/ebiten-tracker-demo/songinfo"
+)
+
+// jamBufferSize is how much audio the jam player buffers, which is kept short so that notes sound as soon
+// as their keys are pressed
+const jamBufferSize = time.Second / 50
+
+// pianoKeys maps the keys of the computer keyboard to notes, in semitones up from C of the current octave,
+// in the layout trackers use: the bottom two rows play one octave and the top two rows the next one
+var pianoKeys = map[ebiten.Key]int{
+	ebiten.KeyZ: 0, ebiten.KeyS: 1, ebiten.KeyX: 2, ebiten.KeyD: 3, ebiten.KeyC: 4, ebiten.KeyV: 5,
+	ebiten.KeyG: 6, ebiten.KeyB: 7, ebiten.KeyH: 8, ebiten.KeyN: 9, ebiten.KeyJ: 10, ebiten.KeyM: 11,
+	ebiten.KeyComma: 12, ebiten.KeyL: 13, ebiten.KeyPeriod: 14, ebiten.KeySemicolon: 15, ebiten.KeySlash: 16,
+
+	ebiten.KeyQ: 12, ebiten.KeyDigit2: 13, ebiten.KeyW: 14, ebiten.KeyDigit3: 15, ebiten.KeyE: 16,
+	ebiten.KeyR: 17, ebiten.KeyDigit5: 18, ebiten.KeyT: 19, ebiten.KeyDigit6: 20, ebiten.KeyY: 21,
+	ebiten.KeyDigit7: 22, ebiten.KeyU: 23, ebiten.KeyI: 24, ebiten.KeyDigit9: 25, ebiten.KeyO: 26,
+	ebiten.KeyDigit0: 27, ebiten.KeyP: 28, ebiten.KeyBracketLeft: 29, ebiten.KeyEqual: 30,
+	ebiten.KeyBracketRight: 31,
+}
+
+var keyNames = [12]string{"C-", "C#", "D-", "D#", "E-", "F-", "F#", "G-", "G#", "A-", "A#", "B-"}
+
+// noteName returns the name of semitone `st` the way trackers show it, such as C#4
+func noteName(st note.Semitone) string {
+	return fmt.Sprintf("%s%d", keyNames[st.Key()], st.Octave())
+}
+
+// baseOctave returns the octave in which the samples of songs in format `format` play at their own pitch
+func baseOctave(format string) int {
+	if format == "it" {
+		return 5
+	}
+	return 4
+}
+
+// jamView plays the instruments of the song from the computer keyboard, through an audio player of its own
+// that mixes on top of the song
+// The notes go through a separate player so that they are heard right away, rather than after all the
+// audio that is buffered ahead for the song.
+type jamView struct {
+	// mu guards the jam, which the audio player reads from on a goroutine of its own
+	mu     sync.Mutex
+	jam    *jam.Jam
+	player *audio.Player
+
+	// inst is the instrument being played, numbered from 1, and octave is the octave of the Z key
+	inst   int
+	octave int
+	held   map[ebiten.Key]*jam.Note
+	// last is the last note that was played, and err why it did not play
+	last note.Semitone
+	err  error
+
+	// channels is the number of channels the notes are mixed into
+	channels int
+	buf      []float32
+	pcm      []byte
+}
+
+func newJamView(context *audio.Context, out render.Options) (*jamView, error) {
+	v := &jamView{
+		jam:      jam.New(out.SampleRate, out.Channels),
+		channels: out.Channels,
+		inst:     1,
+		octave:   4,
+		held:     map[ebiten.Key]*jam.Note{},
+	}
+	player, err := context.NewPlayer(v)
+	if err != nil {
+		return nil, err
+	}
+	player.SetBufferSize(jamBufferSize)
+	player.Play()
+	v.player = player
+	return v, nil
+}
+
+// Read mixes the notes being played into `p` as 16-bit PCM, for the audio player
+// It never runs out, and gives silence when no notes are playing.
+func (v *jamView) Read(p []byte) (int, error) {
+	n := len(p) / deviceBytesPerFrame * v.channels
+	if cap(v.buf) < n {
+		v.buf = make([]float32, n)
+	}
+	v.buf = v.buf[:n]
+	for i := range v.buf {
+		v.buf[i] = 0
+	}
+
+	v.mu.Lock()
+	v.jam.Read(v.buf)
+	v.mu.Unlock()
+
+	v.pcm = appendDevicePCM(v.pcm[:0], v.buf, v.channels)
+	return copy(p, v.pcm), nil
+}
+
+// Start gets ready to play the instruments of a song in format `format`, starting from the octave its
+// samples sound at their own pitch
+func (v *jamView) Start(format string) {
+	v.octave = baseOctave(format)
+	v.err = nil
+}
+
+// Stop lets go of every key that is held down
+func (v *jamView) Stop() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	for k, n := range v.held {
+		n.Release()
+		delete(v.held, k)
+	}
+}
+
+// HandleInput plays the instruments of song `p`, whose details are `info`, from the keyboard, and picks the
+// instrument and octave with the arrow keys
+func (v *jamView) HandleInput(p playback.Playback, info *songinfo.Info) {
+	numInsts := len(jamInstruments(info))
+	switch {
+	case inpututil.IsKeyJustPressed(ebiten.KeyLeft):
+		v.inst--
+	case inpututil.IsKeyJustPressed(ebiten.KeyRight):
+		v.inst++
+	case inpututil.IsKeyJustPressed(ebiten.KeyUp):
+		v.octave++
+	case inpututil.IsKeyJustPressed(ebiten.KeyDown):
+		v.octave--
+	}
+	if v.inst > numInsts {
+		v.inst = numInsts
+	}
+	if v.inst < 1 {
+		v.inst = 1
+	}
+	if v.octave > 8 {
+		v.octave = 8
+	}
+	if v.octave < 0 {
+		v.octave = 0
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	for k, offset := range pianoKeys {
+		if inpututil.IsKeyJustReleased(k) {
+			if n, ok := v.held[k]; ok {
+				n.Release()
+				delete(v.held, k)
+			}
+		}
+		if !inpututil.IsKeyJustPressed(k) {
+			continue
+		}
+		st := note.Semitone(v.octave*12 + offset)
+		if int(st) >= note.MaxSemitone {
+			continue
+		}
+		v.jam.Volume = p.GetMixerVolume()
+		v.jam.Interpolation = p.GetInterpolation()
+		n, err := v.jam.Play(p, v.inst, st)
+		v.last, v.err = st, err
+		if err != nil {
+			continue
+		}
+		if old, ok := v.held[k]; ok {
+			old.Release()
+		}
+		v.held[k] = n
+	}
+}
+
+// Draw draws the instruments of the song, whose details are `info`, into `height` pixels of `screen`,
+// starting at `y`, with the one being played highlighted
+func (v *jamView) Draw(screen *ebiten.Image, info *songinfo.Info, y, height int) {
+	v.mu.Lock()
+	playing := v.jam.Len()
+	v.mu.Unlock()
+
+	status := fmt.Sprintf("Jam: instrument %d, octave %d, %d notes playing", v.inst, v.octave, playing)
+	if v.err != nil {
+		status += fmt.Sprintf("    %s: %v", noteName(v.last), v.err)
+	}
+	ebitenutil.DebugPrintAt(screen, status, 0, y)
+	ebitenutil.DebugPrintAt(screen, strings.Repeat("-", screenWidth/glyphWidth), 0, y+glyphHeight)
+	y += 2 * glyphHeight
+	height -= 2 * glyphHeight
+
+	insts := jamInstruments(info)
+	lines := height / glyphHeight
+	first := v.inst - 1 - lines/2
+	if first > len(insts)-lines {
+		first = len(insts) - lines
+	}
+	if first < 0 {
+		first = 0
+	}
+	for i := first; i < len(insts) && i < first+lines; i++ {
+		ly := y + (i-first)*glyphHeight
+		if i == v.inst-1 {
+			ebitenutil.DrawRect(screen, 0, float64(ly), screenWidth, glyphHeight, currentRowColor)
+		}
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("%3d. %-26s %s", insts[i].Number, insts[i].Name, insts[i].Details),
+			0, ly)
+	}
+}
+
+// jamInstruments returns what the keyboard plays in a song with details `info`: its instruments, or its
+// samples when it does not have any
+func jamInstruments(info *songinfo.Info) []songinfo.Item {
+	if info == nil {
+		return nil
+	}
+	if len(info.Instruments) > 0 {
+		return info.Instruments
+	}
+	return info.Samples
+}
@@ -0,0 +1,127 @@
+package loudness
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+)
+
+// CacheVersion is the version of the measurements in caches; caches of other versions are measured again
+// It goes up whenever a change to the meter or AnalysisOptions would change the results.
+const CacheVersion = 1
+
+// ErrCacheVersion is for when a cache was written by another version of the meter
+var ErrCacheVersion = errors.New("loudness cache is from another version")
+
+// Entry is the cached measurement of a song
+type Entry struct {
+	// Name is the name of the song, to make the cache easier to read; the song is looked up by its data
+	Name string `json:"name"`
+	Result
+}
+
+// Cache keeps the measurements of songs, keyed by a hash of their data so that a song is measured again
+// whenever it changes
+type Cache struct {
+	Version int              `json:"version"`
+	Songs   map[string]Entry `json:"songs"`
+}
+
+// NewCache creates an empty cache
+func NewCache() *Cache {
+	return &Cache{Version: CacheVersion, Songs: map[string]Entry{}}
+}
+
+// Key returns the key of the song with data `data`
+func Key(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// ReadCache reads a cache written by Write
+func ReadCache(r io.Reader) (*Cache, error) {
+	c := NewCache()
+	if err := json.NewDecoder(r).Decode(c); err != nil {
+		return nil, err
+	}
+	if c.Version != CacheVersion {
+		return nil, ErrCacheVersion
+	}
+	if c.Songs == nil {
+		c.Songs = map[string]Entry{}
+	}
+	return c, nil
+}
+
+// LoadCache reads the cache in the file `path`, or returns an empty one if there is no such file or it was
+// written by another version
+func LoadCache(path string) (*Cache, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return NewCache(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	c, err := ReadCache(f)
+	if errors.Is(err, ErrCacheVersion) {
+		return NewCache(), nil
+	}
+	return c, err
+}
+
+// Write writes the cache as JSON
+func (c *Cache) Write(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	return enc.Encode(c)
+}
+
+// Save writes the cache to the file `path`
+func (c *Cache) Save(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := c.Write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Lookup returns the measurement of the song with data `data`, if it is in the cache
+func (c *Cache) Lookup(data []byte) (Result, bool) {
+	e, ok := c.Songs[Key(data)]
+	return e.Result, ok
+}
+
+// Add adds the measurement `r` of the song `name`, with data `data`, to the cache
+func (c *Cache) Add(name string, data []byte, r Result) {
+	c.Songs[Key(data)] = Entry{Name: name, Result: r}
+}
+
+// Merge adds the measurements in `other` to the cache
+func (c *Cache) Merge(other *Cache) {
+	for k, e := range other.Songs {
+		c.Songs[k] = e
+	}
+}
+
+// Measure returns the measurement of the song `name` from the cache, measuring it and adding it first if
+// it is not there; `measured` tells which happened
+func (c *Cache) Measure(name, format string, data []byte) (r Result, measured bool, err error) {
+	if r, ok := c.Lookup(data); ok {
+		return r, false, nil
+	}
+	r, err = Analyze(format, data)
+	if err != nil {
+		return Result{}, false, err
+	}
+	c.Add(name, data, r)
+	return r, true, nil
+}
@@ -0,0 +1,115 @@
+package loudness
+
+import "math"
+
+// biquad is a second-order filter section, with the coefficients normalized so that a0 is 1
+type biquad struct {
+	b0, b1, b2, a1, a2 float64
+}
+
+// biquadState is the state of a biquad on one channel
+type biquadState struct {
+	x1, x2, y1, y2 float64
+}
+
+func (f *biquad) process(s *biquadState, x float64) float64 {
+	y := f.b0*x + f.b1*s.x1 + f.b2*s.x2 - f.a1*s.y1 - f.a2*s.y2
+	s.x2, s.x1 = s.x1, x
+	s.y2, s.y1 = s.y1, y
+	return y
+}
+
+// The K-weighting filters are given in BS.1770 for 48 kHz only. These designs give the same filters at 48 kHz
+// and match them at other rates.
+
+// highShelf sets the filter up as the first stage of K-weighting, a shelf that models the head
+func (f *biquad) highShelf(sampleRate int) {
+	const (
+		f0 = 1681.974450955533
+		g  = 3.999843853973347
+		q  = 0.7071752369554196
+	)
+	k := math.Tan(math.Pi * f0 / float64(sampleRate))
+	vh := math.Pow(10, g/20)
+	vb := math.Pow(vh, 0.4996667741545416)
+	a0 := 1 + k/q + k*k
+	f.b0 = (vh + vb*k/q + k*k) / a0
+	f.b1 = 2 * (k*k - vh) / a0
+	f.b2 = (vh - vb*k/q + k*k) / a0
+	f.a1 = 2 * (k*k - 1) / a0
+	f.a2 = (1 - k/q + k*k) / a0
+}
+
+// highPass sets the filter up as the second stage of K-weighting, a high-pass filter
+func (f *biquad) highPass(sampleRate int) {
+	const (
+		f0 = 38.13547087602444
+		q  = 0.5003270373238773
+	)
+	k := math.Tan(math.Pi * f0 / float64(sampleRate))
+	a0 := 1 + k/q + k*k
+	f.b0, f.b1, f.b2 = 1, -2, 1
+	f.a1 = 2 * (k*k - 1) / a0
+	f.a2 = (1 - k/q + k*k) / a0
+}
+
+const (
+	// oversampling is how many times over the true peak meter samples the audio
+	oversampling = 4
+	// peakTaps is the number of taps of each phase of the interpolation filter
+	peakTaps = 12
+)
+
+// peakFilter holds the phases of the interpolation filter the true peak meter oversamples with, a windowed
+// sinc with its cut-off at the Nyquist frequency of the audio
+var peakFilter = makePeakFilter()
+
+func makePeakFilter() [oversampling][peakTaps]float64 {
+	var phases [oversampling][peakTaps]float64
+	const n = oversampling * peakTaps
+	for i := 0; i < n; i++ {
+		x := (float64(i) - float64(n-1)/2) / oversampling
+		h := 1.0
+		if x != 0 {
+			h = math.Sin(math.Pi*x) / (math.Pi * x)
+		}
+		window := 0.5 - 0.5*math.Cos(2*math.Pi*(float64(i)+0.5)/n)
+		phases[i%oversampling][i/oversampling] = h * window
+	}
+	return phases
+}
+
+// truePeak finds the highest level of audio between its samples, by oversampling it
+type truePeak struct {
+	// history holds the last samples of every channel, newest last
+	history [][peakTaps]float64
+	max     float64
+}
+
+func newTruePeak(channels int) truePeak {
+	return truePeak{history: make([][peakTaps]float64, channels)}
+}
+
+func (p *truePeak) write(ch int, x float64) {
+	h := &p.history[ch]
+	copy(h[:], h[1:])
+	h[peakTaps-1] = x
+	for _, phase := range peakFilter {
+		var y float64
+		for i, k := range phase {
+			y += k * h[peakTaps-1-i]
+		}
+		if y < 0 {
+			y = -y
+		}
+		if y > p.max {
+			p.max = y
+		}
+	}
+	if x < 0 {
+		x = -x
+	}
+	if x > p.max {
+		p.max = x
+	}
+}
@@ -0,0 +1,227 @@
+// Package loudness measures how loud songs are, as integrated loudness (EBU R128 / ITU-R BS.1770) and true
+// peak, and works out the gain that brings them to a target loudness.
+// Measuring a song means rendering all of it, so the results are meant to be worked out once per song, such
+// as at build time, and kept in a Cache.
+package loudness
+
+import (
+	"errors"
+	"io"
+	"math"
+	"time"
+
+	"github.com/eliasdaler/ebiten-tracker-demo/render"
+)
+
+const (
+	// DefaultTarget is the loudness songs are brought to by default, in LUFS
+	DefaultTarget = -16
+	// DefaultMaxTruePeak is the highest true peak the gain is allowed to push a song to, in dBTP
+	DefaultMaxTruePeak = -1
+
+	// Silence is the integrated loudness reported for songs that are too quiet to measure, in LUFS
+	Silence = -70
+	// MinPeak is the lowest true peak reported, in dBTP, which silence is clamped to
+	MinPeak = -120
+
+	// relativeGate is how far under the loudness of the louder blocks a block can be and still count, in LU
+	relativeGate = -10
+	// blockLength is the length of the blocks loudness is measured over, in sub-blocks; a new block starts
+	// every sub-block, so they overlap
+	blockLength = 4
+	// subBlocks is the number of sub-blocks in a second
+	subBlocks = 10
+)
+
+// ErrNoChannels is for when a meter is asked to measure audio with no channels
+var ErrNoChannels = errors.New("no channels")
+
+// AnalysisOptions are the render options songs are measured with
+// The integrated loudness does not depend on the sample rate, so the measurement holds for playback at any
+// rate.
+var AnalysisOptions = render.Options{
+	SampleRate:    48000,
+	Channels:      2,
+	BitsPerSample: 32,
+	MaxDuration:   20 * time.Minute,
+}
+
+// Result is how loud a song is
+type Result struct {
+	// Integrated is the gated loudness of the whole song, in LUFS
+	Integrated float64 `json:"integrated"`
+	// TruePeak is the highest level of the song between its samples as well as at them, in dBTP
+	TruePeak float64 `json:"truePeak"`
+	// Duration is how long the measured audio is
+	Duration time.Duration `json:"duration"`
+}
+
+// GainDB returns the gain that brings the song to `target` LUFS, in dB, turned down as needed to keep its
+// true peak under `maxTruePeak` dBTP
+// Songs too quiet to measure are left as they are.
+func (r Result) GainDB(target, maxTruePeak float64) float64 {
+	if r.Integrated <= Silence {
+		return 0
+	}
+	gain := target - r.Integrated
+	if r.TruePeak+gain > maxTruePeak {
+		gain = maxTruePeak - r.TruePeak
+	}
+	return gain
+}
+
+// Gain returns GainDB as an amplitude factor
+func (r Result) Gain(target, maxTruePeak float64) float32 {
+	return float32(math.Pow(10, r.GainDB(target, maxTruePeak)/20))
+}
+
+// Analyze renders the song in `data`, in format `format`, once through and measures it
+func Analyze(format string, data []byte) (Result, error) {
+	p, err := render.Load(format, data, AnalysisOptions)
+	if err != nil {
+		return Result{}, err
+	}
+	r, err := render.NewRenderer(p, AnalysisOptions)
+	if err != nil {
+		return Result{}, err
+	}
+	m, err := NewMeter(AnalysisOptions.SampleRate, AnalysisOptions.Channels)
+	if err != nil {
+		return Result{}, err
+	}
+	for {
+		b, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return Result{}, err
+		}
+		m.Write(b.Master)
+	}
+	return m.Result(), nil
+}
+
+// Meter measures the loudness and true peak of audio written to it
+type Meter struct {
+	sampleRate int
+	channels   int
+
+	// filters and state are the K-weighting filters, which model how loud the ear finds each frequency
+	filters [2]biquad
+	state   [][2]biquadState
+
+	// subBlockFrames is the length of a sub-block, and frames and energy are how far the sub-block being
+	// measured has got and the sum of the squares of its weighted samples
+	subBlockFrames int
+	frames         int
+	energy         float64
+	// recent holds the energies of the last sub-blocks, which make up the next block
+	recent []float64
+	// blocks holds the mean square of every block
+	blocks []float64
+
+	peak        truePeak
+	totalFrames int64
+}
+
+// NewMeter creates a meter for audio at `sampleRate` frames per second in `channels` channels
+func NewMeter(sampleRate, channels int) (*Meter, error) {
+	if channels < 1 {
+		return nil, ErrNoChannels
+	}
+	m := &Meter{
+		sampleRate:     sampleRate,
+		channels:       channels,
+		state:          make([][2]biquadState, channels),
+		subBlockFrames: sampleRate / subBlocks,
+		peak:           newTruePeak(channels),
+	}
+	m.filters[0].highShelf(sampleRate)
+	m.filters[1].highPass(sampleRate)
+	return m, nil
+}
+
+// Write measures the interleaved samples `samples`
+func (m *Meter) Write(samples []float32) {
+	for i := 0; i+m.channels <= len(samples); i += m.channels {
+		for c := 0; c < m.channels; c++ {
+			x := float64(samples[i+c])
+			m.peak.write(c, x)
+			st := &m.state[c]
+			y := m.filters[0].process(&st[0], x)
+			y = m.filters[1].process(&st[1], y)
+			// the surround channel weights of BS.1770 do not apply to mono and stereo, where they are all 1
+			m.energy += y * y
+		}
+		m.totalFrames++
+		if m.frames++; m.frames >= m.subBlockFrames {
+			m.endSubBlock()
+		}
+	}
+}
+
+func (m *Meter) endSubBlock() {
+	m.recent = append(m.recent, m.energy/float64(m.frames))
+	m.energy = 0
+	m.frames = 0
+	if len(m.recent) < blockLength {
+		return
+	}
+	var sum float64
+	for _, e := range m.recent {
+		sum += e
+	}
+	m.blocks = append(m.blocks, sum/blockLength)
+	m.recent = append(m.recent[:0], m.recent[1:]...)
+}
+
+// Result returns the measurements of everything written so far
+func (m *Meter) Result() Result {
+	return Result{
+		Integrated: m.integrated(),
+		TruePeak:   math.Max(toDB(m.peak.max), MinPeak),
+		Duration:   time.Duration(m.totalFrames) * time.Second / time.Duration(m.sampleRate),
+	}
+}
+
+// integrated gates the blocks the way BS.1770 does: first the ones under the silence threshold are dropped,
+// then the ones more than 10 LU under the loudness of what is left
+func (m *Meter) integrated() float64 {
+	absolute := math.Pow(10, (Silence+0.691)/10)
+	mean, n := gatedMean(m.blocks, absolute)
+	if n == 0 {
+		return Silence
+	}
+	relative := mean * math.Pow(10, relativeGate/10.0)
+	mean, n = gatedMean(m.blocks, math.Max(absolute, relative))
+	if n == 0 {
+		return Silence
+	}
+	return math.Max(-0.691+10*math.Log10(mean), Silence)
+}
+
+// gatedMean returns the mean of the blocks over `gate`, and how many there are
+func gatedMean(blocks []float64, gate float64) (float64, int) {
+	var (
+		sum float64
+		n   int
+	)
+	for _, b := range blocks {
+		if b > gate {
+			sum += b
+			n++
+		}
+	}
+	if n == 0 {
+		return 0, 0
+	}
+	return sum / float64(n), n
+}
+
+func toDB(v float64) float64 {
+	if v <= 0 {
+		return math.Inf(-1)
+	}
+	return 20 * math.Log10(v)
+}
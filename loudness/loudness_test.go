@@ -0,0 +1,123 @@
+package loudness
+
+import (
+	"bytes"
+	"math"
+	"testing"
+)
+
+const testSampleRate = 48000
+
+// stereoSine returns `seconds` of a sine at `freq` Hz and `dbfs` dB under full scale on both channels of
+// stereo audio
+func stereoSine(freq, dbfs, seconds float64) []float32 {
+	n := int(seconds * testSampleRate)
+	amp := math.Pow(10, dbfs/20)
+	samples := make([]float32, 2*n)
+	for i := 0; i < n; i++ {
+		v := float32(amp * math.Sin(2*math.Pi*freq*float64(i)/testSampleRate))
+		samples[2*i], samples[2*i+1] = v, v
+	}
+	return samples
+}
+
+func measure(t *testing.T, samples []float32) Result {
+	t.Helper()
+	m, err := NewMeter(testSampleRate, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.Write(samples)
+	return m.Result()
+}
+
+// TestReferenceSine checks the meter against the reference of EBU Tech 3341: a 1 kHz sine at -23 dBFS on
+// both channels of stereo audio reads -23 LUFS
+func TestReferenceSine(t *testing.T) {
+	r := measure(t, stereoSine(1000, -23, 20))
+	if math.Abs(r.Integrated+23) > 0.1 {
+		t.Fatalf("integrated loudness %.2f LUFS, want -23", r.Integrated)
+	}
+	if math.Abs(r.TruePeak+23) > 0.1 {
+		t.Fatalf("true peak %.2f dBTP, want -23", r.TruePeak)
+	}
+	if r.Duration.Seconds() != 20 {
+		t.Fatalf("duration %v, want 20s", r.Duration)
+	}
+}
+
+// TestGating checks that quiet stretches do not pull the loudness down
+func TestGating(t *testing.T) {
+	loud := stereoSine(1000, -23, 10)
+	quiet := stereoSine(1000, -60, 10)
+	r := measure(t, append(loud, quiet...))
+	if math.Abs(r.Integrated+23) > 0.1 {
+		t.Fatalf("integrated loudness %.2f LUFS with a quiet stretch, want -23", r.Integrated)
+	}
+
+	r = measure(t, make([]float32, 2*testSampleRate))
+	if r.Integrated != Silence || r.TruePeak != MinPeak {
+		t.Fatalf("silence measured as %v", r)
+	}
+}
+
+// TestTruePeak checks that peaks between the samples are found: a sine at a quarter of the sample rate,
+// sampled 45 degrees off its peaks, peaks 3 dB over its samples
+func TestTruePeak(t *testing.T) {
+	m, err := NewMeter(testSampleRate, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	samples := make([]float32, testSampleRate)
+	for i := range samples {
+		samples[i] = float32(0.5 * math.Sin(math.Pi*float64(i)/2+math.Pi/4))
+	}
+	m.Write(samples)
+	want := 20 * math.Log10(0.5)
+	if tp := m.Result().TruePeak; math.Abs(tp-want) > 0.2 {
+		t.Fatalf("true peak %.2f dBTP, want %.2f", tp, want)
+	}
+}
+
+func TestGain(t *testing.T) {
+	tests := []struct {
+		r    Result
+		want float64
+	}{
+		{Result{Integrated: -20, TruePeak: -10}, 4},
+		{Result{Integrated: -10, TruePeak: -1}, -6},
+		// turned down to keep the true peak under the ceiling
+		{Result{Integrated: -30, TruePeak: -3}, 2},
+		{Result{Integrated: Silence, TruePeak: MinPeak}, 0},
+	}
+	for _, tt := range tests {
+		if g := tt.r.GainDB(-16, -1); math.Abs(g-tt.want) > 1e-9 {
+			t.Errorf("%+v: gain %v dB, want %v dB", tt.r, g, tt.want)
+		}
+	}
+}
+
+func TestCache(t *testing.T) {
+	c := NewCache()
+	song := []byte("song data")
+	c.Add("song.xm", song, Result{Integrated: -18, TruePeak: -2})
+
+	var buf bytes.Buffer
+	if err := c.Write(&buf); err != nil {
+		t.Fatal(err)
+	}
+	read, err := ReadCache(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r, ok := read.Lookup(song); !ok || r.Integrated != -18 || r.TruePeak != -2 {
+		t.Fatalf("got %v, %v from the cache", r, ok)
+	}
+	if _, ok := read.Lookup([]byte("changed song data")); ok {
+		t.Fatal("found a song whose data changed")
+	}
+
+	if _, err := ReadCache(bytes.NewReader([]byte(`{"version": 0}`))); err != ErrCacheVersion {
+		t.Fatalf("got error %v from an old cache, want %v", err, ErrCacheVersion)
+	}
+}
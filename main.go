@@ -2,61 +2,600 @@ package main
 
 import (
 	"bytes"
-	_ "embed"
+	"embed"
+	"errors"
+	"flag"
+	"fmt"
 	"log"
+	"strconv"
 	"time"
 
 	"github.com/hajimehoshi/ebiten/v2"
 	"github.com/hajimehoshi/ebiten/v2/audio"
 	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+	"github.com/hajimehoshi/ebiten/v2/inpututil"
 
-	"github.com/gotracker/gomixing/mixing"
-	"github.com/gotracker/gomixing/sampling"
 	"github.com/gotracker/playback"
 	"github.com/gotracker/playback/format"
+	"github.com/gotracker/playback/index"
+	"github.com/gotracker/playback/note"
+	"github.com/gotracker/playback/output"
 	"github.com/gotracker/playback/player/feature"
+	"github.com/gotracker/playback/song"
+	"github.com/gotracker/playback/voice/interpolation"
+
+	"github.com/eliasdaler/ebiten-tracker-demo/channelmix"
+	"github.com/eliasdaler/ebiten-tracker-demo/loudness"
+	"github.com/eliasdaler/ebiten-tracker-demo/music"
+	"github.com/eliasdaler/ebiten-tracker-demo/playlist"
+	"github.com/eliasdaler/ebiten-tracker-demo/render"
+	"github.com/eliasdaler/ebiten-tracker-demo/sfx"
+	"github.com/eliasdaler/ebiten-tracker-demo/timeline"
 )
 
 //go:embed belthsar.s3m
 var fileBytes []byte
 
+// soundtrack is the playlist that plays when no other one is given
+//
+//go:embed soundtrack.m3u belthsar.s3m theme.xm
+var soundtrack embed.FS
+
+// soundtrackLoudness holds the loudness of the songs of the soundtrack, measured ahead of time
+//
+//go:generate go run ./cmd/loudness -o loudness.json soundtrack.m3u
+//go:embed loudness.json
+var soundtrackLoudness []byte
+
+// songFade is how long songs crossfade for when changing songs right away
+const songFade = 2 * time.Second
+
+const (
+	// sfxKeys is the number of function keys that play sound effects, from F1 up
+	sfxKeys = 8
+	// sfxVoices is the number of sound effects that can play at once
+	sfxVoices = 4
+	// sfxDuration is how long the sound effect notes are held for
+	sfxDuration = 500 * time.Millisecond
+)
+
+// tempoStep is how much the PageUp and PageDown keys change the tempo scale by
+const tempoStep = 1.1
+
 const (
 	screenWidth  = 640
 	screenHeight = 480
-	sampleRate   = 44100
+
+	audioBufferSize = time.Second / 20
+	// bufferAhead is how much audio is generated ahead of the audio player
+	// Changes to the song or its channels are heard this much later.
+	bufferAhead = time.Second / 2
 )
 
 type Game struct {
 	audioContext *audio.Context
 	musicPlayer  *audio.Player
+	// output is the format of the audio the songs are mixed into
+	output render.Options
+
+	music    *music.Manager
+	playlist *playlist.Playlist
+	// mixers holds the channel mixing of every song, by name
+	mixers map[string]*channelmix.Mixer
+
+	rb       *RingBuffer
+	pcm      []byte
+	timeline *timeline.Timeline
+	view     patternView
+	visuals  *visualView
+	// showVisuals shows the visualizers in place of the pattern view
+	showVisuals bool
+	// showPlaylist shows the playlist in place of the pattern view and the visualizers
+	showPlaylist bool
+	info         *infoView
+	// showInfo shows the details of the song in place of the other views
+	showInfo bool
+	jam      *jamView
+	sfx      *sfx.Engine
+	// master is the effects chain everything goes through on its way to the output
+	master *masterChain
+	// jamming plays the instruments of the song from the keyboard, which then does nothing else
+	jamming bool
 
-	trackPlayer playback.Playback
-	m           mixing.Mixer
-	panMixer    mixing.PanMixer
+	paused bool
+	ended  bool
 
-	rb *RingBuffer
+	// orderInput holds the digits typed so far for an order jump
+	orderInput string
+
+	// the tempo scale and transposition every song plays with
+	tempoScale float64
+	transpose  float64
+	// interpolation is how every song resamples its instruments
+	interpolation interpolation.Mode
+
+	// loudness holds the loudness of the songs measured ahead of time, and measured holds it for the songs
+	// that have been loaded, by name
+	loudness *loudness.Cache
+	measured map[string]loudness.Result
+	// normalize brings every song to the target loudness, in LUFS
+	normalize bool
+	target    float64
+
+	// lastSync is the last Zxx sync marker that was heard
+	lastSync    timeline.Event
+	hasLastSync bool
 }
 
 var start bool = true
 
 func (g *Game) Update() error {
+	if err := g.handleInput(); err != nil {
+		return err
+	}
 
 	for i := 0; i < 5; i++ {
-		if g.rb.Size() > sampleRate*2 {
+		if g.ended || g.rb.Size() > g.bufferAheadBytes() {
 			break
 		}
 		g.GenerateSamples()
 	}
+	g.timeline.Dispatch(g.heardFrame())
 
-	if !g.musicPlayer.IsPlaying() {
+	if !g.paused && !g.musicPlayer.IsPlaying() {
 		g.musicPlayer.Play()
 	}
 
 	return nil
 }
 
+func (g *Game) handleInput() error {
+	if g.jamming {
+		g.handleJamInput()
+		return nil
+	}
+	if g.showInfo && g.info.HandleInput() {
+		return nil
+	}
+
+	switch {
+	case inpututil.IsKeyJustPressed(ebiten.KeySpace):
+		g.togglePause()
+	case inpututil.IsKeyJustPressed(ebiten.KeyS):
+		g.paused = true
+		return g.restart()
+	case inpututil.IsKeyJustPressed(ebiten.KeyHome):
+		return g.restart()
+	case inpututil.IsKeyJustPressed(ebiten.KeyRight):
+		return g.seekOrder(g.heardPosition().Order + 1)
+	case inpututil.IsKeyJustPressed(ebiten.KeyLeft):
+		return g.seekOrder(g.heardPosition().Order - 1)
+	case inpututil.IsKeyJustPressed(ebiten.KeyBracketLeft):
+		g.view.ScrollChannels(-1)
+	case inpututil.IsKeyJustPressed(ebiten.KeyBracketRight):
+		g.view.ScrollChannels(1)
+	case inpututil.IsKeyJustPressed(ebiten.KeyN):
+		return g.changeSong(1, music.Transition{})
+	case inpututil.IsKeyJustPressed(ebiten.KeyB):
+		return g.changeSong(-1, music.Transition{})
+	case inpututil.IsKeyJustPressed(ebiten.KeyX):
+		return g.changeSong(1, music.Transition{When: music.Now, Fade: songFade})
+	case inpututil.IsKeyJustPressed(ebiten.KeyC):
+		return g.changeSong(1, music.Transition{When: music.AtPatternEnd})
+	case inpututil.IsKeyJustPressed(ebiten.KeyH):
+		g.playlist.SetShuffle(!g.playlist.Shuffle())
+	case inpututil.IsKeyJustPressed(ebiten.KeyR):
+		g.playlist.SetRepeat((g.playlist.Repeat() + 1) % (playlist.RepeatOne + 1))
+	case inpututil.IsKeyJustPressed(ebiten.KeyL):
+		g.showPlaylist = !g.showPlaylist
+	case inpututil.IsKeyJustPressed(ebiten.KeyI):
+		g.showInfo = !g.showInfo
+	case inpututil.IsKeyJustPressed(ebiten.KeyJ):
+		g.jamming = true
+		g.jam.Start(g.playlist.Entry(g.playlist.Current()).Format)
+	case inpututil.IsKeyJustPressed(ebiten.KeyPageUp):
+		g.setSpeed(g.tempoScale*tempoStep, g.transpose)
+	case inpututil.IsKeyJustPressed(ebiten.KeyPageDown):
+		g.setSpeed(g.tempoScale/tempoStep, g.transpose)
+	case inpututil.IsKeyJustPressed(ebiten.KeyUp):
+		g.setSpeed(g.tempoScale, g.transpose+transposeStep())
+	case inpututil.IsKeyJustPressed(ebiten.KeyDown):
+		g.setSpeed(g.tempoScale, g.transpose-transposeStep())
+	case inpututil.IsKeyJustPressed(ebiten.KeyBackslash):
+		g.setSpeed(1, 0)
+	case inpututil.IsKeyJustPressed(ebiten.KeyQ):
+		g.setInterpolation(g.interpolation.Next())
+	case inpututil.IsKeyJustPressed(ebiten.KeyG):
+		g.setNormalize(!g.normalize)
+	case inpututil.IsKeyJustPressed(ebiten.KeyF9):
+		g.master.NextDynamics()
+	case inpututil.IsKeyJustPressed(ebiten.KeyF10):
+		g.master.NextEQ()
+	case inpututil.IsKeyJustPressed(ebiten.KeyF11):
+		g.master.NextReverb()
+	case inpututil.IsKeyJustPressed(ebiten.KeyF12):
+		g.master.NextWidth()
+	case inpututil.IsKeyJustPressed(ebiten.KeyV):
+		g.showVisuals = !g.showVisuals
+	case inpututil.IsKeyJustPressed(ebiten.KeyTab):
+		if ebiten.IsKeyPressed(ebiten.KeyShift) {
+			g.view.Select(-1)
+		} else {
+			g.view.Select(1)
+		}
+	}
+
+	if ch, ok := g.view.SelectedChannel(); ok {
+		g.handleChannelInput(ch)
+	}
+
+	for i := 0; i < sfxKeys; i++ {
+		if inpututil.IsKeyJustPressed(ebiten.KeyF1 + ebiten.Key(i)) {
+			g.playSound(i)
+		}
+	}
+
+	for i := 0; i < 10; i++ {
+		if inpututil.IsKeyJustPressed(ebiten.KeyDigit0+ebiten.Key(i)) ||
+			inpututil.IsKeyJustPressed(ebiten.KeyNumpad0+ebiten.Key(i)) {
+			if len(g.orderInput) < 3 {
+				g.orderInput += strconv.Itoa(i)
+			}
+		}
+	}
+
+	switch {
+	case inpututil.IsKeyJustPressed(ebiten.KeyBackspace):
+		if len(g.orderInput) > 0 {
+			g.orderInput = g.orderInput[:len(g.orderInput)-1]
+		}
+	case inpututil.IsKeyJustPressed(ebiten.KeyEscape):
+		g.orderInput = ""
+	case inpututil.IsKeyJustPressed(ebiten.KeyEnter) || inpututil.IsKeyJustPressed(ebiten.KeyNumpadEnter):
+		if g.orderInput == "" {
+			break
+		}
+		order, _ := strconv.Atoi(g.orderInput)
+		g.orderInput = ""
+		return g.seekOrder(order)
+	}
+
+	return nil
+}
+
+// playSound plays instrument `i`+1 of the song in front as a sound effect, panned across from left to right
+// with `i` and with a higher priority for higher `i`
+func (g *Game) playSound(i int) {
+	_, p, ok := g.music.Current()
+	if !ok {
+		return
+	}
+	format := g.playlist.Entry(g.playlist.Current()).Format
+	_, err := g.sfx.Play(sfx.Sound{
+		Song:       p,
+		Instrument: i + 1,
+		Note:       note.Semitone(baseOctave(format) * 12),
+		Volume:     1,
+		Pan:        -0.7 + 1.4*float32(i)/(sfxKeys-1),
+		Priority:   i,
+		Duration:   sfxDuration,
+	})
+	if err != nil {
+		log.Printf("sound effect %d: %v", i+1, err)
+	}
+}
+
+// handleJamInput plays the instruments of the song in front from the keyboard, until Escape is pressed
+func (g *Game) handleJamInput() {
+	switch {
+	case inpututil.IsKeyJustPressed(ebiten.KeyEscape):
+		g.jam.Stop()
+		g.jamming = false
+		return
+	case inpututil.IsKeyJustPressed(ebiten.KeySpace):
+		g.togglePause()
+	}
+	name, p, ok := g.music.Current()
+	if !ok {
+		return
+	}
+	g.jam.HandleInput(p, g.info.Info(name))
+}
+
+// transposeStep returns how far the Up and Down keys transpose by: a semitone, or 10 cents with Shift held
+func transposeStep() float64 {
+	if ebiten.IsKeyPressed(ebiten.KeyShift) {
+		return 0.1
+	}
+	return 1
+}
+
+// setSpeed sets the tempo scale and transposition of every song
+func (g *Game) setSpeed(tempoScale, transpose float64) {
+	g.tempoScale = tempoScale
+	g.transpose = transpose
+	for i := 0; i < g.playlist.Len(); i++ {
+		if p, ok := g.music.Song(g.playlist.Entry(i).Path); ok {
+			p.SetTempoScale(tempoScale)
+			p.SetTranspose(transpose)
+		}
+	}
+}
+
+// setInterpolation sets how every song resamples its instruments
+func (g *Game) setInterpolation(mode interpolation.Mode) {
+	g.interpolation = mode
+	for i := 0; i < g.playlist.Len(); i++ {
+		if p, ok := g.music.Song(g.playlist.Entry(i).Path); ok {
+			p.SetInterpolation(mode)
+		}
+	}
+}
+
+// setNormalize turns bringing every song to the target loudness on or off
+func (g *Game) setNormalize(normalize bool) {
+	g.normalize = normalize
+	for i := 0; i < g.playlist.Len(); i++ {
+		name := g.playlist.Entry(i).Path
+		_ = g.music.SetGain(name, g.gain(name))
+	}
+}
+
+// gain returns the level the song called `name` is mixed at: the gain that brings it to the target
+// loudness, or 1 when normalization is off or the song was not measured
+func (g *Game) gain(name string) float32 {
+	r, ok := g.measured[name]
+	if !g.normalize || !ok {
+		return 1
+	}
+	return r.Gain(g.target, loudness.DefaultMaxTruePeak)
+}
+
+// describeLoudness describes the loudness of the song called `name` and the gain it plays at
+func (g *Game) describeLoudness(name string) string {
+	r, ok := g.measured[name]
+	switch {
+	case !ok:
+		return "loudness not measured"
+	case !g.normalize:
+		return fmt.Sprintf("%.1f LUFS, not normalized", r.Integrated)
+	}
+	return fmt.Sprintf("%.1f LUFS, %+.1f dB", r.Integrated, r.GainDB(g.target, loudness.DefaultMaxTruePeak))
+}
+
+// changeSong skips `n` songs along the playlist and changes to that song as described by `t`
+func (g *Game) changeSong(n int, t music.Transition) error {
+	return g.startSong(g.playlist.Skip(n), t)
+}
+
+// startSong loads song `i` of the playlist and changes to it from the top as described by `t`
+func (g *Game) startSong(i int, t music.Transition) error {
+	if err := g.loadSong(i); err != nil {
+		return err
+	}
+	if err := g.music.Play(g.playlist.Entry(i).Path, t); err != nil {
+		return err
+	}
+	g.music.Prune()
+	g.ended = false
+	return nil
+}
+
+// title returns the title of the song called `name` in the music manager
+func (g *Game) title(name string) string {
+	for i := 0; i < g.playlist.Len(); i++ {
+		if e := g.playlist.Entry(i); e.Path == name {
+			return e.Title
+		}
+	}
+	return name
+}
+
+// currentSong returns the song in front
+func (g *Game) currentSong() playback.Playback {
+	_, p, _ := g.music.Current()
+	return p
+}
+
+// mixer returns the channel mixing of the song in front
+func (g *Game) mixer() *channelmix.Mixer {
+	name, _, _ := g.music.Current()
+	return g.mixers[name]
+}
+
+// handleChannelInput handles the keys that change the mixing of song channel `ch`
+func (g *Game) handleChannelInput(ch int) {
+	mixer := g.mixer()
+	switch {
+	case inpututil.IsKeyJustPressed(ebiten.KeyM):
+		mixer.SetMute(ch, !mixer.Mute(ch))
+	case inpututil.IsKeyJustPressed(ebiten.KeyO):
+		mixer.SetSolo(ch, !mixer.Solo(ch))
+	case inpututil.IsKeyJustPressed(ebiten.KeyMinus):
+		if vol := mixer.Volume(ch) - 0.1; vol > 0.05 {
+			mixer.SetVolume(ch, vol)
+		} else {
+			mixer.SetVolume(ch, 0)
+		}
+	case inpututil.IsKeyJustPressed(ebiten.KeyEqual):
+		if vol := mixer.Volume(ch) + 0.1; vol < 2 {
+			mixer.SetVolume(ch, vol)
+		}
+	case inpututil.IsKeyJustPressed(ebiten.KeyComma):
+		pan, _ := mixer.Pan(ch)
+		mixer.SetPan(ch, pan-0.25)
+	case inpututil.IsKeyJustPressed(ebiten.KeyPeriod):
+		pan, _ := mixer.Pan(ch)
+		mixer.SetPan(ch, pan+0.25)
+	case inpututil.IsKeyJustPressed(ebiten.KeyP):
+		mixer.ClearPan(ch)
+	}
+}
+
+func (g *Game) togglePause() {
+	g.paused = !g.paused
+	if g.paused {
+		g.musicPlayer.Pause()
+	} else {
+		g.musicPlayer.Play()
+	}
+}
+
+// seekOrder moves playback to the start of `order` in the order list
+func (g *Game) seekOrder(order int) error {
+	if numOrders := g.currentSong().GetNumOrders(); order >= numOrders {
+		order = numOrders - 1
+	}
+	if order < 0 {
+		order = 0
+	}
+
+	if err := g.music.Seek(index.Order(order), 0); err != nil {
+		// the song is still where it was, so just carry on from there
+		log.Println(err)
+		return nil
+	}
+	g.ended = false
+	return g.flush()
+}
+
+// restart plays the current song of the playlist from the very beginning, with all of its initial settings
+func (g *Game) restart() error {
+	if err := g.startSong(g.playlist.Current(), music.Transition{}); err != nil {
+		return err
+	}
+	return g.flush()
+}
+
+// flush throws away the audio that has been generated but not heard yet, so that
+// the new position is heard right away
+func (g *Game) flush() error {
+	if g.musicPlayer != nil {
+		// closing the player also drops the audio it has buffered internally
+		if err := g.musicPlayer.Close(); err != nil {
+			return err
+		}
+	}
+	g.rb.Clear()
+	g.timeline.Reset()
+	g.visuals.Reset()
+	g.master.Reset()
+
+	player, err := g.audioContext.NewPlayer(newDeviceReader(g.rb, g.output))
+	if err != nil {
+		return err
+	}
+	player.SetBufferSize(audioBufferSize)
+	g.musicPlayer = player
+	return nil
+}
+
+// heardFrame returns the number of frames the audio player has read out of the ring buffer
+func (g *Game) heardFrame() int64 {
+	return g.rb.Consumed() / int64(ringBytesPerFrame(g.output))
+}
+
+// bufferAheadBytes returns how much audio is generated ahead of the audio player, in bytes of the ring buffer
+func (g *Game) bufferAheadBytes() int {
+	return int(int64(g.output.SampleRate)*int64(bufferAhead)/int64(time.Second)) * ringBytesPerFrame(g.output)
+}
+
+// heardPosition returns the song position of the audio that is coming out of the speakers right now,
+// which is behind the position of the song player by however much audio is buffered
+func (g *Game) heardPosition() timeline.Position {
+	if pos, ok := g.timeline.Heard(g.heardFrame()); ok && pos.Song != nil {
+		return pos
+	}
+	p := g.currentSong()
+	return timeline.Position{
+		Song:  p,
+		Order: int(p.GetCurrentOrder()),
+		Row:   int(p.GetCurrentRow()),
+	}
+}
+
 func (g *Game) Draw(screen *ebiten.Image) {
-	ebitenutil.DebugPrint(screen, "Now playing... belthsar.s3m (Sandro R.)")
+	state := "Playing"
+	switch {
+	case g.ended:
+		state = "Finished"
+	case g.paused:
+		state = "Paused"
+	}
+
+	pos := g.heardPosition()
+	name, _, _ := g.music.Current()
+	msg := fmt.Sprintf("Now playing... [%d/%d] %s (%s)", g.playlist.Current()+1, g.playlist.Len(), g.title(name),
+		g.describeLoudness(name))
+	if next, _, ok := g.music.Queued(); ok {
+		msg += "    Next: " + g.title(next) + " at the end of the pattern"
+	}
+	msg += "\n"
+	msg += fmt.Sprintf("%s - Order %d/%d, Row %d, Tick %d (%.1fs buffered, %s, %s)  Tempo x%.2f, transpose %+.2f",
+		state, pos.Order, pos.Song.GetNumOrders()-1, pos.Row, pos.Tick,
+		g.timeline.Ahead(g.heardFrame()).Seconds(), describeOutput(g.output), g.interpolation, g.tempoScale,
+		g.transpose)
+	msg += "\n"
+	if ch, ok := g.view.SelectedChannel(); ok {
+		pan := "song"
+		if p, ok := g.mixer().Pan(ch); ok {
+			pan = fmt.Sprintf("%+.2f", p)
+		}
+		msg += fmt.Sprintf("Channel %d: volume %d%%, pan %s    ", ch+1, int(g.mixer().Volume(ch)*100+0.5), pan)
+	}
+	shuffle := "off"
+	if g.playlist.Shuffle() {
+		shuffle = "on"
+	}
+	msg += fmt.Sprintf("Shuffle %s, repeat %s, SFX voices %d/%d", shuffle, g.playlist.Repeat(), g.sfx.Playing(),
+		g.sfx.Voices())
+	msg += "\n"
+	if g.hasLastSync {
+		msg += fmt.Sprintf("Sync marker %c%02X on channel %d (order %d, row %d)    ", g.lastSync.Command, g.lastSync.Param,
+			g.lastSync.Channel+1, g.lastSync.Position.Order, g.lastSync.Position.Row)
+	}
+	msg += g.master.String()
+	if g.orderInput != "" {
+		msg += fmt.Sprintf("    Go to order: %s_", g.orderInput)
+	}
+	ebitenutil.DebugPrint(screen, msg)
+
+	const (
+		viewTop    = 4 * glyphHeight
+		viewBottom = screenHeight - 8*glyphHeight
+	)
+	switch {
+	case g.jamming:
+		g.jam.Draw(screen, g.info.Info(name), viewTop, viewBottom-viewTop)
+	case g.showInfo:
+		g.info.Draw(screen, name, viewTop, viewBottom-viewTop)
+	case g.showPlaylist:
+		drawPlaylist(screen, g.playlist, name, viewTop, viewBottom-viewTop)
+	case g.showVisuals:
+		heard := g.timeline.HeardFrame(g.heardFrame())
+		g.visuals.Draw(screen, heard, pos.Song.GetNumChannels(), viewTop, viewBottom-viewTop)
+	default:
+		g.view.Draw(screen, pos.Song, g.mixer(), pos, viewTop, viewBottom-viewTop)
+	}
+
+	help := "Space: pause/resume  S: stop  Home: restart  "
+	help += "F9: limiter/soft clip/off  F10: EQ  F11: reverb  F12: width\n"
+	help += "Left/Right: previous/next order  0-9, Enter: go to order\n"
+	help += "Tab: select channel  [/]: scroll channels  M: mute  O: solo\n"
+	help += "-/=: channel volume  ,/.: channel pan  P: song pan\n"
+	help += "N/B: next/previous song  X: crossfade to the next song  C: next song at the end of the pattern\n"
+	help += "H: shuffle  R: repeat (off, all, one)  L: playlist  I: song info (Up/Down/PgUp/PgDn: scroll)\n"
+	help += "F1-F8: play instruments 1-8 as sound effects  Q: interpolation (nearest to sinc, Amiga filters)\n"
+	help += "PgUp/PgDn: tempo  Up/Down: transpose (Shift: cents)  \\: normal speed  V: visualizers  J: jam  G: loudness"
+	if g.jamming {
+		help = "Jam mode: play the instruments of the song from the keyboard\n"
+		help += "Z S X D C V G B H N J M , L . ; /: notes of the octave\n"
+		help += "Q 2 W 3 E R 5 T 6 Y 7 U I 9 O 0 P [ = ]: notes of the octave above\n"
+		help += "Left/Right: instrument  Up/Down: octave\n"
+		help += "Space: pause/resume the song, to play on your own\n"
+		help += "Escape: leave jam mode"
+	}
+	ebitenutil.DebugPrintAt(screen, help, 0, viewBottom+glyphHeight/2)
 }
 
 func (g *Game) Layout(outsideWidth, outsideHeight int) (int, int) {
@@ -64,57 +603,195 @@ func (g *Game) Layout(outsideWidth, outsideHeight int) (int, int) {
 }
 
 func (g *Game) GenerateSamples() {
-	premix, err := g.trackPlayer.Generate(0)
+	b, err := g.music.Next()
 	if err != nil {
-		// log.Fatal(err)
+		if !errors.Is(err, song.ErrStopSong) {
+			log.Println(err)
+		}
+		if errors.Is(err, song.ErrStopSong) && g.advance() {
+			return
+		}
+		g.ended = true
+		return
 	}
 
-	const sampleFormat = sampling.Format16BitLESigned
-	data := g.m.Flatten(g.panMixer, premix.SamplesLen, premix.Data, premix.MixerVolume, sampleFormat)
-	for j := 0; j < len(data); j++ {
-		g.rb.Append(data[j])
+	if p, ok := g.music.Song(b.Song); ok && b.Premix != nil {
+		g.timeline.MarkPremix(p, b.Premix)
+	} else {
+		g.timeline.Skip(b.Frames)
 	}
+
+	// the sound effects mix into the music, so that the master chain keeps them both from clipping
+	g.sfx.Read(b.Samples)
+	g.master.Process(b.Samples)
+	g.visuals.Write(b)
+
+	// the ring buffer keeps the mix in float, and the device reader converts it into the output format
+	g.pcm = render.AppendPCM(g.pcm[:0], b.Samples, 32)
+	g.rb.Write(g.pcm)
 }
 
-func main() {
-	ebiten.SetWindowSize(screenWidth, screenHeight)
-	ebiten.SetWindowTitle("Tracker (Demo)")
-	// ebiten.SetRunnableOnUnfocused(false)
+// advance moves on to the next song of the playlist once the song in front has ended, returning false when
+// the playlist has come to its end
+func (g *Game) advance() bool {
+	for tries := 0; tries < g.playlist.Len(); tries++ {
+		i, ok := g.playlist.Next()
+		if !ok {
+			return false
+		}
+		if err := g.startSong(i, music.Transition{}); err != nil {
+			// carry on with the song after it rather than stopping the whole playlist
+			log.Println(err)
+			continue
+		}
+		return true
+	}
+	return false
+}
 
-	g := &Game{}
-	g.rb = NewRingBuffer(sampleRate * 40)
+// loadSong loads song `i` of the playlist into the music manager, replacing it if it was loaded before
+func (g *Game) loadSong(i int) error {
+	e := g.playlist.Entry(i)
+	data, err := e.Read()
+	if err != nil {
+		return err
+	}
 
 	var features []feature.Feature
 	features = append(features, feature.UseNativeSampleFormat(true))
 	features = append(features, feature.IgnoreUnknownEffect{Enabled: true})
-	features = append(features, feature.SongLoop{Count: 0})
+	features = append(features, feature.SongLoop{Count: e.Loops})
+	// the music manager mixes every tick down before it asks for the next one
+	features = append(features, feature.ReuseBuffers{Enabled: true})
 
-	player, _, err := format.LoadFromReader("s3m", bytes.NewReader(fileBytes), features)
+	player, _, err := format.LoadFromReader(e.Format, bytes.NewReader(data), features)
 	if err != nil {
-		panic(err)
+		return err
 	}
 
-	const channels = 2
-	if err := player.SetupSampler(sampleRate, channels); err != nil {
-		panic(err)
+	if err := player.SetupSampler(g.output.SampleRate, g.output.Channels); err != nil {
+		return err
 	}
 
 	if err := player.Configure(features); err != nil {
-		panic(err)
+		return err
+	}
+	player.SetTempoScale(g.tempoScale)
+	player.SetTranspose(g.transpose)
+	player.SetInterpolation(g.interpolation)
+	g.music.Add(e.Path, player)
+	if r, ok := g.loudness.Lookup(data); ok {
+		g.measured[e.Path] = r
+	} else {
+		delete(g.measured, e.Path)
+	}
+	if err := g.music.SetGain(e.Path, g.gain(e.Path)); err != nil {
+		return err
 	}
-	g.trackPlayer = player
+	g.info.Add(e.Path, e.Format, data)
+	if _, ok := g.mixers[e.Path]; !ok {
+		g.mixers[e.Path] = channelmix.New(g.output.SampleRate)
+	}
+	return nil
+}
 
-	g.m = mixing.Mixer{
-		Channels: channels,
+func main() {
+	playlistPath := flag.String("playlist", "", "directory, M3U playlist or song to play instead of the built-in soundtrack")
+	loops := flag.Int("loops", 0, "number of times every song repeats before the playlist moves on; negative loops forever")
+	shuffle := flag.Bool("shuffle", false, "shuffle the playlist")
+	out := render.DefaultOptions
+	flag.IntVar(&out.SampleRate, "rate", out.SampleRate, "output sample rate in Hz: 22050, 32000, 44100 or 48000")
+	flag.IntVar(&out.Channels, "channels", out.Channels, "number of output channels: 1 (mono) or 2 (stereo)")
+	flag.IntVar(&out.BitsPerSample, "bits", out.BitsPerSample, "bits per sample of the output: 16, or 32 for float")
+	interp := flag.String("interp", "linear", "sample interpolation: linear, nearest, cubic, sinc, a500 or a1200")
+	loudnessPath := flag.String("loudness", "", "loudness cache written by cmd/loudness for the songs of -playlist")
+	target := flag.Float64("target", loudness.DefaultTarget, "loudness every song is brought to, in LUFS")
+	normalize := flag.Bool("normalize", true, "bring every song to the -target loudness")
+	flag.Parse()
+	if err := checkOutput(out); err != nil {
+		log.Fatal(err)
 	}
-	g.panMixer = mixing.GetPanMixer(channels)
 
-	g.audioContext = audio.NewContext(sampleRate)
-	g.musicPlayer, err = g.audioContext.NewPlayer(g.rb)
-	g.musicPlayer.SetBufferSize(time.Second / 20)
+	var (
+		entries []playlist.Entry
+		err     error
+	)
+	if *playlistPath != "" {
+		entries, err = playlist.Open(*playlistPath, *loops)
+	} else {
+		entries, err = playlist.FromM3U(soundtrack, "soundtrack.m3u", *loops)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	mode, err := interpolation.Parse(*interp)
+	if err != nil {
+		log.Fatalf("%v: %q", err, *interp)
+	}
+	cache, err := loudness.ReadCache(bytes.NewReader(soundtrackLoudness))
+	if err != nil {
+		// the songs play as they are, rather than not at all
+		log.Printf("loudness.json: %v", err)
+		cache = loudness.NewCache()
+	}
+	if *loudnessPath != "" {
+		c, err := loudness.LoadCache(*loudnessPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		cache.Merge(c)
+	}
+
+	ebiten.SetWindowSize(screenWidth, screenHeight)
+	ebiten.SetWindowTitle("Tracker (Demo)")
+	// ebiten.SetRunnableOnUnfocused(false)
+
+	g := &Game{
+		playlist:      playlist.New(entries),
+		output:        out,
+		tempoScale:    1,
+		interpolation: mode,
+		loudness:      cache,
+		measured:      make(map[string]loudness.Result),
+		normalize:     *normalize,
+		target:        *target,
+	}
+	g.playlist.SetShuffle(*shuffle)
+	g.rb = NewRingBuffer(out.SampleRate * ringBytesPerFrame(out) * 10)
+	g.timeline = timeline.New(out.SampleRate)
+	g.visuals = newVisualView(out)
+	g.sfx = sfx.New(out.SampleRate, out.Channels, sfxVoices)
+	g.master = newMasterChain(out)
+	g.info = newInfoView()
+	// the audio player reads this far ahead of what comes out of the speakers
+	g.timeline.SetLatency(audioBufferSize)
+	g.timeline.OnNote(timeline.Any, timeline.Any, func(e timeline.Event) {
+		g.view.NoteOn(e.Channel)
+	})
+	g.timeline.OnEffect('Z', func(e timeline.Event) {
+		g.lastSync = e
+		g.hasLastSync = true
+	})
+
+	g.music = music.New(out.SampleRate, out.Channels)
+	g.mixers = make(map[string]*channelmix.Mixer)
+	g.music.OnPremix(func(name string, premix *output.PremixData) {
+		g.mixers[name].Apply(premix)
+	})
+	if err := g.startSong(g.playlist.Current(), music.Transition{}); err != nil {
+		panic(err)
+	}
+
+	g.audioContext = audio.NewContext(out.SampleRate)
+	if g.jam, err = newJamView(g.audioContext, out); err != nil {
+		panic(err)
+	}
+	if err := g.flush(); err != nil {
+		panic(err)
+	}
 
 	if start {
-		for i := 0; i < 40*10; i++ {
+		for !g.ended && g.rb.Size() <= g.bufferAheadBytes() {
 			g.GenerateSamples()
 		}
 		start = false
@@ -2,57 +2,148 @@ package main
 
 import (
 	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
 	"testing"
 
-	"github.com/gotracker/gomixing/mixing"
-	"github.com/gotracker/gomixing/sampling"
 	"github.com/gotracker/playback/format"
-	"github.com/gotracker/playback/output"
 	"github.com/gotracker/playback/player/feature"
+	playerrender "github.com/gotracker/playback/player/render"
+	"github.com/gotracker/playback/song"
+
+	"github.com/eliasdaler/ebiten-tracker-demo/render"
 )
 
+// benchmarkOutputs are the output formats the generation benchmark runs with
+var benchmarkOutputs = []render.Options{
+	render.DefaultOptions,
+	{SampleRate: 22050, Channels: 1, BitsPerSample: 16},
+	{SampleRate: 48000, Channels: 2, BitsPerSample: 32},
+}
+
 func BenchmarkGenerate(b *testing.B) {
+	for _, out := range benchmarkOutputs {
+		b.Run(fmt.Sprintf("%dHz_%dch_%dbit", out.SampleRate, out.Channels, out.BitsPerSample), func(b *testing.B) {
+			benchmarkGenerate(b, out)
+		})
+	}
+}
+
+func benchmarkGenerate(b *testing.B, out render.Options) {
 	b.ReportAllocs()
 
 	var features []feature.Feature
 	features = append(features, feature.UseNativeSampleFormat(true))
 	features = append(features, feature.IgnoreUnknownEffect{Enabled: true})
 	features = append(features, feature.SongLoop{Count: 0})
+	features = append(features, feature.ReuseBuffers{Enabled: true})
 
-	player, _, err := format.LoadFromReader("xm", bytes.NewReader(fileBytes), features)
+	player, _, err := format.LoadFromReader("s3m", bytes.NewReader(fileBytes), features)
 	if err != nil {
-		panic(err)
+		b.Fatal(err)
 	}
 
-	const channels = 2
-	if err := player.SetupSampler(sampleRate, channels); err != nil {
-		panic(err)
+	if err := player.SetupSampler(out.SampleRate, out.Channels); err != nil {
+		b.Fatal(err)
 	}
 
 	if err := player.Configure(features); err != nil {
-		panic(err)
+		b.Fatal(err)
 	}
 
-	const premixChannelSize = 8
-	premixDataChannel := make(chan *output.PremixData, premixChannelSize)
-	defer close(premixDataChannel)
-
-	const sampleFormat = sampling.Format16BitLESigned
-
-	m := mixing.Mixer{
-		Channels: channels,
-	}
-
-	panMixer := mixing.GetPanMixer(channels)
+	// mirror Game.GenerateSamples, which mixes down and encodes into buffers it keeps
+	flat := playerrender.NewFlattener(out.Channels)
+	var samples []float32
+	var pcm []byte
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
 		premix, err := player.Generate(0)
+		if errors.Is(err, song.ErrStopSong) {
+			// start the song over, so that every iteration renders a tick
+			if err := player.Seek(0, 0); err != nil {
+				b.Fatal(err)
+			}
+			continue
+		}
 		if err != nil {
-			// log.Fatal(err)
+			b.Fatal(err)
 		}
+		if premix == nil {
+			continue
+		}
+
+		samples = flat.Append(samples[:0], premix.SamplesLen, premix.Data, premix.MixerVolume)
+		pcm = render.AppendPCM(pcm[:0], samples, 32)
+	}
+}
+
+// TestDeviceReader checks that the float mix in the ring buffer reaches the audio device as 16-bit stereo in
+// every output format, with mono on both sides
+func TestDeviceReader(t *testing.T) {
+	tests := []struct {
+		out     render.Options
+		samples []float32
+		want    []int16
+	}{
+		{
+			out:     render.Options{SampleRate: 44100, Channels: 2, BitsPerSample: 16},
+			samples: []float32{0, 0.5, -0.25, 1.5},
+			want:    []int16{0, 16384, -8192, 32767},
+		},
+		{
+			out:     render.Options{SampleRate: 44100, Channels: 2, BitsPerSample: 32},
+			samples: []float32{0, 0.5, -0.25, 1.5},
+			want:    []int16{0, 16384, -8192, 32767},
+		},
+		{
+			out:     render.Options{SampleRate: 22050, Channels: 1, BitsPerSample: 16},
+			samples: []float32{0.5, -1.5},
+			want:    []int16{16384, 16384, -32768, -32768},
+		},
+		{
+			out:     render.Options{SampleRate: 22050, Channels: 1, BitsPerSample: 32},
+			samples: []float32{0.5, -1.5},
+			want:    []int16{16384, 16384, -32768, -32768},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(describeOutput(tt.out), func(t *testing.T) {
+			if err := checkOutput(tt.out); err != nil {
+				t.Fatal(err)
+			}
+			rb := NewRingBuffer(64)
+			if _, err := rb.Write(render.AppendPCM(nil, tt.samples, 32)); err != nil {
+				t.Fatal(err)
+			}
 
-		data := m.Flatten(panMixer, premix.SamplesLen, premix.Data, premix.MixerVolume, sampleFormat)
-		_ = data
+			p := make([]byte, len(tt.want)*2)
+			n, err := newDeviceReader(rb, tt.out).Read(p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n != len(p) {
+				t.Fatalf("read %d bytes, want %d", n, len(p))
+			}
+			for i, want := range tt.want {
+				if got := int16(binary.LittleEndian.Uint16(p[i*2:])); got != want {
+					t.Errorf("sample %d is %d, want %d", i, got, want)
+				}
+			}
+			if got, want := rb.Consumed(), int64(len(tt.samples)*4); got != want {
+				t.Errorf("the reader took %d bytes of the ring buffer, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestCheckOutputBits(t *testing.T) {
+	for bits, want := range map[int]error{16: nil, 32: nil, 8: errInvalidBitsPerSample, 24: errInvalidBitsPerSample} {
+		out := render.DefaultOptions
+		out.BitsPerSample = bits
+		if err := checkOutput(out); err != want {
+			t.Errorf("checkOutput with %d bits = %v, want %v", bits, err, want)
+		}
 	}
 }
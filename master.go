@@ -0,0 +1,110 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/eliasdaler/ebiten-tracker-demo/dsp"
+	"github.com/eliasdaler/ebiten-tracker-demo/render"
+)
+
+var (
+	eqPresets = []struct {
+		name           string
+		low, mid, high float64
+	}{
+		{"flat", 0, 0, 0},
+		{"bass boost", 6, 0, 0},
+		{"smile", 5, -3, 4},
+		{"lo-fi", -9, 3, -9},
+	}
+	reverbPresets = []struct {
+		name          string
+		roomSize, mix float32
+	}{
+		{"off", 0, 0},
+		{"room", 0.3, 0.15},
+		{"hall", 0.85, 0.3},
+	}
+	widthPresets = []float32{1, 1.5, 0.5, 0}
+)
+
+// dynamicsModes are the ways the output can be kept from clipping, which F9 steps through
+var dynamicsModes = []string{"limiter", "soft clip", "no limiting"}
+
+const (
+	dynamicsLimiter = iota
+	dynamicsSoftClip
+)
+
+// masterChain is the effects chain that the music and sound effects go through on their way to the output,
+// with the presets that the function keys step through
+// Jam notes skip it, as they have an audio player of their own.
+type masterChain struct {
+	*dsp.Chain
+	eq, reverb, width, limiter, clip *dsp.Stage
+
+	dynamicsMode, eqPreset, reverbPreset, widthPreset int
+}
+
+// newMasterChain creates the effects chain for audio in the format of `out`, with only the limiter on
+func newMasterChain(out render.Options) *masterChain {
+	c := &masterChain{Chain: dsp.NewChain()}
+	c.eq = c.Add("eq", dsp.NewEQ(out.SampleRate, out.Channels))
+	c.reverb = c.Add("reverb", dsp.NewReverb(out.SampleRate, out.Channels))
+	c.width = c.Add("width", dsp.NewWidth(out.Channels))
+	c.limiter = c.Add("limiter", dsp.NewLimiter(out.SampleRate, out.Channels))
+	c.clip = c.Add("soft clip", dsp.NewSoftClipper())
+	c.apply()
+	return c
+}
+
+// NextDynamics switches to the next way of keeping the output from clipping
+func (c *masterChain) NextDynamics() {
+	c.dynamicsMode = (c.dynamicsMode + 1) % len(dynamicsModes)
+	c.apply()
+}
+
+// NextEQ switches to the next EQ preset
+func (c *masterChain) NextEQ() {
+	c.eqPreset = (c.eqPreset + 1) % len(eqPresets)
+	c.apply()
+}
+
+// NextReverb switches to the next reverb preset
+func (c *masterChain) NextReverb() {
+	c.reverbPreset = (c.reverbPreset + 1) % len(reverbPresets)
+	c.apply()
+}
+
+// NextWidth switches to the next stereo width
+func (c *masterChain) NextWidth() {
+	c.widthPreset = (c.widthPreset + 1) % len(widthPresets)
+	c.apply()
+}
+
+// apply sets up the stages for the presets, bypassing the ones that would leave the audio as it is
+func (c *masterChain) apply() {
+	c.limiter.Bypass = c.dynamicsMode != dynamicsLimiter
+	c.clip.Bypass = c.dynamicsMode != dynamicsSoftClip
+
+	eq := eqPresets[c.eqPreset]
+	c.eq.Processor.(*dsp.EQ).SetGains(eq.low, eq.mid, eq.high)
+	c.eq.Bypass = c.eqPreset == 0
+
+	rv := reverbPresets[c.reverbPreset]
+	reverb := c.reverb.Processor.(*dsp.Reverb)
+	reverb.RoomSize, reverb.Mix = rv.roomSize, rv.mix
+	if c.reverb.Bypass = c.reverbPreset == 0; c.reverb.Bypass {
+		// start from silence when it is turned back on, rather than with the tail of the last time
+		reverb.Reset()
+	}
+
+	c.width.Processor.(*dsp.Width).Amount = widthPresets[c.widthPreset]
+	c.width.Bypass = c.widthPreset == 0
+}
+
+// String describes the settings of the chain for the status line
+func (c *masterChain) String() string {
+	return fmt.Sprintf("%s, EQ %s, reverb %s, width %d%%", dynamicsModes[c.dynamicsMode],
+		eqPresets[c.eqPreset].name, reverbPresets[c.reverbPreset].name, int(widthPresets[c.widthPreset]*100))
+}
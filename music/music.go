@@ -0,0 +1,473 @@
+// Package music plays one of several loaded songs at a time, and changes between them with crossfades or
+// exactly at row, pattern or song boundaries, the way game music systems do
+package music
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/gotracker/gomixing/volume"
+
+	"github.com/gotracker/playback"
+	"github.com/gotracker/playback/index"
+	"github.com/gotracker/playback/output"
+	"github.com/gotracker/playback/player/render"
+	"github.com/gotracker/playback/song"
+)
+
+var (
+	// ErrUnknownSong is for when a song name was never added to the manager
+	ErrUnknownSong = errors.New("unknown song")
+)
+
+// When is the point at which a song change happens
+type When int
+
+const (
+	// Now changes songs straight away
+	Now = When(iota)
+	// AtRowEnd changes songs once the row playing now has finished
+	AtRowEnd
+	// AtPatternEnd changes songs once the pattern playing now has finished and the song moves on in its order list
+	AtPatternEnd
+	// AtSongEnd changes songs once the song playing now has ended
+	AtSongEnd
+)
+
+// Transition describes how to change from the song playing now to another one
+type Transition struct {
+	When When
+	// Fade is how long the two songs crossfade for, and the new song cuts in when it is zero
+	Fade time.Duration
+	// Resume carries on from where the song was left last time, instead of starting it from the top
+	Resume bool
+}
+
+// Block is a stretch of mixed audio from the manager
+type Block struct {
+	Frames int
+	// Samples holds the interleaved samples of the block, where 1 is full scale
+	// Loud songs can go over it, as the samples are not clipped, to leave that to a limiter or to the encoding.
+	Samples []float32
+	// Song is the name of the song in front, which is fading in or playing on its own, or "" when there is none
+	Song string
+	// Premix is the premix data the song in front rendered the block from, or nil when it did not render any
+	// of it, such as when it has ended
+	Premix *output.PremixData
+}
+
+// silenceFrames is how long a block is when the song in front does not set its length, in frames per second
+const silenceFrames = 50
+
+type track struct {
+	name   string
+	player playback.Playback
+	ended  bool
+	// gain is the level the song is mixed at, such as to bring it to the loudness of the others
+	gain float32
+
+	// pending holds rendered samples that have not been mixed yet, when the track is not in front
+	pending []float32
+
+	// fade goes from 0 (silent) to 1 (full volume) and moves by fadeStep every frame
+	fade     float32
+	fadeStep float32
+}
+
+type queuedChange struct {
+	to *track
+	t  Transition
+}
+
+// Manager holds several loaded songs and mixes the ones that are playing
+type Manager struct {
+	sampleRate int
+	channels   int
+	flat       *render.Flattener
+
+	// block is the block Next returns, and taken the samples take returns, which are used again every time
+	block Block
+	taken []float32
+
+	songs  map[string]*track
+	front  *track
+	fading []*track
+	queued *queuedChange
+
+	onPremix func(name string, premix *output.PremixData)
+}
+
+// New creates a manager that mixes songs at `sampleRate` frames per second into `channels` channels
+// Songs added to it must be set up with the same sample rate and channels.
+func New(sampleRate, channels int) *Manager {
+	return &Manager{
+		sampleRate: sampleRate,
+		channels:   channels,
+		flat:       render.NewFlattener(channels),
+		songs:      make(map[string]*track),
+	}
+}
+
+// Add adds song `p` under `name`, replacing any song added under that name before
+// A song that replaces the one in front starts playing in its place right away, and keeps its gain.
+func (m *Manager) Add(name string, p playback.Playback) {
+	t := &track{
+		name:   name,
+		player: p,
+		gain:   1,
+	}
+	if old, ok := m.songs[name]; ok {
+		t.gain = old.gain
+		if m.front == old {
+			t.fade = old.fade
+			t.fadeStep = old.fadeStep
+			m.front = t
+		}
+		m.removeFading(old)
+		if m.queued != nil && m.queued.to == old {
+			m.queued.to = t
+		}
+	}
+	m.songs[name] = t
+}
+
+// Song returns the song added under `name`
+func (m *Manager) Song(name string) (playback.Playback, bool) {
+	t, ok := m.songs[name]
+	if !ok {
+		return nil, false
+	}
+	return t.player, true
+}
+
+// SetGain sets the level the song added under `name` is mixed at, where 1 leaves it as it is
+func (m *Manager) SetGain(name string, gain float32) error {
+	t, ok := m.songs[name]
+	if !ok {
+		return ErrUnknownSong
+	}
+	t.gain = gain
+	return nil
+}
+
+// Current returns the name and the song in front, or false when no song is playing
+func (m *Manager) Current() (string, playback.Playback, bool) {
+	if m.front == nil {
+		return "", nil, false
+	}
+	return m.front.name, m.front.player, true
+}
+
+// Queued returns the name of the song waiting for its transition, or false when there is none
+// The name is "" when the manager is waiting to stop.
+func (m *Manager) Queued() (string, Transition, bool) {
+	if m.queued == nil {
+		return "", Transition{}, false
+	}
+	if m.queued.to == nil {
+		return "", m.queued.t, true
+	}
+	return m.queued.to.name, m.queued.t, true
+}
+
+// OnPremix sets a function that gets to see and change the premix data of every song before it is mixed,
+// such as to apply per-channel mixing
+func (m *Manager) OnPremix(fn func(name string, premix *output.PremixData)) {
+	m.onPremix = fn
+}
+
+// Play changes to the song added under `name` as described by `t`, replacing any change that is still queued
+// When the song is already in front, it just cancels the queued change.
+func (m *Manager) Play(name string, t Transition) error {
+	to, ok := m.songs[name]
+	if !ok {
+		return ErrUnknownSong
+	}
+	if to == m.front {
+		m.queued = nil
+		return nil
+	}
+	m.change(to, t)
+	return nil
+}
+
+// Stop fades out the song in front as described by `t`, leaving silence
+func (m *Manager) Stop(t Transition) {
+	m.change(nil, t)
+}
+
+func (m *Manager) change(to *track, t Transition) {
+	m.queued = nil
+	if t.When == Now || m.front == nil {
+		m.start(to, t)
+		return
+	}
+	m.queued = &queuedChange{
+		to: to,
+		t:  t,
+	}
+}
+
+// start puts `to` in front, fading out the song that was there before over `t.Fade`
+func (m *Manager) start(to *track, t Transition) {
+	fadeFrames := float32(t.Fade) * float32(m.sampleRate) / float32(time.Second)
+
+	if old := m.front; old != nil {
+		if fadeFrames < 1 {
+			old.fade = 0
+			old.fadeStep = 0
+			old.pending = old.pending[:0]
+		} else {
+			old.fadeStep = -1 / fadeFrames
+			m.fading = append(m.fading, old)
+		}
+	}
+
+	m.front = to
+	if to == nil {
+		return
+	}
+
+	wasFading := m.removeFading(to)
+	if !t.Resume {
+		_ = to.player.Seek(0, 0)
+		to.ended = false
+		to.pending = to.pending[:0]
+		to.fade = 0
+	} else if !wasFading {
+		to.fade = 0
+	}
+	if fadeFrames < 1 {
+		to.fade = 1
+		to.fadeStep = 0
+	} else {
+		to.fadeStep = 1 / fadeFrames
+	}
+}
+
+// Seek moves the song in front to `row` of `order`, and lets it play again if it had ended
+// The audio it already rendered but has not been mixed yet is dropped.
+func (m *Manager) Seek(order index.Order, row index.Row) error {
+	if m.front == nil {
+		return nil
+	}
+	if err := m.front.player.Seek(order, row); err != nil {
+		return err
+	}
+	m.front.ended = false
+	m.front.pending = m.front.pending[:0]
+	return nil
+}
+
+// Prune removes the songs that are not in front, fading out or waiting for their transition, so that they
+// can be freed
+func (m *Manager) Prune() {
+	for name, t := range m.songs {
+		if t == m.front || (m.queued != nil && m.queued.to == t) || m.isFading(t) {
+			continue
+		}
+		delete(m.songs, name)
+	}
+}
+
+// isFading returns true if `t` is fading out
+func (m *Manager) isFading(t *track) bool {
+	for _, f := range m.fading {
+		if f == t {
+			return true
+		}
+	}
+	return false
+}
+
+// removeFading takes `t` off the list of songs that are fading out, returning true if it was there
+func (m *Manager) removeFading(t *track) bool {
+	for i, f := range m.fading {
+		if f == t {
+			m.fading = append(m.fading[:i], m.fading[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Next mixes the next block of audio
+// The song in front renders one tick per block, and it returns song.ErrStopSong once no song is playing at all.
+// The block is only valid until Next is called again, as its memory is used for the block after it.
+func (m *Manager) Next() (*Block, error) {
+	if m.front == nil && len(m.fading) == 0 {
+		return nil, song.ErrStopSong
+	}
+
+	b := &m.block
+	samples := b.Samples[:0]
+	*b = Block{}
+	var front *track
+	// a song that ends can start the queued song, which then renders the block instead
+	for tries := 0; tries < 2 && b.Samples == nil && m.front != nil; tries++ {
+		front = m.front
+		b.Song = front.name
+		if err := m.renderFront(front, b, samples); err != nil {
+			return nil, err
+		}
+	}
+	if b.Samples == nil {
+		if len(m.fading) == 0 && (m.front == nil || m.front.ended) {
+			return nil, song.ErrStopSong
+		}
+		front = nil
+		b.Frames = m.sampleRate / silenceFrames
+		b.Samples = samples
+		for i := 0; i < b.Frames*m.channels; i++ {
+			b.Samples = append(b.Samples, 0)
+		}
+	}
+
+	for i := 0; i < len(m.fading); {
+		t := m.fading[i]
+		if t == front {
+			// it rendered this block in front, then started fading out at its end
+			i++
+			continue
+		}
+		samples, err := m.take(t, b.Frames)
+		if err != nil {
+			return nil, err
+		}
+		t.applyFade(samples, m.channels)
+		for j, v := range samples {
+			b.Samples[j] += v
+		}
+		if t.fade <= 0 {
+			t.pending = t.pending[:0]
+			m.fading = append(m.fading[:i], m.fading[i+1:]...)
+			continue
+		}
+		i++
+	}
+
+	return b, nil
+}
+
+// renderFront renders the next tick of the song in front into `b`, with its samples in `samples` and its fade
+// applied, and starts the queued change if the tick ended on its boundary
+func (m *Manager) renderFront(t *track, b *Block, samples []float32) error {
+	if len(t.pending) > 0 {
+		// the song was fading out when it came back to the front, so play out what it has already rendered
+		b.Frames = len(t.pending) / m.channels
+		b.Samples = append(samples, t.pending...)
+		t.pending = t.pending[:0]
+		t.applyFade(b.Samples, m.channels)
+		return nil
+	}
+
+	if t.ended {
+		m.startQueued(AtSongEnd)
+		return nil
+	}
+
+	premix, err := m.generate(t)
+	if err != nil {
+		return err
+	}
+	if premix == nil {
+		if t.ended {
+			m.startQueued(AtSongEnd)
+		}
+		return nil
+	}
+
+	b.Frames = premix.SamplesLen
+	b.Samples = m.flat.Append(samples, premix.SamplesLen, premix.Data, premix.MixerVolume*volume.Volume(t.gain))
+	b.Premix = premix
+	// the fade goes on before the queued change starts, as cutting to the next song silences this one
+	t.applyFade(b.Samples, m.channels)
+
+	if rr, ok := premix.Userdata.(*render.RowRender); ok {
+		order := int(t.player.GetCurrentOrder())
+		row := int(t.player.GetCurrentRow())
+		switch {
+		case order != rr.Order:
+			m.startQueued(AtPatternEnd)
+		case row != rr.Row:
+			m.startQueued(AtRowEnd)
+		}
+	}
+	return nil
+}
+
+// startQueued starts the queued change if it waits for `reached` or anything that comes before it
+// Reaching the end of a pattern also reaches the end of a row, for example.
+func (m *Manager) startQueued(reached When) {
+	if m.queued == nil || m.queued.t.When > reached {
+		return
+	}
+	q := m.queued
+	m.queued = nil
+	m.start(q.to, q.t)
+}
+
+// take returns the next `frames` frames of a song that is not in front, rendering more of it as needed
+// and padding it with silence once it has ended
+// The samples are only valid until take is called again.
+func (m *Manager) take(t *track, frames int) ([]float32, error) {
+	n := frames * m.channels
+	for len(t.pending) < n && !t.ended {
+		premix, err := m.generate(t)
+		if err != nil {
+			return nil, err
+		}
+		if premix != nil {
+			t.pending = m.flat.Append(t.pending, premix.SamplesLen, premix.Data, premix.MixerVolume*volume.Volume(t.gain))
+		}
+	}
+	for len(t.pending) < n {
+		t.pending = append(t.pending, 0)
+	}
+
+	m.taken = append(m.taken[:0], t.pending[:n]...)
+	t.pending = append(t.pending[:0], t.pending[n:]...)
+	return m.taken, nil
+}
+
+// generate renders the next tick of `t`, marking it as ended when the song stops
+func (m *Manager) generate(t *track) (*output.PremixData, error) {
+	premix, err := t.player.Generate(0)
+	if err != nil {
+		if errors.Is(err, song.ErrStopSong) {
+			t.ended = true
+			return nil, nil
+		}
+		return nil, err
+	}
+	if premix != nil && m.onPremix != nil {
+		m.onPremix(t.name, premix)
+	}
+	return premix, nil
+}
+
+// applyFade applies the fade of `t` to `samples` and moves it on, using an equal-power curve so that
+// a crossfade keeps the same loudness all the way through
+func (t *track) applyFade(samples []float32, channels int) {
+	if t.fade >= 1 && t.fadeStep >= 0 {
+		return
+	}
+	for i := 0; i < len(samples); i += channels {
+		gain := float32(math.Sin(float64(t.fade) * math.Pi / 2))
+		for c := 0; c < channels && i+c < len(samples); c++ {
+			samples[i+c] *= gain
+		}
+		t.fade += t.fadeStep
+		// rounding leaves the fade a little short of its target after the last step, so it stops within half
+		// a step of it
+		half := float32(math.Abs(float64(t.fadeStep))) / 2
+		if t.fade >= 1-half {
+			t.fade = 1
+			t.fadeStep = 0
+		} else if t.fade <= half {
+			t.fade = 0
+			t.fadeStep = 0
+		}
+	}
+}
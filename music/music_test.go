@@ -0,0 +1,385 @@
+package music
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/gotracker/playback"
+	playerrender "github.com/gotracker/playback/player/render"
+	"github.com/gotracker/playback/song"
+
+	"github.com/eliasdaler/ebiten-tracker-demo/dsp"
+	"github.com/eliasdaler/ebiten-tracker-demo/internal/fixture"
+	"github.com/eliasdaler/ebiten-tracker-demo/render"
+)
+
+// load loads a fixture song the way the game does
+func load(t *testing.T, format string, data []byte) playback.Playback {
+	t.Helper()
+	p, err := render.Load(format, data, render.DefaultOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func newManager() *Manager {
+	return New(render.DefaultOptions.SampleRate, render.DefaultOptions.Channels)
+}
+
+func peak(samples []float32) float64 {
+	var p float64
+	for _, v := range samples {
+		p = math.Max(p, math.Abs(float64(v)))
+	}
+	return p
+}
+
+// TestLimiterCatchesOverload checks that a song turned up past full scale reaches the limiter as it is, rather
+// than clipped, and that the limiter brings it under its ceiling
+func TestLimiterCatchesOverload(t *testing.T) {
+	m := newManager()
+	m.Add("mod", load(t, "mod", fixture.MOD()))
+	if err := m.SetGain("mod", 8); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Play("mod", Transition{}); err != nil {
+		t.Fatal(err)
+	}
+
+	master := dsp.NewChain()
+	master.Add("limiter", dsp.NewLimiter(render.DefaultOptions.SampleRate, render.DefaultOptions.Channels))
+	var loudest, limited float64
+	for {
+		b, err := m.Next()
+		if errors.Is(err, song.ErrStopSong) {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		loudest = math.Max(loudest, peak(b.Samples))
+		master.Process(b.Samples)
+		limited = math.Max(limited, peak(b.Samples))
+	}
+
+	if loudest <= 1 {
+		t.Fatalf("the mix peaks at %.2f with a gain of 8, want it over full scale", loudest)
+	}
+	if limited > dsp.DefaultCeiling+1e-6 {
+		t.Errorf("the limiter lets through a peak of %.3f, want at most %v", limited, dsp.DefaultCeiling)
+	}
+}
+
+// TestFadeCurves checks that a song fading in and one fading out over the same frames keep the same power all
+// the way through, and that both stop right on their targets
+func TestFadeCurves(t *testing.T) {
+	const frames = 100
+	in := &track{fadeStep: 1.0 / frames}
+	out := &track{fade: 1, fadeStep: -1.0 / frames}
+
+	gainsIn := make([]float32, frames+10)
+	gainsOut := make([]float32, frames+10)
+	for i := range gainsIn {
+		gainsIn[i] = 1
+		gainsOut[i] = 1
+	}
+	in.applyFade(gainsIn, 1)
+	out.applyFade(gainsOut, 1)
+
+	for i := range gainsIn {
+		power := float64(gainsIn[i]*gainsIn[i] + gainsOut[i]*gainsOut[i])
+		if math.Abs(power-1) > 1e-4 {
+			t.Fatalf("frame %d: the gains %v and %v add up to a power of %v, want 1", i, gainsIn[i], gainsOut[i], power)
+		}
+		if i > 0 && (gainsIn[i] < gainsIn[i-1] || gainsOut[i] > gainsOut[i-1]) {
+			t.Fatalf("frame %d: the gains %v and %v go back on the fades", i, gainsIn[i], gainsOut[i])
+		}
+	}
+	if gainsIn[0] != 0 || gainsOut[0] != 1 {
+		t.Errorf("the fades start at %v and %v, want 0 and 1", gainsIn[0], gainsOut[0])
+	}
+	for i := frames; i < len(gainsIn); i++ {
+		if gainsIn[i] != 1 || gainsOut[i] != 0 {
+			t.Fatalf("frame %d: the fades are at %v and %v after they ended, want 1 and 0", i, gainsIn[i], gainsOut[i])
+		}
+	}
+	if in.fade != 1 || in.fadeStep != 0 || out.fade != 0 || out.fadeStep != 0 {
+		t.Errorf("the fades end at %v (step %v) and %v (step %v), want 1 and 0 with no step",
+			in.fade, in.fadeStep, out.fade, out.fadeStep)
+	}
+}
+
+// TestCrossfade checks that a crossfade puts the new song in front straight away, and drops the old one once
+// it has faded out for as long as the transition asked
+func TestCrossfade(t *testing.T) {
+	m := newManager()
+	m.Add("mod", load(t, "mod", fixture.MOD()))
+	m.Add("it", load(t, "it", fixture.IT()))
+	if err := m.Play("mod", Transition{}); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := m.Next(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	const fade = 50 * time.Millisecond
+	if err := m.Play("it", Transition{Fade: fade}); err != nil {
+		t.Fatal(err)
+	}
+	if name, _, _ := m.Current(); name != "it" {
+		t.Fatalf("the song in front is %q while crossfading, want %q", name, "it")
+	}
+
+	want := int(fade) * render.DefaultOptions.SampleRate / int(time.Second)
+	var frames, lastFrames int
+	for len(m.fading) > 0 {
+		b, err := m.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b.Song != "it" {
+			t.Fatalf("a block during the crossfade comes from %q, want %q", b.Song, "it")
+		}
+		frames += b.Frames
+		lastFrames = b.Frames
+	}
+	if frames < want || frames-lastFrames >= want {
+		t.Errorf("the old song faded out over %d frames, want it gone in the block that reaches %d", frames, want)
+	}
+
+	front := m.songs["it"]
+	old := m.songs["mod"]
+	if front.fade != 1 || front.fadeStep != 0 {
+		t.Errorf("the new song ends its fade at %v (step %v), want 1", front.fade, front.fadeStep)
+	}
+	if old.fade != 0 || len(old.pending) != 0 {
+		t.Errorf("the old song ends its fade at %v with %d samples pending, want 0 and none", old.fade, len(old.pending))
+	}
+}
+
+// position is where a song was when it rendered a block
+type position struct {
+	song       string
+	order, row int
+}
+
+// play plays the manager out and returns where the song in front was for every block it rendered, and the
+// peak level of each of those blocks
+func play(t *testing.T, m *Manager) ([]position, []float64) {
+	t.Helper()
+	var positions []position
+	var peaks []float64
+	for {
+		b, err := m.Next()
+		if errors.Is(err, song.ErrStopSong) {
+			return positions, peaks
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b.Premix == nil {
+			continue
+		}
+		rr, ok := b.Premix.Userdata.(*playerrender.RowRender)
+		if !ok {
+			t.Fatalf("the premix of %q has no row render", b.Song)
+		}
+		positions = append(positions, position{song: b.Song, order: rr.Order, row: rr.Row})
+		peaks = append(peaks, peak(b.Samples))
+	}
+}
+
+// TestQueuedTransitions checks that a queued change waits for its boundary, and that the new song starts
+// from the top on the very next block
+func TestQueuedTransitions(t *testing.T) {
+	ref := newManager()
+	ref.Add("mod", load(t, "mod", fixture.MOD()))
+	if err := ref.Play("mod", Transition{}); err != nil {
+		t.Fatal(err)
+	}
+	alone, alonePeaks := play(t, ref)
+
+	ref = newManager()
+	ref.Add("it", load(t, "it", fixture.IT()))
+	if err := ref.Play("it", Transition{}); err != nil {
+		t.Fatal(err)
+	}
+	_, itPeaks := play(t, ref)
+
+	// the blocks the song plays before each boundary, counting from the first row of the second pattern,
+	// so that the change is queued partway through a row
+	const start = 2
+	first := start
+	for alone[first].order == alone[0].order {
+		first++
+	}
+	first++
+	boundary := func(done func(p position) bool) int {
+		for i := first; i < len(alone); i++ {
+			if done(alone[i]) {
+				return i
+			}
+		}
+		return len(alone)
+	}
+	from := alone[first]
+
+	tests := []struct {
+		name string
+		when When
+		want int
+	}{
+		{"Now", Now, first},
+		{"AtRowEnd", AtRowEnd, boundary(func(p position) bool { return p.row != from.row })},
+		{"AtPatternEnd", AtPatternEnd, boundary(func(p position) bool { return p.order != from.order })},
+		{"AtSongEnd", AtSongEnd, len(alone)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newManager()
+			m.Add("mod", load(t, "mod", fixture.MOD()))
+			m.Add("it", load(t, "it", fixture.IT()))
+			if err := m.Play("mod", Transition{}); err != nil {
+				t.Fatal(err)
+			}
+			for i := 0; i < first; i++ {
+				if _, err := m.Next(); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if err := m.Play("it", Transition{When: tt.when}); err != nil {
+				t.Fatal(err)
+			}
+			if name, when, ok := m.Queued(); tt.when != Now && (!ok || name != "it" || when.When != tt.when) {
+				t.Errorf("Queued() = %q, %v, %v, want %q waiting for %v", name, when.When, ok, "it", tt.when)
+			}
+
+			positions, peaks := play(t, m)
+			played := first
+			for played-first < len(positions) && positions[played-first].song == "mod" {
+				if positions[played-first] != alone[played] {
+					t.Fatalf("block %d of the old song is at %+v, want %+v", played, positions[played-first], alone[played])
+				}
+				// the last block before a cut plays at full level like the rest
+				if got, want := peaks[played-first], alonePeaks[played]; math.Abs(got-want) > 1e-6 {
+					t.Errorf("block %d of the old song peaks at %.4f, want %.4f", played, got, want)
+				}
+				played++
+			}
+			if played != tt.want {
+				t.Errorf("the old song played %d blocks, want %d", played, tt.want)
+			}
+			if played-first == len(positions) {
+				t.Fatal("the new song never started")
+			}
+			if got := positions[played-first]; got != (position{song: "it"}) {
+				t.Errorf("the new song starts at %+v, want the top of it", got)
+			}
+			if got, want := peaks[played-first], itPeaks[0]; math.Abs(got-want) > 1e-6 {
+				t.Errorf("the first block of the new song peaks at %.4f, want %.4f", got, want)
+			}
+			if _, _, ok := m.Queued(); ok {
+				t.Error("the change is still queued after it happened")
+			}
+		})
+	}
+}
+
+// TestSeek checks that Seek moves the song in front straight away, leaves it where it was when the position
+// is out of range, and plays a song that has ended again
+func TestSeek(t *testing.T) {
+	m := newManager()
+	m.Add("mod", load(t, "mod", fixture.MOD()))
+	if err := m.Play("mod", Transition{}); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := m.Next(); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	next := func() position {
+		t.Helper()
+		b, err := m.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		rr := b.Premix.Userdata.(*playerrender.RowRender)
+		return position{song: b.Song, order: rr.Order, row: rr.Row}
+	}
+
+	if err := m.Seek(1, 4); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := next(), (position{song: "mod", order: 1, row: 4}); got != want {
+		t.Errorf("after seeking, the song is at %+v, want %+v", got, want)
+	}
+
+	if err := m.Seek(100, 0); err == nil {
+		t.Error("seeking past the order list works, want an error")
+	}
+	if got := next(); got.order != 1 || got.row != 4 {
+		t.Errorf("after a failed seek, the song is at %+v, want it still on order 1 row 4", got)
+	}
+
+	for {
+		if _, err := m.Next(); errors.Is(err, song.ErrStopSong) {
+			break
+		} else if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := m.Seek(0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := next(), (position{song: "mod"}); got != want {
+		t.Errorf("after seeking a song that ended, it is at %+v, want %+v", got, want)
+	}
+}
+
+// TestPrune checks that Prune keeps the songs in front, fading out or queued, and removes all the others
+func TestPrune(t *testing.T) {
+	m := newManager()
+	for _, name := range []string{"front", "fading", "queued", "idle"} {
+		m.Add(name, load(t, "it", fixture.IT()))
+	}
+	if err := m.Play("fading", Transition{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Next(); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.Play("front", Transition{Fade: 50 * time.Millisecond}); err != nil {
+		t.Fatal(err)
+	}
+	// queueing a change for the end of the song leaves the crossfade going
+	if err := m.Play("queued", Transition{When: AtSongEnd}); err != nil {
+		t.Fatal(err)
+	}
+
+	m.Prune()
+	for name, want := range map[string]bool{"front": true, "fading": true, "queued": true, "idle": false} {
+		if _, ok := m.Song(name); ok != want {
+			t.Errorf("after pruning, Song(%q) is there: %v, want %v", name, ok, want)
+		}
+	}
+
+	for len(m.fading) > 0 {
+		if _, err := m.Next(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	m.Prune()
+	if _, ok := m.Song("fading"); ok {
+		t.Error("a song that has faded out is still there after pruning")
+	}
+	if _, ok := m.Song("front"); !ok {
+		t.Error("the song in front was pruned")
+	}
+}
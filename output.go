@@ -0,0 +1,147 @@
+package main
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/eliasdaler/ebiten-tracker-demo/render"
+)
+
+var (
+	errInvalidSampleRate    = errors.New("sample rate must be 22050, 32000, 44100 or 48000 Hz")
+	errInvalidChannels      = errors.New("channels must be 1 (mono) or 2 (stereo)")
+	errInvalidBitsPerSample = errors.New("bits per sample must be 16 or 32 (float)")
+)
+
+// deviceBytesPerFrame is the size of a frame of the audio that goes to the audio device, which always takes
+// 16-bit stereo PCM
+const deviceBytesPerFrame = 4
+
+// checkOutput returns an error if the demo cannot play its audio in the format of `o`
+func checkOutput(o render.Options) error {
+	switch o.SampleRate {
+	case 22050, 32000, 44100, 48000:
+	default:
+		return errInvalidSampleRate
+	}
+	switch o.Channels {
+	case 1, 2:
+	default:
+		return errInvalidChannels
+	}
+	switch o.BitsPerSample {
+	case 16, 32:
+	default:
+		return errInvalidBitsPerSample
+	}
+	return nil
+}
+
+// ringBytesPerFrame returns the size of a frame of the mix in the ring buffer, which holds it as 32-bit float
+// samples in the channels of `o`, whatever its bits per sample
+func ringBytesPerFrame(o render.Options) int {
+	return o.Channels * 4
+}
+
+// describeOutput returns the format of `o` the way the status line shows it, such as "44100 Hz stereo 16-bit"
+func describeOutput(o render.Options) string {
+	layout := "stereo"
+	if o.Channels == 1 {
+		layout = "mono"
+	}
+	depth := "16-bit"
+	if o.BitsPerSample == 32 {
+		depth = "float"
+	}
+	return fmt.Sprintf("%d Hz %s %s", o.SampleRate, layout, depth)
+}
+
+// bytesPerFrame returns the size of a frame of PCM data in the format of `o`
+func bytesPerFrame(o render.Options) int {
+	return o.Channels * o.BitsPerSample / 8
+}
+
+// appendDevicePCM encodes `samples`, interleaved in `channels` channels, as 16-bit stereo PCM for the audio
+// device and appends it to `dst`
+// Mono samples go to both sides.
+func appendDevicePCM(dst []byte, samples []float32, channels int) []byte {
+	if channels == 2 {
+		return render.AppendPCM(dst, samples, 16)
+	}
+	for i := 0; i < len(samples); i += channels {
+		var frame [2]float32
+		frame[0], frame[1] = samples[i], samples[i]
+		dst = render.AppendPCM(dst, frame[:], 16)
+	}
+	return dst
+}
+
+// convertDevicePCM converts `pcm`, which is PCM data in the format of `out`, into the 16-bit stereo PCM the
+// audio device takes and appends it to `dst`
+// Float samples are rounded to 16 bits, and mono samples go to both sides.
+func convertDevicePCM(dst []byte, pcm []byte, out render.Options) []byte {
+	bytesPerSample := out.BitsPerSample / 8
+	for i := 0; i+bytesPerFrame(out) <= len(pcm); i += bytesPerFrame(out) {
+		for side := 0; side < 2; side++ {
+			at := i
+			if out.Channels == 2 {
+				at += side * bytesPerSample
+			}
+			if out.BitsPerSample == 16 {
+				dst = append(dst, pcm[at], pcm[at+1])
+				continue
+			}
+			v := [1]float32{math.Float32frombits(binary.LittleEndian.Uint32(pcm[at:]))}
+			dst = render.AppendPCM(dst, v[:], 16)
+		}
+	}
+	return dst
+}
+
+// deviceReader reads the mix out of the ring buffer `src`, as 32-bit float samples in the channels of the
+// output, converts it into the format of the output and then into the 16-bit stereo PCM the audio device takes
+type deviceReader struct {
+	src io.Reader
+	out render.Options
+
+	raw     []byte
+	samples []float32
+	// encoded holds the audio in the format of the output, and pcm in the format of the device
+	encoded []byte
+	pcm     []byte
+}
+
+// newDeviceReader returns a reader of the audio in `src`, which is the float mix in the channels of `out`, as
+// 16-bit stereo PCM
+func newDeviceReader(src io.Reader, out render.Options) io.Reader {
+	return &deviceReader{src: src, out: out}
+}
+
+func (r *deviceReader) Read(p []byte) (int, error) {
+	frames := len(p) / deviceBytesPerFrame
+	size := frames * ringBytesPerFrame(r.out)
+	if cap(r.raw) < size {
+		r.raw = make([]byte, size)
+	}
+	r.raw = r.raw[:size]
+	n, err := io.ReadFull(r.src, r.raw)
+	if n == 0 {
+		return 0, err
+	}
+	r.raw = r.raw[:n-n%ringBytesPerFrame(r.out)]
+
+	r.samples = r.samples[:0]
+	for i := 0; i+4 <= len(r.raw); i += 4 {
+		r.samples = append(r.samples, math.Float32frombits(binary.LittleEndian.Uint32(r.raw[i:])))
+	}
+	r.encoded = render.AppendPCM(r.encoded[:0], r.samples, r.out.BitsPerSample)
+	if r.out.Channels == 2 && r.out.BitsPerSample == 16 {
+		// the output is in the format of the device already
+		return copy(p, r.encoded), nil
+	}
+	r.pcm = convertDevicePCM(r.pcm[:0], r.encoded, r.out)
+	return copy(p, r.pcm), nil
+}
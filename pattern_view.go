@@ -0,0 +1,201 @@
+package main
+
+import (
+	"fmt"
+	"image/color"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+
+	"github.com/gotracker/playback"
+	"github.com/gotracker/playback/index"
+	"github.com/gotracker/playback/song"
+
+	"github.com/eliasdaler/ebiten-tracker-demo/channelmix"
+	"github.com/eliasdaler/ebiten-tracker-demo/timeline"
+)
+
+const (
+	// size of a character of the ebitenutil debug font
+	glyphWidth  = 6
+	glyphHeight = 16
+)
+
+var (
+	currentRowColor = color.RGBA{0x30, 0x50, 0x90, 0xff}
+	noteOnColor     = color.RGBA{0x20, 0x90, 0x40, 0xff}
+	selectedColor   = color.RGBA{0x80, 0x60, 0x20, 0xff}
+)
+
+// noteOnFrames is how many frames a channel header lights up for when a note starts on the channel
+const noteOnFrames = 8
+
+// patternView draws the pattern data around the row that is being heard, tracker style
+type patternView struct {
+	firstChannel int
+	// selected is the position in channels of the selected channel
+	selected int
+	// visible is the number of channels that fitted across the screen last time
+	visible int
+
+	// activity counts down the frames each channel header stays lit for
+	activity map[int]int
+
+	// the pattern data of the order that was drawn last
+	player   playback.Playback
+	order    int
+	patIdx   index.Pattern
+	rows     [][]song.ChannelData
+	channels []int
+}
+
+// ScrollChannels moves the visible channels left (negative `n`) or right (positive `n`)
+func (v *patternView) ScrollChannels(n int) {
+	v.firstChannel += n
+	if v.firstChannel > len(v.channels)-1 {
+		v.firstChannel = len(v.channels) - 1
+	}
+	if v.firstChannel < 0 {
+		v.firstChannel = 0
+	}
+}
+
+// Select moves the channel selection by `n` channels, wrapping around, and scrolls it into view
+func (v *patternView) Select(n int) {
+	if len(v.channels) == 0 {
+		return
+	}
+	v.selected = ((v.selected+n)%len(v.channels) + len(v.channels)) % len(v.channels)
+	if v.selected < v.firstChannel {
+		v.firstChannel = v.selected
+	} else if v.visible > 0 && v.selected >= v.firstChannel+v.visible {
+		v.firstChannel = v.selected - v.visible + 1
+	}
+}
+
+// SelectedChannel returns the selected song channel (0-based), if there are any channels
+func (v *patternView) SelectedChannel() (int, bool) {
+	if v.selected < 0 || v.selected >= len(v.channels) {
+		return 0, false
+	}
+	return v.channels[v.selected], true
+}
+
+// NoteOn lights up the header of `channel` (0-based)
+func (v *patternView) NoteOn(channel int) {
+	if v.activity == nil {
+		v.activity = make(map[int]int)
+	}
+	v.activity[channel] = noteOnFrames
+}
+
+func (v *patternView) load(player playback.Playback, order int) {
+	orders := player.GetSongData().GetOrderList()
+	patIdx := index.InvalidPattern
+	if order >= 0 && order < len(orders) {
+		patIdx = orders[order]
+	}
+	if v.rows != nil && v.player == player && v.order == order && v.patIdx == patIdx {
+		return
+	}
+
+	v.player = player
+	v.order = order
+	v.patIdx = patIdx
+	v.rows = player.GetPatternData(patIdx)
+
+	v.channels = v.channels[:0]
+	songData := player.GetSongData()
+	for ch := 0; ch < player.GetNumChannels(); ch++ {
+		if songData.IsChannelEnabled(ch) {
+			v.channels = append(v.channels, ch)
+		}
+	}
+	v.ScrollChannels(0)
+	if v.selected >= len(v.channels) {
+		v.selected = 0
+	}
+}
+
+// Draw draws the pattern at `pos` into the `height` pixels tall area at `y`, with the row of `pos` in the middle
+// The channel headers show which channels `mixer` mutes or solos.
+func (v *patternView) Draw(screen *ebiten.Image, player playback.Playback, mixer *channelmix.Mixer, pos timeline.Position, y, height int) {
+	v.load(player, pos.Order)
+
+	if v.rows == nil {
+		ebitenutil.DebugPrintAt(screen, "(no pattern data)", 0, y)
+		return
+	}
+
+	// work out how many channels fit across the screen, from the width of the data in the first one
+	cellWidth := 3
+	if len(v.rows) > 0 && len(v.channels) > 0 {
+		if row := v.rows[0]; v.channels[0] < len(row) {
+			cellWidth = len(row[v.channels[0]].String())
+		}
+	}
+	v.visible = (screenWidth/glyphWidth - 4) / (cellWidth + 1)
+	channels := v.channels[v.firstChannel:]
+	if len(channels) > v.visible {
+		channels = channels[:v.visible]
+	}
+
+	var header strings.Builder
+	header.WriteString("   ")
+	for i, ch := range channels {
+		x := float64((4 + i*(cellWidth+1)) * glyphWidth)
+		if v.firstChannel+i == v.selected {
+			ebitenutil.DrawRect(screen, x, float64(y), float64(cellWidth*glyphWidth), glyphHeight, selectedColor)
+		}
+		if frames := v.activity[ch]; frames > 0 {
+			a := float64(frames) / noteOnFrames
+			c := color.RGBA{uint8(float64(noteOnColor.R) * a), uint8(float64(noteOnColor.G) * a), uint8(float64(noteOnColor.B) * a), 0xff}
+			ebitenutil.DrawRect(screen, x, float64(y)+glyphHeight-3, float64(cellWidth*glyphWidth), 3, c)
+		}
+
+		label := fmt.Sprintf("Ch %02d", ch+1)
+		if mixer.Mute(ch) {
+			label += " M"
+		}
+		if mixer.Solo(ch) {
+			label += " S"
+		}
+		fmt.Fprintf(&header, "|%-*s", cellWidth, label)
+	}
+	for ch, frames := range v.activity {
+		if frames > 0 {
+			v.activity[ch] = frames - 1
+		}
+	}
+	header.WriteString("|")
+	ebitenutil.DebugPrintAt(screen, header.String(), 0, y)
+	y += glyphHeight
+	height -= glyphHeight
+
+	lines := height / glyphHeight
+	middle := lines / 2
+	ebitenutil.DrawRect(screen, 0, float64(y+middle*glyphHeight), screenWidth, glyphHeight, currentRowColor)
+
+	var line strings.Builder
+	for i := 0; i < lines; i++ {
+		r := pos.Row - middle + i
+		if r < 0 || r >= len(v.rows) {
+			continue
+		}
+
+		line.Reset()
+		fmt.Fprintf(&line, "%03d", r)
+		row := v.rows[r]
+		for _, ch := range channels {
+			line.WriteString("|")
+			if ch < len(row) && row[ch] != nil {
+				line.WriteString(row[ch].String())
+			} else {
+				line.WriteString(strings.Repeat(" ", cellWidth))
+			}
+		}
+		line.WriteString("|")
+		ebitenutil.DebugPrintAt(screen, line.String(), 0, y+i*glyphHeight)
+	}
+}
@@ -0,0 +1,46 @@
+package channel
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/gotracker/playback/instrument"
+	"github.com/gotracker/playback/note"
+)
+
+// SampleID is an InstrumentID that is a combination of InstID and SampID
+type SampleID struct {
+	InstID   uint8
+	Semitone note.Semitone
+}
+
+// IsEmpty returns true if the sample ID is empty
+func (s SampleID) IsEmpty() bool {
+	return s.InstID == 0
+}
+
+func (s SampleID) String() string {
+	return fmt.Sprint(s.InstID)
+}
+
+// sampleIDs holds every SampleID of an instrument as an instrument.ID, made the first time the instrument plays,
+// so that the channel data of a row does not allocate a new one every time it is read
+var sampleIDs [256]struct {
+	once sync.Once
+	ids  *[256]instrument.ID
+}
+
+// ID returns the sample ID as an instrument.ID without allocating
+func (s SampleID) ID() instrument.ID {
+	inst := &sampleIDs[s.InstID]
+	inst.once.Do(func() {
+		inst.ids = new([256]instrument.ID)
+		for st := range inst.ids {
+			inst.ids[st] = SampleID{
+				InstID:   s.InstID,
+				Semitone: note.Semitone(st),
+			}
+		}
+	})
+	return inst.ids[s.Semitone]
+}
@@ -0,0 +1,26 @@
+package effect
+
+import (
+	"fmt"
+
+	"github.com/gotracker/playback"
+	"github.com/gotracker/playback/format/it/channel"
+)
+
+// ExtraFinePortaUp defines an extra-fine portamento up effect
+type ExtraFinePortaUp channel.DataEffect // 'FEx'
+
+// Start triggers on the first tick, but before the Tick() function is called
+func (e ExtraFinePortaUp) Start(cs playback.Channel[channel.Memory, channel.Data], p playback.Playback) error {
+	cs.ResetRetriggerCount()
+	cs.UnfreezePlayback()
+
+	mem := cs.GetMemory()
+	y := mem.PortaUp(channel.DataEffect(e)) & 0x0F
+
+	return doPortaUp(cs, float32(y), 1, mem.Shared.LinearFreqSlides)
+}
+
+func (e ExtraFinePortaUp) String() string {
+	return fmt.Sprintf("F%0.2x", channel.DataEffect(e))
+}
@@ -0,0 +1,22 @@
+package effect
+
+import (
+	"fmt"
+
+	"github.com/gotracker/playback"
+	"github.com/gotracker/playback/format/it/channel"
+	"github.com/gotracker/playback/note"
+)
+
+// NewNoteActionNoteContinue defines a NewNoteAction: Note Continue effect
+type NewNoteActionNoteContinue channel.DataEffect // 'S74'
+
+// Start triggers on the first tick, but before the Tick() function is called
+func (e NewNoteActionNoteContinue) Start(cs playback.Channel[channel.Memory, channel.Data], p playback.Playback) error {
+	cs.SetNewNoteAction(note.ActionContinue)
+	return nil
+}
+
+func (e NewNoteActionNoteContinue) String() string {
+	return fmt.Sprintf("S%0.2x", channel.DataEffect(e))
+}
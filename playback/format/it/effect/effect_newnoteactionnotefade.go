@@ -0,0 +1,22 @@
+package effect
+
+import (
+	"fmt"
+
+	"github.com/gotracker/playback"
+	"github.com/gotracker/playback/format/it/channel"
+	"github.com/gotracker/playback/note"
+)
+
+// NewNoteActionNoteFade defines a NewNoteAction: Note Fade effect
+type NewNoteActionNoteFade channel.DataEffect // 'S76'
+
+// Start triggers on the first tick, but before the Tick() function is called
+func (e NewNoteActionNoteFade) Start(cs playback.Channel[channel.Memory, channel.Data], p playback.Playback) error {
+	cs.SetNewNoteAction(note.ActionFadeout)
+	return nil
+}
+
+func (e NewNoteActionNoteFade) String() string {
+	return fmt.Sprintf("S%0.2x", channel.DataEffect(e))
+}
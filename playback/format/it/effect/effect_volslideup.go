@@ -0,0 +1,46 @@
+package effect
+
+import (
+	"fmt"
+
+	"github.com/gotracker/playback"
+	"github.com/gotracker/playback/format/it/channel"
+)
+
+// VolumeSlideUp defines a volume slide up effect
+type VolumeSlideUp channel.DataEffect // 'D'
+
+// Start triggers on the first tick, but before the Tick() function is called
+func (e VolumeSlideUp) Start(cs playback.Channel[channel.Memory, channel.Data], p playback.Playback) error {
+	cs.ResetRetriggerCount()
+	return nil
+}
+
+// Tick is called on every tick
+func (e VolumeSlideUp) Tick(cs playback.Channel[channel.Memory, channel.Data], p playback.Playback, currentTick int) error {
+	mem := cs.GetMemory()
+	x, _ := mem.VolumeSlide(channel.DataEffect(e))
+
+	return doVolSlide(cs, float32(x), 1.0)
+}
+
+func (e VolumeSlideUp) String() string {
+	return fmt.Sprintf("D%0.2x", channel.DataEffect(e))
+}
+
+//====================================================
+
+// VolChanVolumeSlideUp defines a volume slide up effect (from the volume channel)
+type VolChanVolumeSlideUp channel.DataEffect // 'd'
+
+// Tick is called on every tick
+func (e VolChanVolumeSlideUp) Tick(cs playback.Channel[channel.Memory, channel.Data], p playback.Playback, currentTick int) error {
+	mem := cs.GetMemory()
+	x := mem.VolChanVolumeSlide(channel.DataEffect(e))
+
+	return doVolSlide(cs, float32(x), 1.0)
+}
+
+func (e VolChanVolumeSlideUp) String() string {
+	return fmt.Sprintf("d%x0", channel.DataEffect(e))
+}
@@ -0,0 +1,5 @@
+package feature
+
+type LongChannelOutput struct {
+	Enabled bool
+}
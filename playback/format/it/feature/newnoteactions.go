@@ -0,0 +1,5 @@
+package feature
+
+type NewNoteActions struct {
+	Enabled bool
+}
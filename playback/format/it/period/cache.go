@@ -0,0 +1,9 @@
+package period
+
+import "github.com/gotracker/playback/period"
+
+var (
+	// amigaPeriods and linearPeriods are the periods of each type that have been handed out as a period.Period
+	amigaPeriods  period.Cache[Amiga]
+	linearPeriods period.Cache[Linear]
+)
@@ -0,0 +1,161 @@
+package playback
+
+import (
+	"github.com/gotracker/gomixing/sampling"
+	"github.com/gotracker/gomixing/volume"
+	"github.com/gotracker/playback"
+	"github.com/gotracker/playback/format/it/channel"
+	"github.com/gotracker/playback/format/it/effect"
+	"github.com/gotracker/playback/note"
+	"github.com/gotracker/playback/player/state"
+	"github.com/gotracker/playback/song"
+)
+
+type channelDataConverter struct{}
+
+func (c channelDataConverter) Process(out *state.ChannelDataActions, data *channel.Data, s song.Data, cs *state.ChannelState[channel.Memory, channel.Data]) error {
+	if data == nil {
+		return nil
+	}
+
+	var n note.Note = note.EmptyNote{}
+	inst := cs.GetInstrument()
+	prevInst := inst
+
+	if data.HasNote() || data.HasInstrument() {
+		instID := data.GetInstrument(cs.StoredSemitone)
+		n = data.GetNote()
+		var (
+			wantRetrigger    bool
+			wantRetriggerVol bool
+		)
+		if instID.IsEmpty() {
+			// use current
+			inst = prevInst
+			wantRetrigger = true
+		} else if !s.IsValidInstrumentID(instID) {
+			out.TargetInst.Set(nil)
+			n = note.InvalidNote{}
+		} else {
+			var str note.Semitone
+			inst, str = s.GetInstrument(instID)
+			n = note.CoalesceNoteSemitone(n, str)
+			if !note.IsEmpty(n) && inst == nil {
+				inst = prevInst
+			}
+			wantRetrigger = true
+			wantRetriggerVol = true
+		}
+
+		if wantRetrigger {
+			out.TargetInst.Set(inst)
+			out.TargetPos.Set(sampling.Pos{})
+			if inst != nil {
+				if wantRetriggerVol {
+					out.TargetVolume.Set(inst.GetDefaultVolume())
+				}
+				out.NoteAction.Set(note.ActionRetrigger)
+				out.TargetNewNoteAction.Set(inst.GetNewNoteAction())
+			}
+		}
+	}
+
+	if note.IsInvalid(n) {
+		out.TargetPeriod.Set(nil)
+		out.NoteAction.Set(note.ActionCut)
+	} else if note.IsRelease(n) {
+		out.NoteAction.Set(note.ActionRelease)
+	} else if !note.IsEmpty(n) {
+		if nn, ok := n.(note.Normal); ok {
+			st := note.Semitone(nn)
+			out.TargetStoredSemitone.Set(st)
+			out.NoteCalcST.Set(st)
+		} else {
+			out.NoteAction.Set(note.ActionCut)
+		}
+	}
+
+	if data.HasVolume() {
+		v := data.GetVolume()
+		if v == volume.VolumeUseInstVol {
+			if inst != nil {
+				v = inst.GetDefaultVolume()
+			}
+		}
+		out.TargetVolume.Set(v)
+	}
+
+	return nil
+}
+
+type channelDataTransaction struct {
+	state.ChannelDataTxnHelper[channel.Memory, channel.Data, channelDataConverter]
+	// combined holds the effect of the row when it has both a volume and a standard effect
+	combined effect.VolEff
+}
+
+// nextChannelTxn returns an empty transaction for the next row of channel `ch`
+// Each channel takes turns between two transactions, so that the one of the row before is left as it was.
+func (m *Manager) nextChannelTxn(ch int) *channelDataTransaction {
+	txn := &m.channelTxns[ch][m.channelTxnRow]
+	volOps, noteOps, combined := txn.VolOps[:0], txn.NoteOps[:0], txn.combined.Effects[:0]
+	*txn = channelDataTransaction{}
+	txn.VolOps, txn.NoteOps, txn.combined.Effects = volOps, noteOps, combined
+	return txn
+}
+
+func (d *channelDataTransaction) CommitPreRow(p playback.Playback, cs *state.ChannelState[channel.Memory, channel.Data], semitoneSetterFactory state.SemitoneSetterFactory[channel.Memory, channel.Data]) error {
+	e := effect.FactoryInto(&d.combined, cs.GetMemory(), d.Data)
+	cs.SetActiveEffect(e)
+	if e != nil {
+		if onEff := p.GetOnEffect(); onEff != nil {
+			onEff(e)
+		}
+		if err := playback.EffectPreStart[channel.Memory, channel.Data](e, cs, p); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *channelDataTransaction) CommitRow(p playback.Playback, cs *state.ChannelState[channel.Memory, channel.Data], semitoneSetterFactory state.SemitoneSetterFactory[channel.Memory, channel.Data]) error {
+	if pos, ok := d.TargetPos.Get(); ok {
+		cs.SetTargetPos(pos)
+	}
+
+	if inst, ok := d.TargetInst.Get(); ok {
+		cs.SetTargetInst(inst)
+	}
+
+	if period, ok := d.TargetPeriod.Get(); ok {
+		cs.SetTargetPeriod(period)
+		cs.SetPortaTargetPeriod(period)
+	}
+
+	if st, ok := d.TargetStoredSemitone.Get(); ok {
+		cs.SetStoredSemitone(st)
+	}
+
+	if nna, ok := d.TargetNewNoteAction.Get(); ok {
+		cs.SetNewNoteAction(nna)
+	}
+
+	if v, ok := d.TargetVolume.Get(); ok {
+		cs.SetActiveVolume(v)
+	}
+
+	na, targetTick := d.NoteAction.Get()
+	cs.UseTargetPeriod = targetTick
+	cs.SetNotePlayTick(targetTick, na, 0)
+
+	if st, ok := d.NoteCalcST.Get(); ok {
+		d.AddNoteOp(semitoneSetterFactory(st, cs.TargetPeriodSetter()))
+	}
+
+	return nil
+}
+
+func init() {
+	var _ channelDataConverter
+}
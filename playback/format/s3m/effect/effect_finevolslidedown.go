@@ -0,0 +1,31 @@
+package effect
+
+import (
+	"fmt"
+
+	"github.com/gotracker/playback"
+	"github.com/gotracker/playback/format/s3m/channel"
+)
+
+// FineVolumeSlideDown defines a fine volume slide down effect
+type FineVolumeSlideDown ChannelCommand // 'DFy'
+
+// Start triggers on the first tick, but before the Tick() function is called
+func (e FineVolumeSlideDown) Start(cs playback.Channel[channel.Memory, channel.Data], p playback.Playback) error {
+	cs.ResetRetriggerCount()
+	return nil
+}
+
+// Tick is called on every tick
+func (e FineVolumeSlideDown) Tick(cs playback.Channel[channel.Memory, channel.Data], p playback.Playback, currentTick int) error {
+	y := channel.DataEffect(e) & 0x0F
+
+	if y != 0x0F && currentTick == 0 {
+		return doVolSlide(cs, -float32(y), 1.0)
+	}
+	return nil
+}
+
+func (e FineVolumeSlideDown) String() string {
+	return fmt.Sprintf("D%0.2x", channel.DataEffect(e))
+}
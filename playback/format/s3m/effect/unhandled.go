@@ -0,0 +1,27 @@
+package effect
+
+import (
+	"fmt"
+
+	"github.com/gotracker/playback"
+	"github.com/gotracker/playback/format/s3m/channel"
+	effectIntf "github.com/gotracker/playback/format/s3m/effect/intf"
+)
+
+// UnhandledCommand is an unhandled command
+type UnhandledCommand struct {
+	Command uint8
+	Info    channel.DataEffect
+}
+
+// PreStart triggers when the effect enters onto the channel state
+func (e UnhandledCommand) PreStart(cs playback.Channel[channel.Memory, channel.Data], m effectIntf.S3M) error {
+	if !m.IgnoreUnknownEffect() {
+		panic("unhandled command")
+	}
+	return nil
+}
+
+func (e UnhandledCommand) String() string {
+	return fmt.Sprintf("%c%0.2x", e.Command+'@', e.Info)
+}
@@ -0,0 +1,6 @@
+package period
+
+import "github.com/gotracker/playback/period"
+
+// amigaPeriods are the Amiga periods that have been handed out as a period.Period
+var amigaPeriods period.Cache[Amiga]
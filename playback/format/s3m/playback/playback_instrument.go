@@ -0,0 +1,28 @@
+package playback
+
+import (
+	"github.com/gotracker/playback/format/s3m/channel"
+	s3mPeriod "github.com/gotracker/playback/format/s3m/period"
+	"github.com/gotracker/playback/instrument"
+	"github.com/gotracker/playback/note"
+	"github.com/gotracker/playback/period"
+)
+
+// GetInstrumentNote returns instrument `inst` (1-based) and the period it plays note `st` at, for playing it
+// outside of the song, or nil if there is no such instrument or it cannot play that note
+func (m *Manager) GetInstrumentNote(inst int, st note.Semitone) (*instrument.Instrument, period.Period) {
+	id := channel.InstID(uint8(inst))
+	if inst <= 0 || inst > 0xff || !m.song.IsValidInstrumentID(id) {
+		return nil, nil
+	}
+	in, _ := m.song.GetInstrument(id)
+	if in == nil {
+		return nil, nil
+	}
+
+	semi := int(st) + int(in.GetSemitoneShift())
+	if semi < 0 || semi >= note.MaxSemitone {
+		return nil, nil
+	}
+	return in, s3mPeriod.CalcSemitonePeriod(note.Semitone(semi), in.GetFinetune(), in.GetC2Spd())
+}
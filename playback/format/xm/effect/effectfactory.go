@@ -0,0 +1,63 @@
+package effect
+
+import (
+	"fmt"
+
+	"github.com/gotracker/playback"
+	"github.com/gotracker/playback/format/xm/channel"
+)
+
+type EffectXM interface {
+	playback.Effect
+}
+
+// VolEff is a combined effect that includes a volume effect and a standard effect
+type VolEff struct {
+	playback.CombinedEffect[channel.Memory, channel.Data]
+	eff EffectXM
+}
+
+func (e VolEff) String() string {
+	if e.eff == nil {
+		return "..."
+	}
+	return fmt.Sprint(e.eff)
+}
+
+// Factory produces an effect for the provided channel pattern data
+func Factory(mem *channel.Memory, data *channel.Data) EffectXM {
+	return FactoryInto(nil, mem, data)
+}
+
+// FactoryInto produces an effect for the provided channel pattern data like Factory, but combines a volume effect
+// and a standard effect in `combined` when it is not nil, so that the rows that have both do not allocate
+// The effect it returns is only valid until `combined` is used again.
+func FactoryInto(combined *VolEff, mem *channel.Memory, data *channel.Data) EffectXM {
+	if data == nil {
+		return nil
+	}
+
+	if !data.HasCommand() {
+		return nil
+	}
+
+	var ve EffectXM
+	if data.What.HasVolume() {
+		ve = volumeEffectFactory(mem, data.Volume)
+	}
+	e := standardEffectFactory(mem, data)
+
+	// only rows with both a volume and a standard effect need combining them
+	switch {
+	case ve == nil:
+		return e
+	case e == nil:
+		return ve
+	}
+	if combined == nil {
+		combined = &VolEff{}
+	}
+	combined.Effects = append(combined.Effects[:0], ve, e)
+	combined.eff = e
+	return combined
+}
@@ -0,0 +1,16 @@
+package layout
+
+import (
+	"github.com/gotracker/gomixing/panning"
+	"github.com/gotracker/gomixing/volume"
+	"github.com/gotracker/playback/format/xm/channel"
+)
+
+// ChannelSetting is settings specific to a single channel
+type ChannelSetting struct {
+	Enabled          bool
+	OutputChannelNum int
+	InitialVolume    volume.Volume
+	InitialPanning   panning.Position
+	Memory           channel.Memory
+}
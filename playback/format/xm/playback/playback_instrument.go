@@ -0,0 +1,40 @@
+package playback
+
+import (
+	"github.com/gotracker/playback/format/xm/channel"
+	xmPeriod "github.com/gotracker/playback/format/xm/period"
+	"github.com/gotracker/playback/instrument"
+	"github.com/gotracker/playback/note"
+	"github.com/gotracker/playback/period"
+)
+
+// GetInstrumentNote returns the sample that instrument `inst` (1-based) maps note `st` to and the period it
+// plays the note at, for playing it outside of the song, or nil if there is no such instrument or it does not
+// map the note to a sample
+func (m *Manager) GetInstrumentNote(inst int, st note.Semitone) (*instrument.Instrument, period.Period) {
+	id := channel.SampleID{
+		InstID:   uint8(inst),
+		Semitone: st,
+	}
+	if inst <= 0 || inst > 0xff || !m.song.IsValidInstrumentID(id) {
+		return nil, nil
+	}
+	in, _ := m.song.GetInstrument(id)
+	if in == nil {
+		return nil, nil
+	}
+
+	semi := int(st) + int(in.GetSemitoneShift())
+	if semi < 0 || semi >= note.MaxSemitone {
+		return nil, nil
+	}
+	return in, xmPeriod.CalcSemitonePeriod(note.Semitone(semi), in.GetFinetune(), in.GetC2Spd(), m.linearFreqSlides())
+}
+
+// linearFreqSlides returns true if the song uses linear frequency slides
+func (m *Manager) linearFreqSlides() bool {
+	if len(m.song.ChannelSettings) == 0 {
+		return false
+	}
+	return m.song.ChannelSettings[0].Memory.Shared.LinearFreqSlides
+}
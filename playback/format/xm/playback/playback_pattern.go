@@ -0,0 +1,167 @@
+package playback
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gotracker/playback/format/xm/channel"
+	"github.com/gotracker/playback/player/state"
+	"github.com/gotracker/playback/song"
+)
+
+const (
+	tickBaseDuration = time.Duration(2500) * time.Millisecond
+)
+
+func (m *Manager) processPatternRow() error {
+	patIdx, err := m.pattern.GetCurrentPatternIdx()
+	if err != nil {
+		return err
+	}
+
+	if m.pattern.NeedResetPatternLoops() {
+		for _, cs := range m.channels {
+			mem := cs.GetMemory()
+			pl := mem.GetPatternLoop()
+			pl.Count = 0
+			pl.Enabled = false
+		}
+	}
+
+	pat := m.song.GetPattern(patIdx)
+	if pat == nil {
+		return song.ErrStopSong
+	}
+
+	withinPatternLoop := false
+	for _, cs := range m.channels {
+		mem := cs.GetMemory()
+		pl := mem.GetPatternLoop()
+		if pl.Enabled {
+			withinPatternLoop = true
+			break
+		}
+	}
+
+	if !withinPatternLoop {
+		if err := m.pattern.Observe(); err != nil {
+			return err
+		}
+	}
+
+	rows := pat.GetRows()
+
+	myCurrentRow := m.pattern.GetCurrentRow()
+
+	row := rows.GetRow(myCurrentRow)
+
+	preMixRowTxn := &m.preMixRowTxnBuffer
+	m.pattern.RestartTransaction(preMixRowTxn)
+	defer func() {
+		preMixRowTxn.Cancel()
+		m.preMixRowTxn = nil
+	}()
+	m.preMixRowTxn = preMixRowTxn
+
+	s := m.GetSampler()
+	if s == nil {
+		return errors.New("sampler not configured")
+	}
+
+	if m.rowRenderState == nil {
+		panmixer := s.GetPanMixer()
+
+		m.rowRenderState = &rowRenderState{
+			RenderDetails: state.RenderDetails{
+				Mix:          s.Mixer(),
+				SamplerSpeed: s.GetSamplerSpeed(),
+				Panmixer:     panmixer,
+				SampleRate:   m.GetSampleRate(),
+			},
+		}
+	}
+
+	var resetMemory bool
+	if myCurrentRow == 0 {
+		if myCurrentOrder := m.pattern.GetCurrentOrder(); myCurrentOrder == 0 {
+			resetMemory = true
+		}
+	}
+
+	m.channelTxnRow ^= 1
+	m.noteCalcs[m.channelTxnRow] = m.noteCalcs[m.channelTxnRow][:0]
+	for ch := range m.channels {
+		cs := &m.channels[ch]
+		cs.AdvanceRow(m.nextChannelTxn(ch))
+		if resetMemory {
+			mem := cs.GetMemory()
+			mem.StartOrder()
+		}
+	}
+
+	// generate effects and run prestart
+	channels := row.GetChannels()
+	for channelNum := range channels {
+		if channelNum >= m.GetNumChannels() {
+			continue
+		}
+
+		cdata := &channels[channelNum]
+
+		cs := &m.channels[channelNum]
+		if err := cs.SetData(cdata); err != nil {
+			return err
+		}
+	}
+
+	for ch := range m.channels {
+		cs := &m.channels[ch]
+
+		if txn := cs.GetTxn(); txn != nil {
+			if err := txn.CommitPreRow(m, cs, cs.SemitoneSetterFactory); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := preMixRowTxn.Commit(); err != nil {
+		return err
+	}
+
+	tickDuration := tickBaseDuration / time.Duration(m.pattern.GetTempo())
+
+	m.rowRenderState.tickDuration = tickDuration
+	m.rowRenderState.ticksThisRow = m.pattern.GetTicksThisRow()
+	m.rowRenderState.currentTick = 0
+
+	// run row processing, now that prestart has completed
+	for channelNum := range row.GetChannels() {
+		if channelNum >= m.GetNumChannels() {
+			continue
+		}
+
+		cs := &m.channels[channelNum]
+
+		if err := m.processRowForChannel(cs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Manager) processRowForChannel(cs *state.ChannelState[channel.Memory, channel.Data]) error {
+	mem := cs.GetMemory()
+	mem.TremorMem().Reset()
+
+	if txn := cs.GetTxn(); txn != nil {
+		if err := txn.CommitRow(m, cs, cs.SemitoneSetterFactory); err != nil {
+			return err
+		}
+
+		if err := txn.CommitPostRow(m, cs, cs.SemitoneSetterFactory); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package playback
+
+import (
+	"time"
+
+	"github.com/gotracker/playback/output"
+	"github.com/gotracker/playback/player/render"
+	"github.com/gotracker/playback/player/state"
+)
+
+// OnTick runs the XM tick processing
+func (m *Manager) OnTick() error {
+	m.premix = nil
+	premix, err := m.renderTick()
+	if err != nil {
+		return err
+	}
+
+	m.premix = premix
+	return nil
+}
+
+// GetPremixData gets the current premix data from the manager
+func (m *Manager) GetPremixData() (*output.PremixData, error) {
+	return m.premix, nil
+}
+
+// RenderOneRow renders the next single row from the song pattern data into a RowRender object
+func (m *Manager) renderTick() (*output.PremixData, error) {
+	postMixRowTxn := &m.postMixRowTxnBuffer
+	m.pattern.RestartTransaction(postMixRowTxn)
+	defer func() {
+		postMixRowTxn.Cancel()
+		m.postMixRowTxn = nil
+	}()
+	m.postMixRowTxn = postMixRowTxn
+
+	if m.rowRenderState == nil || m.rowRenderState.currentTick >= m.rowRenderState.ticksThisRow {
+		if err := m.processPatternRow(); err != nil {
+			return nil, err
+		}
+	}
+
+	rs := m.rowRenderState
+	rs.Duration, rs.Samples, rs.SamplerSpeed = m.TickTiming(rs.tickDuration)
+	rs.Interpolation = m.GetInterpolation()
+	rs.ReuseBuffers = m.ReuseBuffers()
+
+	premix, finalData := m.newPremix()
+
+	if err := m.soundRenderTick(premix); err != nil {
+		return nil, err
+	}
+
+	finalData.Order = int(m.pattern.GetCurrentOrder())
+	finalData.Row = int(m.pattern.GetCurrentRow())
+	finalData.Tick = m.rowRenderState.currentTick
+	if m.rowRenderState.currentTick == 0 {
+		finalData.RowText = m.getRowText()
+	}
+
+	m.rowRenderState.currentTick++
+	if m.rowRenderState.currentTick >= m.rowRenderState.ticksThisRow {
+		postMixRowTxn.AdvanceRow = true
+	}
+
+	if err := postMixRowTxn.Commit(); err != nil {
+		return nil, err
+	}
+	return premix, nil
+}
+
+// newPremix returns the premix data for the tick to render into, which is the data of the tick before it when
+// the buffers are reused
+func (m *Manager) newPremix() (*output.PremixData, *render.RowRender) {
+	if !m.ReuseBuffers() {
+		finalData := &render.RowRender{}
+		return &output.PremixData{
+			Userdata:   finalData,
+			SamplesLen: m.rowRenderState.Samples,
+		}, finalData
+	}
+
+	m.rowRender = render.RowRender{}
+	m.premixBuffer = output.PremixData{
+		Userdata:   &m.rowRender,
+		SamplesLen: m.rowRenderState.Samples,
+		Data:       m.premixBuffer.Data[:0],
+		Sources:    m.premixBuffer.Sources[:0],
+	}
+	return &m.premixBuffer, &m.rowRender
+}
+
+type rowRenderState struct {
+	state.RenderDetails
+
+	// tickDuration is the length of the ticks of the row as the song data asks for
+	tickDuration time.Duration
+
+	ticksThisRow int
+	currentTick  int
+}
+
+func (m *Manager) soundRenderTick(premix *output.PremixData) error {
+	tick := m.rowRenderState.currentTick
+	var lastTick = (tick+1 == m.rowRenderState.ticksThisRow)
+
+	for ch := range m.channels {
+		cs := &m.channels[ch]
+		if m.song.IsChannelEnabled(ch) {
+
+			if err := m.processEffect(ch, cs, tick, lastTick); err != nil {
+				return err
+			}
+
+			rr, instIDs, err := cs.RenderRowTick(m.rowRenderState.RenderDetails, nil)
+			if err != nil {
+				return err
+			}
+			if rr != nil {
+				premix.Data = append(premix.Data, rr)
+				premix.Sources = append(premix.Sources, output.PremixSource{
+					Channel:     ch,
+					Instruments: instIDs,
+				})
+			}
+		}
+	}
+
+	premix.MixerVolume = m.GetMixerVolume()
+	return nil
+}
+
+/** unused in XM, for now
+func (m *Manager) ensureOPL2() {
+	if opl2 := m.GetOPL2Chip(); opl2 == nil {
+		if s := m.GetSampler(); s != nil {
+			opl2 = render.NewOPL2Chip(uint32(s.SampleRate))
+			opl2.WriteReg(0x01, 0x20) // enable all waveforms
+			opl2.WriteReg(0x04, 0x00) // clear timer flags
+			opl2.WriteReg(0x08, 0x40) // clear CSW and set NOTE-SEL
+			opl2.WriteReg(0xBD, 0x00) // set default notes
+			m.SetOPL2Chip(opl2)
+		}
+	}
+}
+*/
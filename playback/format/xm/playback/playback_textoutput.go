@@ -0,0 +1,33 @@
+package playback
+
+import (
+	"github.com/gotracker/playback/format/xm/channel"
+	"github.com/gotracker/playback/player/render"
+)
+
+func (m *Manager) getRowText() *render.RowDisplay[channel.Data] {
+	nCh := 0
+	for ch := range m.channels {
+		if !m.song.IsChannelEnabled(ch) {
+			continue
+		}
+		nCh++
+	}
+	var rowText *render.RowDisplay[channel.Data]
+	if m.ReuseBuffers() {
+		rowText = &m.rowText
+	} else {
+		rowText = &render.RowDisplay[channel.Data]{}
+	}
+	rowText.Reset(nCh, true)
+	for ch, cs := range m.channels {
+		if !m.song.IsChannelEnabled(ch) {
+			continue
+		}
+
+		if cd := cs.GetData(); cd != nil {
+			rowText.Channels[ch] = *cd
+		}
+	}
+	return rowText
+}
@@ -0,0 +1,16 @@
+module github.com/gotracker/playback
+
+go 1.18
+
+require (
+	github.com/gotracker/goaudiofile v1.0.14
+	github.com/gotracker/gomixing v1.3.0
+	github.com/gotracker/opl2 v1.0.1
+	github.com/heucuva/comparison v1.0.0
+	github.com/heucuva/optional v0.0.1
+	golang.org/x/exp v0.0.0-20220713135740-79cabaa25d75
+)
+
+require github.com/pkg/errors v0.9.1 // indirect
+
+replace github.com/gotracker/goaudiofile => ../goaudiofile
@@ -0,0 +1,4 @@
+package index
+
+// Order is an index into the pattern order list
+type Order uint8
@@ -0,0 +1,1140 @@
+package main
+
+// Song: 1942
+// Format: Amiga MOD
+// Authors: Lizardking (Gustaf Grefberg), Some1 (Mikael Fyrek)
+// Released: 1994
+var modfile = []byte{
+	0x31, 0x39, 0x34, 0x32, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x20, 0x23, 0x6c, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x6b, 0x69, 0x6e, 0x67,
+	0x20, 0x26, 0x20, 0x73, 0x6f, 0x6d, 0x65, 0x31, 0x23, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x01, 0x20, 0x20, 0x20, 0x20, 0x20, 0x6f, 0x66, 0x20, 0x72, 0x61, 0x7a, 0x6f, 0x72, 0x20,
+	0x31, 0x39, 0x31, 0x31, 0x20, 0x20, 0x20, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01,
+	0x66, 0x72, 0x6f, 0x6d, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x61, 0x6d, 0x6f, 0x75, 0x73, 0x20,
+	0x67, 0x61, 0x6d, 0x65, 0x20, 0x00, 0x04, 0x6d, 0x00, 0x40, 0x00, 0x00, 0x00, 0x01, 0x63, 0x61,
+	0x6c, 0x6c, 0x65, 0x64, 0x20, 0x22, 0x31, 0x39, 0x34, 0x32, 0x22, 0x20, 0x6f, 0x6e, 0x20, 0x74,
+	0x68, 0x65, 0x20, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x6f, 0x6c, 0x64, 0x20,
+	0x63, 0x2d, 0x36, 0x34, 0x21, 0x21, 0x21, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x10, 0x02, 0x19, 0x00, 0x00, 0x00, 0x10, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x01, 0x5e, 0x00, 0x40, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0xe2, 0x00, 0x40, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0xd2,
+	0x00, 0x40, 0x00, 0x00, 0x00, 0x01, 0x5f, 0x2a, 0x20, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x20, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x3a, 0x20, 0x2a, 0x00, 0x00, 0xad, 0x00, 0x20,
+	0x00, 0x00, 0x00, 0x01, 0x5f, 0x2a, 0x20, 0x20, 0x50, 0x72, 0x6f, 0x52, 0x75, 0x6e, 0x6e, 0x65,
+	0x72, 0x5f, 0x76, 0x31, 0x2e, 0x30, 0x20, 0x20, 0x2a, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01,
+	0x3d, 0x3d, 0x3d, 0x3d, 0x3d, 0x3d, 0x3d, 0x3d, 0x3d, 0x3d, 0x3d, 0x3d, 0x3d, 0x3d, 0x3d, 0x3d,
+	0x3d, 0x3d, 0x3d, 0x3d, 0x3d, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x52, 0x69,
+	0x70, 0x70, 0x65, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x4d, 0x75, 0x73, 0x69, 0x63, 0x64,
+	0x69, 0x73, 0x6b, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x22, 0x44, 0x6f, 0x73, 0x6b, 0x70, 0x6f, 0x70, 0x22, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+	0x20, 0x20, 0x2d, 0x20, 0x2d, 0x20, 0x2d, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x53, 0x65, 0x6e, 0x64, 0x20, 0x6d, 0x65, 0x20,
+	0x6d, 0x6f, 0x72, 0x65, 0x20, 0x74, 0x6f, 0x20, 0x72, 0x69, 0x70, 0x21, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x20, 0x20, 0x20, 0x20, 0x20, 0x54, 0x69, 0x6d, 0x6f, 0x20,
+	0x56, 0x65, 0x68, 0x6d, 0x61, 0x61, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x01, 0x20, 0x74, 0x69, 0x76, 0x65, 0x40, 0x76, 0x69, 0x70, 0x75, 0x6e, 0x65,
+	0x6e, 0x2e, 0x68, 0x75, 0x74, 0x2e, 0x66, 0x69, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x01, 0x3d, 0x3d, 0x3d, 0x3d, 0x3d, 0x3d, 0x3d, 0x3d, 0x3d, 0x3d, 0x3d, 0x3d, 0x3d, 0x3d,
+	0x3d, 0x3d, 0x3d, 0x3d, 0x3d, 0x3d, 0x3d, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x5f, 0x20,
+	0x52, 0x69, 0x70, 0x70, 0x65, 0x64, 0x20, 0x26, 0x20, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x65, 0x64, 0x20, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x5f, 0x20, 0x77, 0x69,
+	0x7a, 0x20, 0x50, 0x72, 0x6f, 0x2d, 0x57, 0x69, 0x7a, 0x61, 0x72, 0x64, 0x20, 0x32, 0x2e, 0x30,
+	0x20, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x5f, 0x20, 0x77, 0x72, 0x69, 0x74,
+	0x74, 0x65, 0x6e, 0x20, 0x62, 0x79, 0x20, 0x47, 0x72, 0x79, 0x7a, 0x6f, 0x72, 0x21, 0x20, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x0d, 0x7f, 0x0b, 0x0b, 0x00, 0x01, 0x02, 0x03, 0x04, 0x05,
+	0x06, 0x07, 0x08, 0x09, 0x0a, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x4d, 0x2e, 0x4b, 0x2e, 0x02, 0xd0, 0x3f, 0x04,
+	0x01, 0xe0, 0x64, 0x91, 0x02, 0x3a, 0x64, 0x91, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x01, 0xe0, 0x66, 0x03, 0x02, 0x3a, 0x66, 0x03, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x68, 0x3a, 0x0a,
+	0x01, 0xe0, 0x66, 0x03, 0x02, 0x3a, 0x66, 0x03, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x01, 0x68, 0x66, 0x03, 0x02, 0x3a, 0x66, 0x03, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x68, 0x3a, 0x0a,
+	0x01, 0x40, 0x66, 0x03, 0x01, 0xe0, 0x66, 0x03, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x01, 0x0d, 0x66, 0x03, 0x02, 0x3a, 0x66, 0x03, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x02, 0xd0, 0x3a, 0x0a,
+	0x01, 0x1d, 0x66, 0x01, 0x01, 0xe0, 0x66, 0x01, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x02, 0x00, 0x00, 0x06, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0xf0, 0x60, 0x00, 0x01, 0x1d, 0x60, 0x00, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x91, 0x00, 0x00, 0x04, 0x91, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x92, 0x00, 0x00, 0x04, 0x92, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x00, 0x00, 0x02, 0xd0, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x04, 0x02, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x93, 0x00, 0x00, 0x04, 0x93, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x02, 0xd0, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0d, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xd0, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xd0, 0x3f, 0x04,
+	0x01, 0xe0, 0x64, 0x91, 0x02, 0x3a, 0x64, 0x91, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x01, 0xe0, 0x66, 0x03, 0x02, 0x3a, 0x66, 0x03, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x68, 0x3a, 0x0a,
+	0x01, 0xe0, 0x66, 0x03, 0x02, 0x3a, 0x66, 0x03, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x01, 0x68, 0x66, 0x03, 0x02, 0x3a, 0x66, 0x03, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x68, 0x3a, 0x0a,
+	0x01, 0x40, 0x66, 0x03, 0x01, 0xe0, 0x66, 0x03, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x01, 0x0d, 0x66, 0x03, 0x02, 0x3a, 0x66, 0x03, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x02, 0xd0, 0x3a, 0x0a,
+	0x01, 0x1d, 0x66, 0x01, 0x01, 0xe0, 0x66, 0x01, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x91, 0x00, 0x00, 0x04, 0x91, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x92, 0x00, 0x00, 0x04, 0x92, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x00, 0x00, 0x02, 0xd0, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x04, 0x02, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x93, 0x00, 0x00, 0x04, 0x93, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x02, 0xd0, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0d, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xd0, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xd0, 0x3f, 0x04,
+	0x01, 0xe0, 0x64, 0x91, 0x02, 0x3a, 0x64, 0x91, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x01, 0xe0, 0x66, 0x03, 0x02, 0x3a, 0x66, 0x03, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x68, 0x3a, 0x0a,
+	0x01, 0xe0, 0x66, 0x03, 0x02, 0x3a, 0x66, 0x03, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x01, 0x68, 0x66, 0x03, 0x02, 0x3a, 0x66, 0x03, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x68, 0x3a, 0x0a,
+	0x01, 0x40, 0x66, 0x03, 0x01, 0xe0, 0x66, 0x03, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x01, 0x0d, 0x66, 0x03, 0x02, 0x3a, 0x66, 0x03, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x02, 0xd0, 0x3a, 0x0a,
+	0x01, 0x1d, 0x66, 0x01, 0x01, 0xe0, 0x66, 0x01, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x02, 0x00, 0x00, 0x06, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x01, 0x68, 0x60, 0x00, 0x02, 0x3a, 0x60, 0x00, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x91, 0x00, 0x00, 0x04, 0x91, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x68, 0x3a, 0x0a,
+	0x01, 0x40, 0x64, 0x01, 0x02, 0x1a, 0x64, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x01, 0x0d, 0x64, 0x92, 0x01, 0xac, 0x64, 0x92, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x00, 0x00, 0x02, 0xd0, 0x3a, 0x0a,
+	0x01, 0x1d, 0x64, 0x91, 0x01, 0xe0, 0x64, 0x91, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x02, 0x00, 0x00, 0x06, 0x02, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x68, 0x3a, 0x0a,
+	0x01, 0x68, 0x66, 0x01, 0x02, 0x3a, 0x66, 0x01, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x02, 0x00, 0x00, 0x06, 0x02, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x68, 0x3a, 0x0a,
+	0x01, 0x40, 0x6a, 0x03, 0x02, 0x1a, 0x6a, 0x03, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x0a, 0x03, 0x00, 0x00, 0x0a, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x01, 0x0d, 0x6a, 0x03, 0x01, 0xac, 0x6a, 0x03, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x0a, 0x03, 0x00, 0x00, 0x0a, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x02, 0xd0, 0x3a, 0x0a,
+	0x01, 0x1d, 0x6a, 0x01, 0x01, 0xe0, 0x6a, 0x01, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x0a, 0x01, 0x00, 0x00, 0x0a, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x0a, 0x03, 0x00, 0x00, 0x0a, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x0a, 0x03, 0x00, 0x00, 0x0a, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x01, 0x68, 0x66, 0x01, 0x02, 0x3a, 0x66, 0x01, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x68, 0x3a, 0x0a,
+	0x01, 0x1d, 0x66, 0x01, 0x01, 0xe0, 0x66, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0xf0, 0x66, 0x01, 0x01, 0x68, 0x66, 0x01, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0d, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xd0, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 0x58, 0x3f, 0x04,
+	0x00, 0xd6, 0x66, 0x01, 0x01, 0x53, 0x66, 0x01, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0f, 0x01, 0xac, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0xac, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x91, 0x00, 0x00, 0x04, 0x91, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x01, 0xac, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0xac, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x92, 0x00, 0x00, 0x04, 0x92, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x00, 0x0f, 0x03, 0x58, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x04, 0x02, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x93, 0x00, 0x00, 0x04, 0x93, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0xac, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0xac, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x01, 0xac, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0xac, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x03, 0x58, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x01, 0xac, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0xac, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x01, 0xac, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0xac, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x03, 0x58, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0xac, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0xac, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x01, 0xac, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0xac, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0d, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x80, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x80, 0x3f, 0x04,
+	0x01, 0xac, 0x64, 0x91, 0x01, 0xfc, 0x64, 0x91, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x01, 0xac, 0x66, 0x03, 0x01, 0xfc, 0x66, 0x03, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x40, 0x3a, 0x0a,
+	0x01, 0xac, 0x66, 0x03, 0x01, 0xfc, 0x66, 0x03, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x01, 0x40, 0x66, 0x03, 0x01, 0xfc, 0x66, 0x03, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x40, 0x3a, 0x0a,
+	0x01, 0x1d, 0x66, 0x03, 0x01, 0xac, 0x66, 0x03, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0xf0, 0x66, 0x03, 0x01, 0xfc, 0x66, 0x03, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x02, 0x80, 0x3a, 0x0a,
+	0x00, 0xfe, 0x66, 0x01, 0x01, 0xac, 0x66, 0x01, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x02, 0x00, 0x00, 0x06, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0xd6, 0x60, 0x00, 0x00, 0xfe, 0x60, 0x00, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x91, 0x00, 0x00, 0x04, 0x91, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x92, 0x00, 0x00, 0x04, 0x92, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x00, 0x00, 0x02, 0x80, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x04, 0x02, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x93, 0x00, 0x00, 0x04, 0x93, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x02, 0x80, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0d, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x80, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x80, 0x3f, 0x04,
+	0x01, 0xac, 0x64, 0x91, 0x01, 0xfc, 0x64, 0x91, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x01, 0xac, 0x66, 0x03, 0x01, 0xfc, 0x66, 0x03, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x40, 0x3a, 0x0a,
+	0x01, 0xac, 0x66, 0x03, 0x01, 0xfc, 0x66, 0x03, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x01, 0x40, 0x66, 0x03, 0x01, 0xfc, 0x66, 0x03, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x40, 0x3a, 0x0a,
+	0x01, 0x1d, 0x66, 0x03, 0x01, 0xac, 0x66, 0x03, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0xf0, 0x66, 0x03, 0x01, 0xfc, 0x66, 0x03, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x02, 0x80, 0x3a, 0x0a,
+	0x00, 0xfe, 0x66, 0x01, 0x01, 0xac, 0x66, 0x01, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x91, 0x00, 0x00, 0x04, 0x91, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x92, 0x00, 0x00, 0x04, 0x92, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x00, 0x00, 0x02, 0x80, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x04, 0x02, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x93, 0x00, 0x00, 0x04, 0x93, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x02, 0x80, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0d, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x80, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x80, 0x3f, 0x04,
+	0x01, 0xac, 0x64, 0x91, 0x01, 0xfc, 0x64, 0x91, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x01, 0xac, 0x66, 0x03, 0x01, 0xfc, 0x66, 0x03, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x40, 0x3a, 0x0a,
+	0x01, 0xac, 0x66, 0x03, 0x01, 0xfc, 0x66, 0x03, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x01, 0x40, 0x66, 0x03, 0x01, 0xfc, 0x66, 0x03, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x40, 0x3a, 0x0a,
+	0x01, 0x1d, 0x66, 0x03, 0x01, 0xac, 0x66, 0x03, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0xf0, 0x66, 0x03, 0x01, 0xfc, 0x66, 0x03, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x02, 0x80, 0x3a, 0x0a,
+	0x00, 0xfe, 0x66, 0x01, 0x01, 0xac, 0x66, 0x01, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x02, 0x00, 0x00, 0x06, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x01, 0x40, 0x60, 0x00, 0x01, 0xfc, 0x60, 0x00, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x91, 0x00, 0x00, 0x04, 0x91, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x40, 0x3a, 0x0a,
+	0x01, 0x1d, 0x64, 0x01, 0x01, 0xe0, 0x64, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0xf0, 0x64, 0x92, 0x01, 0x7d, 0x64, 0x92, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x00, 0x00, 0x02, 0x80, 0x3a, 0x0a,
+	0x00, 0xfe, 0x64, 0x91, 0x01, 0xac, 0x64, 0x91, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x02, 0x00, 0x00, 0x06, 0x02, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x40, 0x3a, 0x0a,
+	0x01, 0x40, 0x66, 0x01, 0x01, 0xfc, 0x66, 0x01, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x02, 0x00, 0x00, 0x06, 0x02, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x40, 0x3a, 0x0a,
+	0x01, 0x1d, 0x6a, 0x03, 0x01, 0xe0, 0x6a, 0x03, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x0a, 0x03, 0x00, 0x00, 0x0a, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0xf0, 0x6a, 0x03, 0x01, 0x7d, 0x6a, 0x03, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x0a, 0x03, 0x00, 0x00, 0x0a, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x02, 0x80, 0x3a, 0x0a,
+	0x00, 0xfe, 0x6a, 0x01, 0x01, 0xac, 0x6a, 0x01, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x0a, 0x01, 0x00, 0x00, 0x0a, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x0a, 0x03, 0x00, 0x00, 0x0a, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x0a, 0x03, 0x00, 0x00, 0x0a, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x01, 0x40, 0x66, 0x01, 0x01, 0xfc, 0x66, 0x01, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0xfe, 0x66, 0x01, 0x01, 0xac, 0x66, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x3a, 0x0a,
+	0x00, 0xd6, 0x66, 0x01, 0x01, 0x40, 0x66, 0x01, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0d, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x80, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x40, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xfa, 0x3f, 0x04,
+	0x00, 0xbe, 0x66, 0x01, 0x01, 0x2e, 0x66, 0x01, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x7d, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x7d, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x91, 0x00, 0x00, 0x04, 0x91, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x7d, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x7d, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x92, 0x00, 0x00, 0x04, 0x92, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x00, 0x0f, 0x02, 0xfa, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x04, 0x02, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x93, 0x00, 0x00, 0x04, 0x93, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x7d, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x7d, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x7d, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x7d, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x02, 0xfa, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x7d, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x7d, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x7d, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x7d, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x02, 0xfa, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x7d, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x7d, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x7d, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x7d, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0d, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x3a, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x1d, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x1d, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x1d, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x1d, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x3a, 0x3f, 0x04,
+	0x01, 0x7d, 0x64, 0x91, 0x01, 0xc5, 0x64, 0x91, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x1d, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x1d, 0x3a, 0x0a,
+	0x01, 0x1d, 0x66, 0x02, 0x01, 0x7d, 0x66, 0x02, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x02, 0x00, 0x00, 0x06, 0x02, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x1d, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x02, 0x00, 0x00, 0x06, 0x02, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x02, 0x00, 0x00, 0x06, 0x02, 0x00, 0x00, 0x00, 0x00, 0x01, 0x1d, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x02, 0x3a, 0x3a, 0x0a,
+	0x00, 0xfe, 0x66, 0x01, 0x01, 0xac, 0x66, 0x01, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0xd6, 0x66, 0x02, 0x01, 0x7d, 0x66, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x1d, 0x3a, 0x0a,
+	0x00, 0xfe, 0x63, 0x30, 0x01, 0x7d, 0x63, 0x30, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x03, 0x30, 0x00, 0x00, 0x03, 0x30, 0x00, 0x00, 0x00, 0x00, 0x01, 0x1d, 0x3a, 0x0a,
+	0x00, 0xe2, 0x64, 0x91, 0x01, 0x1d, 0x64, 0x91, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x1d, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x1d, 0x3a, 0x0a,
+	0x01, 0x7d, 0x64, 0x91, 0x01, 0xc5, 0x64, 0x91, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x00, 0x02, 0x3a, 0x3a, 0x0a,
+	0x00, 0xfe, 0x63, 0xf1, 0x01, 0x7d, 0x63, 0xf1, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x03, 0xf1, 0x00, 0x00, 0x03, 0xf1, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0xd6, 0x64, 0x91, 0x01, 0x7d, 0x64, 0x91, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x1d, 0x3a, 0x0a,
+	0x00, 0xfe, 0x6a, 0x02, 0x01, 0x2e, 0x6a, 0x02, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x0a, 0x02, 0x00, 0x00, 0x0a, 0x02, 0x00, 0x00, 0x00, 0x00, 0x01, 0x1d, 0x3a, 0x0a,
+	0x00, 0xe2, 0x64, 0x01, 0x01, 0x1d, 0x64, 0x01, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x1d, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x1d, 0x3a, 0x0a,
+	0x01, 0x1d, 0x64, 0x01, 0x01, 0xc5, 0x64, 0x01, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x61, 0x00, 0x00, 0x04, 0x61, 0x00, 0x00, 0x00, 0x0f, 0x02, 0x3a, 0x3a, 0x0a,
+	0x00, 0xfe, 0x63, 0x61, 0x01, 0x7d, 0x63, 0x61, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x03, 0x61, 0x00, 0x00, 0x03, 0x61, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0xd6, 0x63, 0x61, 0x01, 0x7d, 0x63, 0x61, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x03, 0x01, 0x00, 0x00, 0x03, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x1d, 0x3a, 0x0a,
+	0x00, 0xfe, 0x64, 0x01, 0x01, 0x2e, 0x64, 0x01, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x1d, 0x3a, 0x0a,
+	0x00, 0xe2, 0x66, 0x01, 0x01, 0x1d, 0x66, 0x01, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x1d, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x1d, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x01, 0x7d, 0x6c, 0x21, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0d, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0f, 0x00, 0x00, 0x00, 0x00, 0x02, 0x3a, 0x30, 0x00,
+	0x01, 0x2e, 0x60, 0x00, 0x00, 0x00, 0x0a, 0x0f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x1d, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x1d, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x1d, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x1d, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0xc5, 0x3f, 0x04,
+	0x00, 0xe2, 0x64, 0x91, 0x01, 0x2e, 0x64, 0x91, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x00, 0xe2, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0xe2, 0x3a, 0x0a,
+	0x01, 0x2e, 0x66, 0x02, 0x01, 0x68, 0x66, 0x02, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x02, 0x00, 0x00, 0x06, 0x02, 0x00, 0x00, 0x00, 0x0f, 0x00, 0xe2, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x02, 0x00, 0x00, 0x06, 0x02, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x02, 0x00, 0x00, 0x06, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0xe2, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x03, 0x28, 0x3a, 0x0a,
+	0x01, 0x0d, 0x66, 0x01, 0x01, 0x53, 0x66, 0x01, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x02, 0x00, 0x00, 0x06, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x06, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0x94, 0x3a, 0x0a,
+	0x00, 0x00, 0x03, 0x30, 0x00, 0x00, 0x03, 0x30, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x03, 0x30, 0x00, 0x00, 0x03, 0x30, 0x00, 0x00, 0x00, 0x00, 0x02, 0x5c, 0x3a, 0x0a,
+	0x00, 0xf0, 0x64, 0x91, 0x01, 0x2e, 0x64, 0x91, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x2e, 0x3a, 0x0a,
+	0x00, 0xe2, 0x64, 0x01, 0x01, 0x0d, 0x64, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x2e, 0x3a, 0x0a,
+	0x00, 0xca, 0x64, 0x91, 0x01, 0x2e, 0x64, 0x91, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0xc5, 0x3a, 0x0a,
+	0x00, 0xe2, 0x66, 0x01, 0x01, 0x68, 0x66, 0x01, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0f, 0x00, 0xe2, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xe2, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x91, 0x00, 0x00, 0x04, 0x91, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x00, 0xe2, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x04, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0xe2, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x92, 0x00, 0x00, 0x04, 0x92, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x00, 0x0f, 0x01, 0xfc, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x04, 0x02, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x04, 0x02, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x93, 0x00, 0x00, 0x04, 0x93, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0xfe, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0xfe, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x0f, 0x00, 0xfe, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0xfe, 0x3a, 0x0a,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0d, 0x00,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x01, 0xfc, 0x30, 0x00,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0xfe, 0x30, 0x00,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x04, 0x03, 0x00, 0x00, 0x00, 0x00, 0x00, 0xfe, 0x30, 0x00,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0xfe, 0x30, 0x00,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0xfe, 0x30, 0x00,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x02, 0x1a, 0x3f, 0x04,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x0d, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x0d, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x0d, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x0d, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x02, 0x5c, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x2e, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x2e, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x2e, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x2e, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0d, 0x00,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0xc5, 0x3a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0f, 0x00, 0xe2, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xe2, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0f, 0x00, 0xe2, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xe2, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0f, 0x01, 0xfc, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xfe, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xfe, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0f, 0x00, 0xfe, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xfe, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0d, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0xfc, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xfe, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xfe, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xfe, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xfe, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xd0, 0x3f, 0x04,
+	0x00, 0x00, 0x0a, 0x0f, 0x00, 0x00, 0x0a, 0x0f, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x0a, 0x0f, 0x00, 0x00, 0x0a, 0x0f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x0a, 0x0f, 0x00, 0x00, 0x0a, 0x0f, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x0a, 0x0f, 0x00, 0x00, 0x0a, 0x0f, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0f, 0x02, 0xd0, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xd0, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0xd6, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd6, 0xe0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0f, 0x02, 0xd0, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd6, 0xd0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd6, 0xf0, 0x0f, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x3a, 0x0a,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd6, 0xe0, 0x00, 0x00, 0x00, 0x0d, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xd0, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x68, 0x30, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x4e, 0x65,
+	0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7a, 0x6d, 0x5b, 0x5b, 0x53, 0x3f,
+	0x27, 0x20, 0x20, 0x1d, 0x18, 0x10, 0x06, 0x00, 0xf0, 0xe0, 0xdb, 0xd1, 0xc4, 0xba, 0xb2, 0xa5,
+	0xa2, 0x95, 0x95, 0x8e, 0x86, 0x83, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+	0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x86, 0x8b, 0x93, 0x95, 0x9b, 0xa2, 0xa2, 0xa5, 0xa5, 0xad,
+	0xb2, 0xb4, 0xba, 0xc1, 0xc4, 0xc4, 0xd1, 0xd3, 0xd3, 0xdb, 0xe0, 0xe0, 0xe3, 0xe8, 0xe8, 0xeb,
+	0xf0, 0xf2, 0xf2, 0xf2, 0xf7, 0xfa, 0xfa, 0xff, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x06, 0x06,
+	0x09, 0x0e, 0x10, 0x15, 0x18, 0x1d, 0x1d, 0x18, 0x18, 0x18, 0x18, 0x0e, 0x01, 0x00, 0xf7, 0xf0,
+	0xe3, 0xdb, 0xd3, 0xd1, 0xc4, 0xc4, 0xd1, 0xe3, 0xe8, 0xf2, 0x01, 0x27, 0x65, 0x7f, 0x7f, 0x7f,
+	0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7d,
+	0x7d, 0x7f, 0x7d, 0x7a, 0x6d, 0x63, 0x5b, 0x46, 0x46, 0x3f, 0x3f, 0x3c, 0x34, 0x2d, 0x2d, 0x20,
+	0x15, 0x09, 0x00, 0x00, 0xfa, 0xf2, 0xf2, 0xf0, 0xeb, 0xe3, 0xe0, 0xe8, 0xe8, 0xe8, 0xe0, 0xe0,
+	0xe0, 0xe3, 0xe3, 0xe0, 0xe0, 0xe0, 0xe3, 0xeb, 0xf2, 0xf2, 0xf0, 0xf2, 0xf7, 0x00, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x06, 0x09, 0x10,
+	0x18, 0x1d, 0x1d, 0x1d, 0x1d, 0x1d, 0x1d, 0x20, 0x1d, 0x18, 0x15, 0x10, 0x0e, 0x09, 0x01, 0x00,
+	0x00, 0x00, 0xfa, 0xf2, 0xf0, 0xf0, 0xe8, 0xe0, 0xd3, 0xc1, 0xb2, 0xa2, 0x93, 0x83, 0x83, 0x80,
+	0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x95, 0xc1, 0xf7, 0x06, 0x1d, 0x20, 0x20, 0x27,
+	0x2d, 0x2d, 0x2d, 0x34, 0x4c, 0x5e, 0x6d, 0x7d, 0x7a, 0x72, 0x6b, 0x5e, 0x56, 0x53, 0x53, 0x53,
+	0x4e, 0x4c, 0x3f, 0x34, 0x2d, 0x20, 0x18, 0x10, 0x0e, 0x09, 0x09, 0x06, 0x00, 0x00, 0xff, 0xf7,
+	0xf0, 0xe8, 0xe0, 0xe0, 0xdb, 0xd9, 0xd3, 0xd3, 0xd3, 0xd3, 0xd9, 0xdb, 0xe0, 0xe0, 0xe0, 0xe3,
+	0xe8, 0xeb, 0xeb, 0xf0, 0xf2, 0xf7, 0xfa, 0xfa, 0xff, 0x00, 0x00, 0xff, 0xfa, 0xf7, 0xf2, 0xf0,
+	0xf0, 0xeb, 0xe8, 0xe0, 0xe0, 0xe0, 0xe8, 0xe8, 0xe3, 0xe8, 0xfa, 0x00, 0x06, 0x09, 0x0e, 0x0e,
+	0x0e, 0x0e, 0x10, 0x15, 0x18, 0x1d, 0x20, 0x2d, 0x2d, 0x25, 0x20, 0x18, 0x18, 0x15, 0x0e, 0x09,
+	0x06, 0x06, 0x01, 0x01, 0x00, 0x00, 0x00, 0xf7, 0xe3, 0xe0, 0xd3, 0xc1, 0xb2, 0xaa, 0xa2, 0x95,
+	0x95, 0x93, 0x8e, 0x86, 0x86, 0x8e, 0xb4, 0xdb, 0x01, 0x10, 0x27, 0x3c, 0x3f, 0x46, 0x3f, 0x3f,
+	0x3f, 0x44, 0x56, 0x5e, 0x72, 0x7a, 0x7a, 0x7a, 0x72, 0x6b, 0x5e, 0x5e, 0x5e, 0x5e, 0x56, 0x4e,
+	0x4c, 0x3f, 0x3c, 0x27, 0x20, 0x18, 0x10, 0x09, 0x06, 0x00, 0x00, 0xfa, 0xf2, 0xe8, 0xe0, 0xd3,
+	0xd1, 0xc9, 0xc4, 0xc1, 0xba, 0xba, 0xba, 0xba, 0xb4, 0xb4, 0xb4, 0xba, 0xba, 0xbc, 0xc1, 0xc4,
+	0xc4, 0xc9, 0xcc, 0xd1, 0xd3, 0xd3, 0xd9, 0xd9, 0xd9, 0xd3, 0xd3, 0xd3, 0xd1, 0xd1, 0xc9, 0xc4,
+	0xc4, 0xc4, 0xc4, 0xc4, 0xc4, 0xc4, 0xc4, 0xd9, 0xe0, 0xf2, 0xfa, 0x00, 0x00, 0x01, 0x09, 0x0e,
+	0x0e, 0x0e, 0x15, 0x20, 0x2d, 0x2d, 0x2f, 0x2f, 0x2f, 0x2f, 0x2f, 0x2d, 0x2d, 0x25, 0x20, 0x20,
+	0x20, 0x20, 0x20, 0x18, 0x0e, 0x06, 0x00, 0x00, 0xf0, 0xe0, 0xd1, 0xc1, 0xc1, 0xba, 0xb4, 0xad,
+	0xa2, 0x9b, 0x9b, 0xad, 0xc9, 0xeb, 0x06, 0x15, 0x2f, 0x44, 0x4c, 0x53, 0x53, 0x4c, 0x4c, 0x4c,
+	0x53, 0x5e, 0x6b, 0x7d, 0x7a, 0x7a, 0x7a, 0x72, 0x72, 0x6d, 0x6b, 0x6b, 0x65, 0x5e, 0x5b, 0x53,
+	0x4c, 0x3f, 0x34, 0x2d, 0x25, 0x20, 0x18, 0x10, 0x0e, 0x06, 0x00, 0x00, 0xfa, 0xf2, 0xeb, 0xe3,
+	0xe0, 0xd3, 0xd3, 0xd1, 0xd1, 0xc9, 0xc9, 0xc4, 0xc4, 0xc4, 0xc4, 0xc4, 0xc9, 0xcc, 0xd1, 0xd3,
+	0xd3, 0xd9, 0xdb, 0xe0, 0xe0, 0xe0, 0xe0, 0xe0, 0xe0, 0xe0, 0xdb, 0xd3, 0xd3, 0xd1, 0xd1, 0xc9,
+	0xc9, 0xc4, 0xc4, 0xc4, 0xc4, 0xd1, 0xdb, 0xe8, 0xf0, 0xf7, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x06, 0x09, 0x10, 0x18, 0x18, 0x1d, 0x1d, 0x20, 0x20, 0x1d, 0x18, 0x10, 0x10, 0x10, 0x15, 0x10,
+	0x0e, 0x09, 0x06, 0x00, 0x00, 0xfa, 0xe8, 0xe0, 0xcc, 0xc4, 0xbc, 0xb4, 0xad, 0xa2, 0x95, 0x8e,
+	0x8e, 0x95, 0xb2, 0xc9, 0xe3, 0x06, 0x15, 0x2d, 0x2f, 0x3c, 0x37, 0x34, 0x2f, 0x34, 0x3c, 0x46,
+	0x4c, 0x53, 0x5b, 0x5e, 0x5e, 0x5e, 0x5e, 0x5e, 0x5b, 0x5b, 0x56, 0x53, 0x4c, 0x46, 0x3f, 0x37,
+	0x2d, 0x25, 0x20, 0x18, 0x15, 0x0e, 0x09, 0x01, 0x00, 0x00, 0xff, 0xf7, 0xf0, 0xe8, 0xe3, 0xe0,
+	0xdb, 0xd3, 0xd3, 0xd3, 0xd3, 0xd1, 0xd1, 0xd1, 0xd1, 0xd1, 0xd3, 0xd3, 0xd3, 0xdb, 0xe0, 0xe3,
+	0xe8, 0xe8, 0xe8, 0xeb, 0xeb, 0xeb, 0xeb, 0xe8, 0xe3, 0xe0, 0xe0, 0xe0, 0xe0, 0xdb, 0xd3, 0xd3,
+	0xd1, 0xd3, 0xd3, 0xdb, 0xe3, 0xf0, 0xf7, 0x00, 0x00, 0x00, 0x06, 0x06, 0x06, 0x09, 0x0e, 0x15,
+	0x18, 0x18, 0x1d, 0x20, 0x25, 0x25, 0x25, 0x20, 0x1d, 0x18, 0x18, 0x18, 0x18, 0x18, 0x15, 0x10,
+	0x10, 0x0e, 0x06, 0x00, 0xfa, 0xf0, 0xe3, 0xe0, 0xd9, 0xd3, 0xc1, 0xba, 0xad, 0xad, 0xad, 0xad,
+	0xba, 0xd3, 0xf0, 0x01, 0x1d, 0x2f, 0x3f, 0x3f, 0x44, 0x3f, 0x3f, 0x46, 0x46, 0x4c, 0x53, 0x56,
+	0x5b, 0x5e, 0x63, 0x65, 0x63, 0x5e, 0x5e, 0x5b, 0x5b, 0x56, 0x53, 0x4c, 0x44, 0x3c, 0x2f, 0x2d,
+	0x25, 0x20, 0x18, 0x10, 0x0e, 0x06, 0x01, 0x00, 0x00, 0x00, 0xf7, 0xf2, 0xeb, 0xe8, 0xe3, 0xe0,
+	0xdb, 0xd3, 0xd3, 0xd1, 0xd1, 0xd1, 0xd1, 0xd1, 0xcc, 0xcc, 0xd1, 0xd1, 0xd3, 0xd3, 0xdb, 0xe0,
+	0xe0, 0xe0, 0xe3, 0xe3, 0xe3, 0xe0, 0xe0, 0xdb, 0xdb, 0xdb, 0xd3, 0xd3, 0xd1, 0xd1, 0xcc, 0xcc,
+	0xd1, 0xd3, 0xd9, 0xe0, 0xf2, 0xf7, 0xff, 0x00, 0x00, 0x00, 0x00, 0x00, 0x06, 0x06, 0x09, 0x0e,
+	0x10, 0x18, 0x1d, 0x20, 0x1d, 0x1d, 0x18, 0x18, 0x1d, 0x1d, 0x18, 0x18, 0x18, 0x18, 0x18, 0x15,
+	0x0e, 0x01, 0x00, 0xfa, 0xf2, 0xf0, 0xe8, 0xe0, 0xd3, 0xc1, 0xbc, 0xb4, 0xb4, 0xb4, 0xba, 0xd1,
+	0xeb, 0x01, 0x15, 0x2d, 0x3c, 0x3f, 0x3f, 0x44, 0x46, 0x46, 0x4c, 0x4c, 0x4c, 0x4e, 0x53, 0x5b,
+	0x5e, 0x5e, 0x5e, 0x5e, 0x5b, 0x5b, 0x5b, 0x53, 0x4e, 0x46, 0x3c, 0x37, 0x2d, 0x25, 0x1d, 0x15,
+	0x0e, 0x09, 0x00, 0x00, 0x00, 0x00, 0xfa, 0xf2, 0xf0, 0xe8, 0xe3, 0xe0, 0xdb, 0xd3, 0xd3, 0xd1,
+	0xc9, 0xc4, 0xc4, 0xc4, 0xc4, 0xc1, 0xc1, 0xc4, 0xc4, 0xc4, 0xc9, 0xd1, 0xd1, 0xd3, 0xd3, 0xdb,
+	0xe0, 0xe0, 0xe0, 0xe0, 0xe0, 0xe0, 0xe0, 0xdb, 0xd9, 0xd3, 0xd3, 0xd1, 0xd1, 0xd1, 0xd1, 0xd3,
+	0xd9, 0xe3, 0xf0, 0xf2, 0xfa, 0xff, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x06, 0x09,
+	0x0e, 0x0e, 0x0e, 0x0e, 0x0e, 0x0e, 0x0e, 0x0e, 0x0e, 0x0e, 0x0e, 0x10, 0x10, 0x0e, 0x06, 0x00,
+	0x00, 0xf7, 0xf2, 0xeb, 0xe3, 0xdb, 0xd3, 0xc1, 0xc1, 0xb4, 0xad, 0xad, 0xb4, 0xc1, 0xe0, 0xfa,
+	0x09, 0x1d, 0x2f, 0x37, 0x3c, 0x3f, 0x46, 0x46, 0x4c, 0x4c, 0x4c, 0x4c, 0x4c, 0x53, 0x5b, 0x5b,
+	0x5b, 0x5e, 0x5e, 0x5e, 0x5b, 0x5b, 0x53, 0x4e, 0x4c, 0x3f, 0x3c, 0x37, 0x2d, 0x27, 0x20, 0x18,
+	0x10, 0x0e, 0x09, 0x06, 0x00, 0x00, 0x00, 0xff, 0xf7, 0xf2, 0xf0, 0xeb, 0xe8, 0xe3, 0xe0, 0xe0,
+	0xdb, 0xd3, 0xd3, 0xd3, 0xd3, 0xd3, 0xd3, 0xd3, 0xd3, 0xdb, 0xe0, 0xe0, 0xe3, 0xe8, 0xe8, 0xe8,
+	0xe8, 0xe8, 0xe8, 0xe8, 0xe8, 0xe3, 0xe0, 0xe0, 0xdb, 0xd3, 0xd3, 0xd1, 0xd1, 0xd3, 0xd3, 0xe0,
+	0xe8, 0xeb, 0xf2, 0xf7, 0xff, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x01, 0x06, 0x06, 0x06, 0x06, 0x06, 0x06, 0x09, 0x09, 0x0e, 0x09, 0x06, 0x00, 0x00, 0xff, 0xf7,
+	0xf2, 0xeb, 0xe3, 0xe0, 0xd3, 0xc9, 0xc1, 0xb4, 0xad, 0xad, 0xad, 0xc1, 0xd9, 0xf2, 0x01, 0x15,
+	0x27, 0x2f, 0x3c, 0x44, 0x4c, 0x4c, 0x4c, 0x4c, 0x4c, 0x4c, 0x4c, 0x4c, 0x53, 0x53, 0x56, 0x5b,
+	0x5b, 0x5b, 0x5b, 0x5b, 0x56, 0x53, 0x4c, 0x46, 0x3f, 0x3c, 0x2f, 0x2d, 0x20, 0x18, 0x10, 0x0e,
+	0x09, 0x01, 0x00, 0x00, 0x00, 0xff, 0xfa, 0xf2, 0xf0, 0xeb, 0xe8, 0xe0, 0xe0, 0xdb, 0xd3, 0xd3,
+	0xd3, 0xd1, 0xd1, 0xd1, 0xd1, 0xd3, 0xd3, 0xd3, 0xd9, 0xe0, 0xe0, 0xe3, 0xe3, 0xe8, 0xe8, 0xe8,
+	0xe8, 0xe8, 0xe8, 0xe8, 0xe8, 0xe3, 0xe0, 0xdb, 0xd3, 0xd3, 0xd3, 0xd3, 0xdb, 0xe0, 0xe8, 0xf0,
+	0xf7, 0xff, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x06, 0x09, 0x09,
+	0x09, 0x09, 0x09, 0x09, 0x09, 0x0e, 0x0e, 0x0e, 0x0e, 0x09, 0x01, 0x00, 0x00, 0xfa, 0xf2, 0xeb,
+	0xe8, 0xe0, 0xd3, 0xd1, 0xbc, 0xb4, 0xad, 0xa5, 0xaa, 0xb4, 0xc9, 0xe3, 0xfa, 0x00, 0x15, 0x25,
+	0x2d, 0x3f, 0x3f, 0x46, 0x4c, 0x4c, 0x46, 0x46, 0x46, 0x46, 0x46, 0x4c, 0x4e, 0x53, 0x56, 0x5b,
+	0x5b, 0x56, 0x53, 0x4e, 0x4c, 0x46, 0x3f, 0x3c, 0x2f, 0x2d, 0x20, 0x18, 0x10, 0x09, 0x06, 0x00,
+	0x00, 0x00, 0x00, 0xff, 0xfa, 0xf2, 0xf0, 0xeb, 0xe8, 0xe3, 0xe0, 0xe0, 0xd9, 0xd3, 0xd3, 0xd3,
+	0xd3, 0xd3, 0xd3, 0xd3, 0xd3, 0xd9, 0xe0, 0xe0, 0xe3, 0xe8, 0xe8, 0xe8, 0xeb, 0xeb, 0xeb, 0xeb,
+	0xeb, 0xeb, 0xeb, 0xe8, 0xe3, 0xe0, 0xdb, 0xd3, 0xd9, 0xdb, 0xe0, 0xe0, 0xe8, 0xf0, 0xf7, 0xff,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x01, 0x01, 0x01,
+	0x00, 0x01, 0x06, 0x06, 0x09, 0x09, 0x09, 0x06, 0x00, 0x00, 0x00, 0xfa, 0xf2, 0xf0, 0xe8, 0xe0,
+	0xd3, 0xcc, 0xc1, 0xb4, 0xaa, 0xa2, 0xa2, 0xa5, 0xba, 0xd1, 0xe8, 0xff, 0x01, 0x18, 0x27, 0x2f,
+	0x3c, 0x46, 0x4c, 0x4c, 0x4c, 0x46, 0x46, 0x44, 0x46, 0x4c, 0x4c, 0x53, 0x56, 0x5b, 0x5b, 0x5b,
+	0x5b, 0x53, 0x53, 0x4c, 0x4c, 0x3f, 0x3c, 0x2f, 0x2d, 0x20, 0x18, 0x10, 0x0e, 0x06, 0x01, 0x00,
+	0x00, 0x00, 0x00, 0xfa, 0xf7, 0xf2, 0xf0, 0xeb, 0xe8, 0xe8, 0xe0, 0xe0, 0xe0, 0xdb, 0xd9, 0xd3,
+	0xd3, 0xd9, 0xe0, 0xe0, 0xe3, 0xe8, 0xe8, 0xeb, 0xf0, 0xf0, 0xf0, 0xf0, 0xf0, 0xf2, 0xf2, 0xf2,
+	0xf2, 0xf0, 0xe8, 0xe3, 0xe0, 0xe0, 0xe0, 0xe0, 0xe0, 0xe3, 0xe8, 0xf2, 0xfa, 0xff, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01,
+	0x06, 0x09, 0x09, 0x0e, 0x0e, 0x09, 0x06, 0x00, 0x00, 0x00, 0xfa, 0xf2, 0xeb, 0xe3, 0xe0, 0xd3,
+	0xc1, 0xba, 0xad, 0xa5, 0xa2, 0xa5, 0xad, 0xc4, 0xd9, 0xf0, 0x01, 0x06, 0x1d, 0x25, 0x2f, 0x3c,
+	0x3f, 0x46, 0x44, 0x3f, 0x3c, 0x3c, 0x37, 0x3c, 0x3c, 0x3f, 0x46, 0x4c, 0x4c, 0x4c, 0x4c, 0x4c,
+	0x4c, 0x46, 0x3f, 0x3c, 0x37, 0x2d, 0x25, 0x1d, 0x15, 0x0e, 0x06, 0x00, 0x00, 0x00, 0x00, 0xff,
+	0xf7, 0xf2, 0xf0, 0xeb, 0xe8, 0xe8, 0xe0, 0xe0, 0xdb, 0xd9, 0xd3, 0xd3, 0xd3, 0xd3, 0xd3, 0xd3,
+	0xd9, 0xe0, 0xe0, 0xe3, 0xe8, 0xf0, 0xf0, 0xf2, 0xf2, 0xf2, 0xf2, 0xf7, 0xfa, 0xfa, 0xf7, 0xf2,
+	0xf2, 0xf0, 0xe8, 0xe8, 0xe3, 0xe3, 0xe8, 0xeb, 0xf2, 0xf7, 0xff, 0x00, 0x00, 0x00, 0x01, 0x06,
+	0x09, 0x09, 0x09, 0x06, 0x06, 0x06, 0x06, 0x06, 0x06, 0x06, 0x06, 0x06, 0x06, 0x09, 0x09, 0x0e,
+	0x0e, 0x10, 0x10, 0x10, 0x0e, 0x06, 0x00, 0x00, 0x00, 0xfa, 0xf7, 0xf0, 0xe8, 0xe0, 0xd3, 0xc1,
+	0xb2, 0xad, 0xad, 0xad, 0xb4, 0xc1, 0xd3, 0xe8, 0xff, 0x00, 0x15, 0x25, 0x34, 0x3c, 0x44, 0x46,
+	0x46, 0x44, 0x3f, 0x3c, 0x37, 0x37, 0x3c, 0x3c, 0x3f, 0x46, 0x4c, 0x4c, 0x4c, 0x4c, 0x4c, 0x46,
+	0x3f, 0x3c, 0x37, 0x2f, 0x2d, 0x20, 0x18, 0x0e, 0x06, 0x00, 0x00, 0x00, 0x00, 0xfa, 0xf7, 0xf2,
+	0xf0, 0xeb, 0xe8, 0xe3, 0xe0, 0xe0, 0xdb, 0xd3, 0xd3, 0xd3, 0xd1, 0xd1, 0xd1, 0xd1, 0xd3, 0xd3,
+	0xd9, 0xe0, 0xe3, 0xe8, 0xe8, 0xeb, 0xeb, 0xf0, 0xf2, 0xf2, 0xf7, 0xf7, 0xf2, 0xf2, 0xf0, 0xeb,
+	0xe8, 0xe8, 0xe0, 0xe0, 0xe3, 0xe8, 0xf0, 0xf2, 0xfa, 0x00, 0x00, 0x00, 0x01, 0x06, 0x06, 0x09,
+	0x09, 0x09, 0x06, 0x06, 0x06, 0x06, 0x06, 0x06, 0x01, 0x01, 0x06, 0x06, 0x09, 0x0e, 0x0e, 0x10,
+	0x10, 0x0e, 0x0e, 0x09, 0x01, 0x00, 0x00, 0x00, 0xff, 0xf7, 0xf0, 0xe8, 0xdb, 0xd1, 0xbc, 0xb4,
+	0xb2, 0xad, 0xb4, 0xba, 0xcc, 0xe0, 0xf2, 0x01, 0x06, 0x1d, 0x2f, 0x3f, 0x44, 0x4c, 0x4c, 0x4c,
+	0x44, 0x3c, 0x3c, 0x37, 0x37, 0x3c, 0x3f, 0x44, 0x4c, 0x4c, 0x4c, 0x4c, 0x4c, 0x4c, 0x46, 0x3f,
+	0x3c, 0x37, 0x2d, 0x20, 0x18, 0x0e, 0x06, 0x00, 0x00, 0x00, 0xff, 0xfa, 0xf7, 0xf2, 0xf2, 0xf0,
+	0xe8, 0xe8, 0xe3, 0xe0, 0xdb, 0xd3, 0xd3, 0xd3, 0xd1, 0xd1, 0xd1, 0xd1, 0xd1, 0xd3, 0xd3, 0xe0,
+	0xe0, 0xe8, 0xe8, 0xeb, 0xeb, 0xf0, 0xf2, 0xf2, 0xf7, 0xf7, 0xf7, 0xf2, 0xf2, 0xf0, 0xeb, 0xe8,
+	0xe3, 0xe0, 0xe3, 0xe8, 0xeb, 0xf2, 0xf7, 0xfa, 0x00, 0x00, 0x00, 0x00, 0x01, 0x06, 0x06, 0x06,
+	0x01, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x06, 0x06, 0x09, 0x0e, 0x0e,
+	0x09, 0x06, 0x00, 0x00, 0x00, 0x00, 0xff, 0xf7, 0xf2, 0xe8, 0xe0, 0xcc, 0xc4, 0xba, 0xb4, 0xad,
+	0xad, 0xb4, 0xc1, 0xd3, 0xe3, 0xf7, 0x01, 0x10, 0x25, 0x2d, 0x3f, 0x46, 0x4c, 0x4c, 0x44, 0x3f,
+	0x3c, 0x37, 0x34, 0x37, 0x3c, 0x3f, 0x44, 0x4c, 0x4c, 0x4c, 0x4c, 0x4c, 0x4c, 0x46, 0x3f, 0x3c,
+	0x2f, 0x2d, 0x20, 0x18, 0x0e, 0x09, 0x00, 0x00, 0x00, 0xff, 0xfa, 0xfa, 0xf7, 0xf2, 0xf0, 0xeb,
+	0xe8, 0xe3, 0xe0, 0xe0, 0xd9, 0xd3, 0xd3, 0xd3, 0xd1, 0xd1, 0xd1, 0xd3, 0xd3, 0xdb, 0xe0, 0xe3,
+	0xe8, 0xe8, 0xeb, 0xf0, 0xf2, 0xf7, 0xf7, 0xf7, 0xfa, 0xfa, 0xf7, 0xf7, 0xf2, 0xf0, 0xeb, 0xeb,
+	0xeb, 0xf0, 0xf0, 0xf2, 0xf7, 0xff, 0x00, 0x00, 0x01, 0x06, 0x09, 0x09, 0x0e, 0x0e, 0x0e, 0x09,
+	0x09, 0x06, 0x06, 0x01, 0x01, 0x00, 0x00, 0x01, 0x06, 0x06, 0x09, 0x0e, 0x0e, 0x0e, 0x0e, 0x09,
+	0x06, 0x00, 0x00, 0x00, 0x00, 0xff, 0xf7, 0xf0, 0xe3, 0xd1, 0xcc, 0xc1, 0xba, 0xb4, 0xb4, 0xb4,
+	0xc1, 0xc9, 0xdb, 0xf0, 0x00, 0x01, 0x18, 0x2f, 0x3f, 0x44, 0x4c, 0x4c, 0x46, 0x3f, 0x3c, 0x3c,
+	0x34, 0x2f, 0x34, 0x3c, 0x3c, 0x3f, 0x46, 0x46, 0x46, 0x46, 0x46, 0x44, 0x3f, 0x3c, 0x2f, 0x2d,
+	0x20, 0x18, 0x0e, 0x06, 0x00, 0x00, 0x00, 0xfa, 0xf7, 0xf2, 0xf2, 0xf0, 0xeb, 0xe8, 0xe3, 0xe0,
+	0xe0, 0xdb, 0xd3, 0xd3, 0xd3, 0xd1, 0xd1, 0xcc, 0xcc, 0xd1, 0xd3, 0xd3, 0xdb, 0xe0, 0xe3, 0xe8,
+	0xeb, 0xf0, 0xf2, 0xf2, 0xf7, 0xf7, 0xfa, 0xfa, 0xfa, 0xf7, 0xf2, 0xf0, 0xf0, 0xeb, 0xeb, 0xeb,
+	0xf0, 0xf2, 0xf7, 0xff, 0x00, 0x00, 0x00, 0x06, 0x09, 0x0e, 0x0e, 0x0e, 0x0e, 0x0e, 0x0e, 0x09,
+	0x09, 0x06, 0x01, 0x01, 0x01, 0x01, 0x06, 0x09, 0x0e, 0x0e, 0x10, 0x10, 0x10, 0x0e, 0x09, 0x06,
+	0x01, 0x00, 0x00, 0x00, 0xff, 0xf2, 0xeb, 0xe0, 0xd3, 0xc1, 0xc1, 0xb4, 0xb4, 0xb4, 0xba, 0xc4,
+	0xd1, 0xe3, 0xf2, 0x01, 0x09, 0x20, 0x2f, 0x3f, 0x46, 0x46, 0x46, 0x44, 0x3f, 0x3c, 0x2f, 0x2f,
+	0x2f, 0x2f, 0x37, 0x3c, 0x3f, 0x3f, 0x44, 0x46, 0x46, 0x44, 0x3f, 0x3c, 0x34, 0x2d, 0x20, 0x18,
+	0x10, 0x09, 0x00, 0x00, 0x00, 0xfa, 0xf7, 0xf2, 0xf3, 0xf1, 0xec, 0xea, 0xe6, 0xe3, 0xe3, 0xdd,
+	0xd9, 0xd9, 0xd8, 0xd4, 0xd3, 0xcf, 0xd0, 0xd4, 0xdb, 0xdc, 0xde, 0xe3, 0xe9, 0xe9, 0xef, 0xf0,
+	0xf2, 0xf6, 0xf7, 0xf7, 0xfb, 0xfb, 0xfb, 0xf8, 0xf8, 0xf7, 0xf5, 0xf5, 0xf4, 0xf4, 0xf6, 0xf8,
+	0xfa, 0xfc, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0x05, 0x05, 0x05, 0x04, 0x04, 0x04, 0x02, 0x01,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x01, 0x02, 0x03, 0x02, 0x02, 0x02, 0x01, 0x01, 0x00, 0x00,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x62, 0x62, 0x62, 0x53, 0xed, 0xd8, 0xce, 0xc4,
+	0xb5, 0xb2, 0xad, 0xbc, 0xc6, 0xcf, 0xd4, 0xd9, 0xe2, 0xea, 0xf6, 0xfc, 0x03, 0x0b, 0x18, 0x1f,
+	0x23, 0x1f, 0x15, 0x06, 0x0e, 0x5d, 0x00, 0x00, 0xf5, 0x1f, 0xe0, 0x1f, 0xdd, 0x18, 0xd7, 0xe8,
+	0xf3, 0xd8, 0x1f, 0xe0, 0x07, 0xf8, 0xdf, 0xf0, 0xef, 0xc4, 0x1f, 0xb0, 0x26, 0xd5, 0xd0, 0xcf,
+	0xd4, 0xef, 0xd0, 0xfb, 0xc0, 0x00, 0xc2, 0xd7, 0xc8, 0xd7, 0xb4, 0xff, 0x99, 0xf0, 0xbf, 0xe5,
+	0xb0, 0xcf, 0xd0, 0xee, 0xca, 0xdc, 0xaf, 0xd0, 0xdf, 0xc0, 0xef, 0x90, 0x27, 0xab, 0xce, 0xdb,
+	0xff, 0xe0, 0xd0, 0xf7, 0xeb, 0x58, 0x00, 0xd7, 0xe0, 0x5f, 0x20, 0xdf, 0x17, 0x67, 0x60, 0x20,
+	0xff, 0x5b, 0x50, 0x4a, 0x53, 0x3f, 0x7e, 0x57, 0x71, 0x44, 0x7f, 0x28, 0x7f, 0x37, 0x7f, 0x7e,
+	0x7f, 0x7c, 0x7f, 0x7f, 0x7f, 0x6f, 0x7f, 0x7f, 0x7f, 0x60, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f, 0x7f,
+	0x7f, 0x7f, 0x7f, 0x7f, 0x79, 0x7f, 0x7f, 0x7f, 0x70, 0x7f, 0x64, 0x67, 0x7f, 0x4f, 0x78, 0x3f,
+	0x48, 0x53, 0x40, 0x67, 0x16, 0x40, 0x0f, 0x14, 0x26, 0x31, 0x04, 0xe4, 0xc3, 0x18, 0xdf, 0xe0,
+	0xad, 0xdf, 0xa8, 0xd7, 0x8b, 0xb0, 0x81, 0x80, 0x95, 0x80, 0x9f, 0x80, 0x80, 0xb0, 0x80, 0x80,
+	0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+	0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80,
+	0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x83, 0x80, 0x83, 0x90, 0x83, 0xa1,
+	0x90, 0xb7, 0x90, 0xb7, 0xaf, 0xd0, 0xbf, 0xc0, 0xd7, 0xe0, 0x0f, 0xf2, 0xdf, 0x10, 0xfc, 0x15,
+	0x10, 0x09, 0x4b, 0xf0, 0x5e, 0x0f, 0x47, 0x40, 0x57, 0x40, 0x5f, 0x2c, 0x7f, 0x2f, 0x70, 0x4f,
+	0x7b, 0x60, 0x7b, 0x70, 0x53, 0x7f, 0x5f, 0x7c, 0x74, 0x7d, 0x7f, 0x64, 0x7f, 0x67, 0x77, 0x70,
+	0x7f, 0x78, 0x7d, 0x68, 0x6f, 0x7e, 0x67, 0x70, 0x6f, 0x60, 0x73, 0x50, 0x65, 0x64, 0x5e, 0x60,
+	0x44, 0x67, 0x40, 0x53, 0x5e, 0x2f, 0x4a, 0x38, 0x43, 0x38, 0x36, 0x36, 0x42, 0x1f, 0x38, 0x26,
+	0x2d, 0x10, 0x3f, 0x00, 0x39, 0x18, 0x13, 0x18, 0x0e, 0x1d, 0x02, 0x27, 0x12, 0x12, 0x0b, 0x1d,
+	0x04, 0x1f, 0xe8, 0x3f, 0x00, 0x17, 0x14, 0x0f, 0x09, 0x1f, 0x18, 0x0a, 0x14, 0x0f, 0x22, 0x14,
+	0x2b, 0x20, 0x1b, 0x2f, 0x0f, 0x30, 0x1f, 0x30, 0x31, 0x2a, 0x37, 0x44, 0x24, 0x3f, 0x40, 0x3f,
+	0x30, 0x4f, 0x48, 0x3d, 0x57, 0x44, 0x3f, 0x50, 0x4f, 0x50, 0x5f, 0x40, 0x7c, 0x3f, 0x58, 0x4f,
+	0x65, 0x5c, 0x50, 0x69, 0x49, 0x60, 0x5d, 0x5e, 0x50, 0x5f, 0x48, 0x5f, 0x5d, 0x4f, 0x60, 0x47,
+	0x50, 0x55, 0x38, 0x6f, 0x23, 0x68, 0x3f, 0x40, 0x4b, 0x28, 0x5f, 0x20, 0x46, 0x2f, 0x37, 0x2c,
+	0x33, 0x22, 0x1f, 0x23, 0x2c, 0x17, 0x20, 0x15, 0x0a, 0x17, 0x08, 0x11, 0x0a, 0x03, 0x00, 0xfb,
+	0x01, 0xf0, 0xff, 0xf0, 0xef, 0xee, 0xeb, 0xea, 0xe7, 0xe0, 0xef, 0xd9, 0xde, 0xe0, 0xdb, 0xde,
+	0xd6, 0xdf, 0xc0, 0xeb, 0xc5, 0xd7, 0xd4, 0xca, 0xd5, 0xc9, 0xdd, 0xc0, 0xe4, 0xbf, 0xd1, 0xc4,
+	0xcf, 0xc4, 0xcf, 0xc0, 0xda, 0xc5, 0xd1, 0xc2, 0xcd, 0xc2, 0xcd, 0xc1, 0xc7, 0xca, 0xc6, 0xc8,
+	0xcf, 0xc0, 0xda, 0xc3, 0xc9, 0xcc, 0xc7, 0xc2, 0xc7, 0xc4, 0xcc, 0xbc, 0xc8, 0xc4, 0xc3, 0xc2,
+	0xc3, 0xbd, 0xc3, 0xc1, 0xc2, 0xbe, 0xc0, 0xbf, 0xbe, 0xba, 0xc4, 0xb0, 0xc4, 0xb5, 0xb8, 0xb9,
+	0xb8, 0xb3, 0xb6, 0xb2, 0xb3, 0xad, 0xb2, 0xb5, 0xac, 0xab, 0xb7, 0xa1, 0xb9, 0xa5, 0xa8, 0xa7,
+	0xa9, 0xab, 0xa2, 0xa9, 0xa5, 0xa6, 0xa2, 0xa5, 0xa3, 0xa9, 0x9c, 0xb5, 0x9b, 0xa7, 0xa4, 0xa5,
+	0xa2, 0xab, 0xa9, 0xa2, 0xab, 0xa9, 0xa8, 0xae, 0xac, 0xaa, 0xb9, 0x9c, 0xc6, 0x9f, 0xc0, 0xb3,
+	0xb9, 0xb5, 0xc2, 0xb1, 0xc4, 0xbf, 0xc0, 0xc3, 0xc0, 0xce, 0xc6, 0xc7, 0xce, 0xd0, 0xcf, 0xd8,
+	0xd9, 0xde, 0xd9, 0xe3, 0xe4, 0xe2, 0xe7, 0xea, 0xe7, 0xef, 0xed, 0xf3, 0xef, 0xf8, 0xf7, 0xf6,
+	0xff, 0xf9, 0x07, 0xf5, 0x10, 0x03, 0x09, 0x0b, 0x11, 0x0b, 0x11, 0x11, 0x11, 0x16, 0x15, 0x19,
+	0x19, 0x18, 0x21, 0x12, 0x24, 0x1e, 0x23, 0x20, 0x27, 0x22, 0x1c, 0x30, 0x21, 0x28, 0x29, 0x22,
+	0x2d, 0x28, 0x27, 0x2b, 0x2e, 0x2b, 0x26, 0x2c, 0x2c, 0x2a, 0x2e, 0x23, 0x36, 0x27, 0x30, 0x28,
+	0x2d, 0x28, 0x29, 0x33, 0x25, 0x30, 0x29, 0x29, 0x2b, 0x2a, 0x2b, 0x21, 0x31, 0x27, 0x2a, 0x27,
+	0x24, 0x2f, 0x1d, 0x2a, 0x27, 0x25, 0x24, 0x23, 0x27, 0x20, 0x27, 0x24, 0x24, 0x23, 0x25, 0x20,
+	0x2b, 0x20, 0x2b, 0x1e, 0x21, 0x1f, 0x23, 0x1e, 0x27, 0x18, 0x29, 0x1b, 0x21, 0x1e, 0x1e, 0x1d,
+	0x20, 0x12, 0x24, 0x0e, 0x22, 0x13, 0x19, 0x15, 0x15, 0x15, 0x14, 0x15, 0x19, 0x11, 0x17, 0x12,
+	0x16, 0x0f, 0x14, 0x10, 0x14, 0x12, 0x11, 0x10, 0x10, 0x0f, 0x11, 0x10, 0x0f, 0x10, 0x0f, 0x0e,
+	0x0d, 0x0d, 0x0c, 0x0c, 0x0b, 0x0a, 0x0a, 0x09, 0x08, 0x07, 0x07, 0x06, 0x05, 0x04, 0x04, 0x03,
+	0x02, 0x00, 0x00, 0x00, 0x0d, 0xe8, 0x3b, 0xc7, 0x10, 0xff, 0xf0, 0xff, 0xe0, 0x1f, 0xc8, 0x38,
+	0xdf, 0x0c, 0xec, 0xff, 0xe8, 0x17, 0xc4, 0x40, 0xbf, 0x20, 0xff, 0xf0, 0x0f, 0xe8, 0x1f, 0xdf,
+	0x10, 0xff, 0x00, 0xe7, 0x0d, 0xe4, 0x0d, 0xcf, 0x20, 0xef, 0x00, 0xfb, 0x10, 0xd0, 0x1f, 0xef,
+	0x00, 0xff, 0xf0, 0x1f, 0xc0, 0x3f, 0xa9, 0x40, 0xbf, 0x20, 0xff, 0x08, 0xe8, 0x17, 0xdc, 0xfb,
+	0x08, 0xef, 0x08, 0xe8, 0x37, 0xc0, 0x3f, 0xdb, 0x06, 0xfc, 0xfb, 0x0f, 0xf0, 0xf7, 0x1f, 0xd7,
+	0x0d, 0x00, 0xff, 0xf0, 0xfb, 0x0e, 0xc0, 0x40, 0xbf, 0x00, 0xff, 0x10, 0xd2, 0x27, 0xd3, 0x2c,
+	0xef, 0x00, 0x17, 0xe0, 0xff, 0x1d, 0xc4, 0x0e, 0x00, 0xff, 0x00, 0xff, 0x04, 0xf7, 0xfe, 0xfb,
+	0x08, 0xf7, 0x07, 0x04, 0xfc, 0xf8, 0x25, 0xdf, 0xff, 0x00, 0xff, 0xe0, 0xff, 0x00, 0x02, 0xef,
+	0x20, 0xf7, 0xf4, 0x0f, 0x00, 0xfe, 0x05, 0xff, 0x00, 0xff, 0x00, 0x0b, 0x00, 0xfb, 0x1e, 0xcf,
+	0x10, 0xf7, 0xf2, 0xff, 0xec, 0x1f, 0xcb, 0x28, 0x00, 0xff, 0xf0, 0xff, 0x00, 0x06, 0x11, 0xfb,
+	0x00, 0xfb, 0x00, 0x0b, 0xf0, 0x2b, 0xc0, 0x2c, 0xff, 0x00, 0x0b, 0xf0, 0xff, 0x15, 0xcc, 0x40,
+	0xed, 0x07, 0xf0, 0x1f, 0xf0, 0x09, 0xf1, 0x0a, 0x00, 0xf0, 0xf9, 0x02, 0xfc, 0x09, 0x05, 0xef,
+	0x10, 0xff, 0x03, 0xff, 0xf8, 0x1b, 0xd3, 0x14, 0xdf, 0x09, 0xf4, 0xff, 0x04, 0xe0, 0x27, 0xe3,
+	0x04, 0xff, 0xf4, 0x13, 0xe0, 0x1f, 0xe3, 0x20, 0xf6, 0xff, 0x08, 0xee, 0x0f, 0xf4, 0xf7, 0x20,
+	0xdf, 0x10, 0xfb, 0x08, 0xec, 0x1f, 0xe3, 0x18, 0xef, 0x00, 0x0b, 0x00, 0xfb, 0x1b, 0xe4, 0x14,
+	0x00, 0xff, 0x00, 0xff, 0xfa, 0xfd, 0x05, 0x08, 0xfb, 0x00, 0xf2, 0x0f, 0xe8, 0x25, 0xe5, 0x0c,
+	0xf9, 0x07, 0xf8, 0x0b, 0xf0, 0x12, 0xef, 0x10, 0xef, 0x05, 0x00, 0x05, 0x04, 0xe4, 0x22, 0xe7,
+	0x0f, 0x00, 0xff, 0x02, 0xf8, 0x0f, 0xf9, 0xfe, 0x07, 0x00, 0x07, 0x01, 0xf8, 0x12, 0xe6, 0x28,
+	0xdf, 0x0a, 0xf7, 0x06, 0xf8, 0xfc, 0x0e, 0xef, 0x10, 0x06, 0x00, 0xfc, 0x0f, 0xe2, 0x22, 0xdf,
+	0x20, 0xf7, 0x0d, 0x00, 0x0b, 0xec, 0x14, 0xf9, 0xfb, 0xfc, 0xff, 0x08, 0xf0, 0x17, 0xf7, 0xff,
+	0x00, 0xfb, 0xf6, 0x07, 0xfa, 0x05, 0xf1, 0x06, 0x00, 0xfa, 0x0f, 0xe8, 0x1f, 0xd4, 0x2e, 0xf8,
+	0xf7, 0x04, 0xf4, 0x17, 0xe8, 0x0b, 0xf5, 0x08, 0xff, 0x00, 0xf7, 0xf8, 0xf9, 0x0f, 0xfc, 0xfb,
+	0x04, 0xfc, 0xfd, 0x02, 0x01, 0xf8, 0x10, 0xef, 0x05, 0xfa, 0x0f, 0xf0, 0xff, 0xf6, 0x08, 0xef,
+	0x10, 0xff, 0x00, 0x17, 0xe0, 0x2b, 0xcf, 0x28, 0xef, 0x04, 0xfe, 0x07, 0xf8, 0x13, 0xf9, 0xfd,
+	0x05, 0x00, 0x07, 0xfa, 0x03, 0xf0, 0x10, 0xfb, 0x04, 0x00, 0x06, 0xf8, 0xff, 0x04, 0xf5, 0x08,
+	0xfb, 0xfe, 0xfe, 0x03, 0xff, 0x00, 0xf7, 0x08, 0xf9, 0x03, 0x00, 0x02, 0x00, 0xfb, 0x09, 0xf1,
+	0x07, 0xf8, 0x05, 0xfc, 0x00, 0x04, 0xfa, 0x05, 0x00, 0x01, 0x00, 0x00, 0xfc, 0x06, 0xfa, 0x02,
+	0x00, 0x00, 0x00, 0x01, 0xfc, 0x04, 0xfd, 0x00, 0xff, 0x00, 0xff, 0x00, 0x00, 0x02, 0xfc, 0x03,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x02, 0x09, 0x0d, 0xff, 0x08, 0xff, 0x00,
+	0x17, 0x00, 0x27, 0xdb, 0x20, 0xff, 0x08, 0xff, 0x14, 0x20, 0xd8, 0x9b, 0xff, 0x2f, 0x20, 0xdf,
+	0x90, 0xff, 0x08, 0xfc, 0xa7, 0xc8, 0xdb, 0xe0, 0xef, 0xb0, 0xcf, 0x80, 0x8f, 0xff, 0x1f, 0xc0,
+	0x87, 0x80, 0xd7, 0xaf, 0x0f, 0x00, 0xb7, 0xa4, 0xb7, 0xdb, 0x2d, 0xf7, 0x50, 0xd7, 0x00, 0xbf,
+	0xd0, 0x7f, 0x58, 0x4a, 0x00, 0x1f, 0x40, 0x2b, 0x7f, 0x70, 0x7f, 0x10, 0xef, 0x7f, 0x7f, 0x10,
+	0x5f, 0x7f, 0x7f, 0x30, 0x2b, 0x5f, 0x7f, 0x68, 0x47, 0x6f, 0x58, 0x7f, 0x04, 0x2b, 0x30, 0x7f,
+	0x40, 0x5f, 0xc8, 0x38, 0x08, 0xff, 0x40, 0x1f, 0xf0, 0xfb, 0xc0, 0x48, 0x9f, 0xf0, 0xc0, 0xaf,
+	0xc5, 0xd0, 0x0d, 0x80, 0x9f, 0xbf, 0xb6, 0x96, 0xb3, 0x80, 0x80, 0xc5, 0xd0, 0x80, 0x80, 0x88,
+	0xc5, 0xc0, 0x80, 0x80, 0x80, 0xbf, 0xe7, 0xb4, 0xbf, 0x80, 0x8f, 0x80, 0xae, 0xbb, 0xdb, 0xba,
+	0xa3, 0xa3, 0x0f, 0xe7, 0x10, 0xe3, 0x17, 0x04, 0x1f, 0x00, 0x25, 0x04, 0x30, 0x3f, 0x48, 0x4f,
+	0x74, 0x40, 0x7f, 0x00, 0x7e, 0x5f, 0x7f, 0x70, 0x6b, 0x5a, 0x7f, 0x66, 0x68, 0x3f, 0x50, 0x7f,
+	0x7f, 0x70, 0x40, 0x3c, 0x0f, 0x4f, 0x40, 0x5f, 0x40, 0x1f, 0x18, 0xeb, 0x40, 0xe7, 0xf4, 0xff,
+	0xfa, 0xda, 0xb9, 0xdf, 0xe4, 0xd0, 0xba, 0x98, 0xa7, 0x8c, 0xd7, 0xc2, 0xb8, 0xaf, 0xa8, 0xa7,
+	0x88, 0xaf, 0xd7, 0xa6, 0xb0, 0xa0, 0xbf, 0xd8, 0xcf, 0xb8, 0xcb, 0xb7, 0x00, 0xbf, 0x00, 0xff,
+	0x14, 0xe2, 0xff, 0xf0, 0xff, 0x26, 0x15, 0x3b, 0x00, 0x2f, 0xe0, 0x2f, 0x55, 0x47, 0x20, 0x2f,
+	0x20, 0x3f, 0xe0, 0x56, 0x21, 0x37, 0x40, 0x5f, 0x50, 0x00, 0x27, 0x03, 0x44, 0x35, 0x35, 0x2d,
+	0x0c, 0x2b, 0x04, 0x0f, 0x10, 0xff, 0x20, 0x17, 0x00, 0xf7, 0x0f, 0xf7, 0x10, 0xeb, 0xf9, 0xfb,
+	0xd8, 0xe9, 0xf6, 0xe7, 0xf4, 0xe4, 0xeb, 0xd0, 0xd7, 0xef, 0xd4, 0xfc, 0xc8, 0xcf, 0xe3, 0xdb,
+	0xdb, 0xdf, 0xc1, 0xf1, 0xef, 0xe0, 0xc8, 0xef, 0xc0, 0xe7, 0xe5, 0xd7, 0xec, 0xef, 0xff, 0xe4,
+	0xdf, 0xdf, 0xf4, 0xf2, 0xff, 0x18, 0x0f, 0xe0, 0xff, 0x00, 0x23, 0x30, 0x13, 0x04, 0x17, 0x2e,
+	0x08, 0x0f, 0x19, 0x43, 0x40, 0x13, 0x0c, 0x1f, 0x3f, 0x18, 0x45, 0x25, 0x20, 0xff, 0x33, 0x45,
+	0x36, 0x12, 0x2b, 0x34, 0xeb, 0x00, 0x1f, 0x38, 0x08, 0x09, 0x06, 0xef, 0x10, 0xef, 0x00, 0xff,
+	0xe8, 0xff, 0xc4, 0xf4, 0xd7, 0xf7, 0x00, 0xd4, 0xc5, 0xb7, 0xef, 0xf5, 0xe2, 0xd4, 0xe7, 0xd4,
+	0xdf, 0xeb, 0xde, 0xf0, 0xaf, 0xdb, 0xff, 0x10, 0xed, 0xa2, 0x1f, 0x01, 0x10, 0xbf, 0xf0, 0xff,
+	0xf8, 0x2b, 0x1a, 0x12, 0xf0, 0xd7, 0xfa, 0x25, 0x3e, 0x32, 0xec, 0xfe, 0xfb, 0x0a, 0x27, 0x24,
+	0x03, 0xff, 0x11, 0x1e, 0xfb, 0x1b, 0x24, 0x14, 0xec, 0xe7, 0xff, 0x13, 0x1a, 0x0e, 0x00, 0xf7,
+	0x00, 0x11, 0x0a, 0xf3, 0x0f, 0x05, 0xf1, 0xe9, 0x1b, 0xf0, 0xf3, 0x1f, 0x1d, 0x00, 0xd0, 0xf7,
+	0x0c, 0x1f, 0x04, 0x16, 0x00, 0xf2, 0xf4, 0xff, 0xf8, 0x13, 0xf9, 0xff, 0xf8, 0xff, 0x00, 0xf1,
+	0xee, 0xff, 0x15, 0x06, 0x00, 0xd0, 0xd8, 0xff, 0x08, 0x06, 0xfe, 0xe1, 0xe8, 0xe3, 0xee, 0x0b,
+	0x08, 0xe0, 0xdf, 0xff, 0xf0, 0xdd, 0xf4, 0x0f, 0x0c, 0xf8, 0xe9, 0xfc, 0xfb, 0x0b, 0x12, 0x14,
+	0xf4, 0xff, 0xf2, 0x16, 0x17, 0x30, 0x10, 0x03, 0x08, 0xfa, 0xf1, 0x0f, 0x1f, 0x3f, 0x24, 0x00,
+	0xe8, 0x0f, 0x06, 0x10, 0x07, 0x21, 0x1e, 0x08, 0xf3, 0xe9, 0xff, 0x0f, 0x0c, 0x00, 0xff, 0xfa,
+	0x03, 0xec, 0xfd, 0x08, 0xef, 0x04, 0x0d, 0xec, 0xe1, 0xff, 0x05, 0xf4, 0xd3, 0xf7, 0x27, 0x24,
+	0xd8, 0xdf, 0xd0, 0x1c, 0xe8, 0xdd, 0xfb, 0x0f, 0xf6, 0x0f, 0x00, 0xf5, 0xfb, 0xf0, 0xef, 0x02,
+	0xfd, 0xfb, 0x06, 0x17, 0x10, 0xe5, 0x1b, 0x20, 0x04, 0xf5, 0xf4, 0xfb, 0x0f, 0x27, 0x2d, 0x00,
+	0xdb, 0xec, 0x19, 0x0c, 0xf0, 0xef, 0x1b, 0x1e, 0xec, 0xfb, 0xfb, 0x02, 0xf5, 0xf7, 0xed, 0xfa,
+	0x0e, 0x14, 0xf4, 0xcf, 0xf7, 0x0b, 0x00, 0xf1, 0xea, 0xf7, 0xed, 0xff, 0x20, 0x02, 0xe0, 0xbf,
+	0xff, 0x30, 0x36, 0x08, 0xd4, 0xdf, 0x0f, 0x24, 0x20, 0x10, 0x08, 0xf2, 0xe7, 0x0b, 0x23, 0x20,
+	0xe8, 0xff, 0x35, 0x30, 0xe0, 0xd7, 0x17, 0x31, 0x24, 0x0c, 0x06, 0xde, 0xeb, 0x1f, 0x2c, 0x04,
+	0xf6, 0x10, 0xf0, 0xe9, 0xf7, 0x04, 0x1f, 0x00, 0xde, 0xdd, 0xf3, 0x1a, 0xf8, 0xf4, 0xf0, 0xf7,
+	0x00, 0xf0, 0xef, 0xf7, 0xf0, 0xef, 0x0c, 0x12, 0xf8, 0xea, 0xed, 0xeb, 0xf1, 0xff, 0x00, 0x07,
+	0x15, 0x16, 0xf2, 0xf0, 0xe5, 0xec, 0xf7, 0x23, 0x18, 0x11, 0xdc, 0xe4, 0xef, 0x1b, 0x09, 0xf0,
+	0xec, 0xf9, 0xfa, 0x0b, 0x10, 0xfc, 0xf2, 0xfb, 0xfe, 0x00, 0xed, 0xef, 0x1f, 0x20, 0xf5, 0xf2,
+	0xff, 0xfd, 0xff, 0x0b, 0x27, 0x10, 0xd8, 0xef, 0x1f, 0x20, 0x05, 0xff, 0x0f, 0x0a, 0xf8, 0xf3,
+	0x0f, 0x31, 0x30, 0xf8, 0xeb, 0x1f, 0x44, 0x20, 0xe9, 0xf3, 0x03, 0x08, 0x0f, 0x1f, 0x15, 0x00,
+	0xfc, 0xfd, 0xf7, 0xf9, 0xfd, 0x03, 0x09, 0x01, 0xf7, 0xff, 0x02, 0xee, 0xef, 0x07, 0xfc, 0xea,
+	0xf3, 0x0c, 0x00, 0xd8, 0xdf, 0xff, 0xfe, 0xfa, 0xfc, 0xf6, 0xe4, 0xe5, 0xfb, 0x07, 0x00, 0xf9,
+	0xea, 0xe7, 0xff, 0x04, 0xe8, 0xf9, 0x1b, 0x18, 0x04, 0xeb, 0xdf, 0xf3, 0x1f, 0x39, 0x10, 0xd2,
+	0xdf, 0x13, 0x28, 0x10, 0xfb, 0xfd, 0x07, 0x15, 0x11, 0x06, 0xff, 0x02, 0x03, 0x07, 0x0a, 0x0c,
+	0x04, 0xff, 0x0a, 0x0c, 0xf0, 0xeb, 0x17, 0x1e, 0x08, 0xf0, 0xf3, 0x0d, 0x08, 0xe4, 0xf7, 0x15,
+	0x0a, 0xf5, 0xef, 0xf7, 0x05, 0xf8, 0xf5, 0x01, 0xfc, 0xf7, 0xfb, 0xf8, 0xf3, 0xff, 0x0e, 0x06,
+	0xe9, 0xdd, 0xf9, 0x13, 0x08, 0xf0, 0xe7, 0xf4, 0xfe, 0x06, 0x0c, 0xfc, 0xf6, 0xff, 0xfc, 0xe5,
+	0xe7, 0x0f, 0x1c, 0x04, 0xf0, 0xf9, 0xf7, 0xf5, 0xfb, 0x0c, 0x05, 0xf4, 0xf7, 0xff, 0xfa, 0xf4,
+	0xfd, 0xfe, 0x07, 0x08, 0xf4, 0xf5, 0x01, 0x0d, 0x04, 0xf3, 0xf3, 0xff, 0x06, 0x05, 0x03, 0x09,
+	0x00, 0xf4, 0xff, 0x08, 0x00, 0x07, 0x15, 0x0d, 0x02, 0xf0, 0xf1, 0x0b, 0x11, 0x03, 0xfa, 0xfa,
+	0x06, 0x04, 0xf7, 0xfb, 0x02, 0x0b, 0x00, 0xee, 0xf5, 0x02, 0xfe, 0xf4, 0xfa, 0xf8, 0xf1, 0xf0,
+	0xf6, 0xfb, 0x00, 0x00, 0xea, 0xda, 0xf2, 0x14, 0x04, 0xe5, 0xeb, 0xf9, 0xfd, 0xfc, 0xf3, 0xf5,
+	0xf6, 0xf2, 0xff, 0x02, 0xf6, 0xf4, 0xff, 0x08, 0x01, 0xf8, 0xf3, 0xfb, 0x03, 0x0a, 0x02, 0xf9,
+	0xfc, 0x04, 0x00, 0xff, 0x07, 0x0a, 0x04, 0xf4, 0xea, 0xfc, 0x00, 0x00, 0x73, 0x20, 0x68, 0x6e,
+	0x74, 0x65, 0x64, 0x72, 0x77, 0x73, 0x61, 0x64, 0x64, 0x74, 0x20, 0x68, 0x20, 0x61, 0x68, 0x62,
+	0x74, 0x74, 0x65, 0x64, 0x72, 0x63, 0x6f, 0x79, 0x77, 0x73, 0x27, 0x20, 0x70, 0x6e, 0x64, 0x0a,
+	0x20, 0x68, 0x73, 0x69, 0x20, 0x6f, 0x20, 0x69, 0x65, 0x2e, 0x2d, 0x50, 0x69, 0x74, 0x6f, 0x67,
+	0x6e, 0x77, 0x70, 0x69, 0x74, 0x20, 0x68, 0x20, 0x61, 0x74, 0x72, 0x6c, 0x73, 0x20, 0x69, 0x68,
+	0x64, 0x63, 0x6d, 0x6c, 0x76, 0x6c, 0x65, 0x2e, 0x49, 0x20, 0x72, 0x6e, 0x73, 0x61, 0x6c, 0x20,
+	0x70, 0x74, 0x65, 0x6e, 0x2e, 0x4f, 0x64, 0x72, 0x76, 0x72, 0x69, 0x6e, 0x20, 0x66, 0x50, 0x20,
+	0x69, 0x73, 0x64, 0x74, 0x20, 0x72, 0x6e, 0x20, 0x68, 0x20, 0x69, 0x68, 0x73, 0x20, 0x61, 0x74,
+	0x72, 0x29, 0x2d, 0x49, 0x68, 0x76, 0x20, 0x6d, 0x6c, 0x6d, 0x6e, 0x65, 0x20, 0x20, 0x68, 0x72,
+	0x20, 0x61, 0x65, 0x2e, 0x2d, 0x49, 0x68, 0x76, 0x20, 0x6d, 0x6c, 0x6d, 0x6e, 0x65, 0x20, 0x20,
+	0x6f, 0x69, 0x69, 0x6e, 0x44, 0x68, 0x6e, 0x2d, 0x2c, 0x4e, 0x1f, 0x63, 0x72, 0x70, 0x2b, 0x47,
+	0x65, 0x72, 0x1f, 0x5d, 0x61, 0x25, 0x68, 0x67, 0x64, 0x5c, 0x66, 0x68, 0x25, 0x1e, 0x63, 0x5f,
+	0x62, 0x1e, 0x4e, 0x2b, 0x2a, 0x29, 0x4a, 0x66, 0x69, 0x5b, 0x5d, 0x1d, 0x59, 0x1d, 0x69, 0x68,
+	0x64, 0x63, 0x68, 0x67, 0x5b, 0x29, 0x4e, 0x5a, 0x1c, 0x5c, 0x1c, 0x4a, 0x65, 0x64, 0x59, 0x1c,
+	0x64, 0x5b, 0x1b, 0x63, 0x5f, 0x1b, 0x58, 0x53, 0x5d, 0x5f, 0x59, 0x61, 0x1b, 0x61, 0x60, 0x55,
+	0x61, 0x1a, 0x46, 0x63, 0x5a, 0x1a, 0x50, 0x53, 0x61, 0x26, 0x25, 0x45, 0x52, 0x50, 0x5a, 0x5d,
+	0x1a, 0x5e, 0x50, 0x54, 0x58, 0x54, 0x19, 0x58, 0x53, 0x53, 0x4f, 0x5b, 0x19, 0x4d, 0x19, 0x52,
+	0x4e, 0x3e, 0x26, 0x27, 0x18, 0x59, 0x58, 0x55, 0x57, 0x58, 0x4c, 0x4b, 0x56, 0x4c, 0x57, 0x18,
+	0x4a, 0x58, 0x49, 0x55, 0x47, 0x49, 0x49, 0x54, 0x50, 0x4f, 0x53, 0x07, 0x17, 0x49, 0x57, 0x54,
+	0x4a, 0x4d, 0x16, 0x49, 0x16, 0x4a, 0x49, 0x16, 0x4d, 0x50, 0x44, 0x50, 0x4c, 0x15, 0x43, 0x44,
+	0x12, 0x43, 0x42, 0x3a, 0x37, 0x3c, 0x32, 0x2f, 0x34, 0x34, 0x2c, 0x2a, 0x28, 0x0c, 0x0b, 0x24,
+	0x23, 0x22, 0x23, 0x09, 0x1f, 0x1b, 0x07, 0x18, 0x06, 0x15, 0x14, 0x11, 0x0e, 0x0d, 0x0b, 0x0a,
+	0x09, 0x06, 0x05, 0x05,
+}
@@ -0,0 +1,25 @@
+package output
+
+import (
+	"github.com/gotracker/gomixing/mixing"
+	"github.com/gotracker/gomixing/volume"
+
+	"github.com/gotracker/playback/instrument"
+)
+
+// PremixData is a structure containing the audio pre-mix data for a specific row or buffer
+type PremixData struct {
+	SamplesLen  int
+	Data        []mixing.ChannelData
+	Sources     []PremixSource // Sources describes the entry at the same index in Data
+	MixerVolume volume.Volume
+	Userdata    interface{}
+}
+
+// PremixSource describes where an entry in the premix data was rendered from
+type PremixSource struct {
+	// Channel is the song channel number, or -1 when the data does not come from a song channel (such as OPL2 output)
+	Channel int
+	// Instruments holds the instrument of each mixing.Data in the entry, in the same order
+	Instruments []instrument.ID
+}
@@ -0,0 +1,35 @@
+package period
+
+import "sync"
+
+// maxCachedPeriods is how many periods of one type a Cache keeps at most
+const maxCachedPeriods = 1 << 14
+
+// Cache keeps the periods of one type that have been turned into a Period, so that a period that comes up again,
+// such as on every loop of a slide or a vibrato, is not boxed into a new interface value
+// It is safe to use from several goroutines at once.
+type Cache[T interface {
+	comparable
+	Period
+}] struct {
+	mu      sync.Mutex
+	periods map[T]Period
+}
+
+// Get returns `p` as a Period
+func (c *Cache[T]) Get(p T) Period {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if cp, ok := c.periods[p]; ok {
+		return cp
+	}
+	var cp Period = p
+	if len(c.periods) < maxCachedPeriods {
+		if c.periods == nil {
+			c.periods = make(map[T]Period)
+		}
+		c.periods[p] = cp
+	}
+	return cp
+}
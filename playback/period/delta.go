@@ -0,0 +1,5 @@
+package period
+
+// Delta is an amount of delta understood by the Period
+// It is the same type as PeriodDelta, so that passing one around does not box it into an interface.
+type Delta = PeriodDelta
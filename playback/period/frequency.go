@@ -0,0 +1,4 @@
+package period
+
+// Frequency is a frequency value, in Hertz (Hz)
+type Frequency float64
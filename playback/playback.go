@@ -0,0 +1,62 @@
+package playback
+
+import (
+	"time"
+
+	"github.com/gotracker/gomixing/volume"
+
+	"github.com/gotracker/playback/index"
+	"github.com/gotracker/playback/instrument"
+	"github.com/gotracker/playback/note"
+	"github.com/gotracker/playback/output"
+	"github.com/gotracker/playback/pattern"
+	"github.com/gotracker/playback/period"
+	"github.com/gotracker/playback/player/feature"
+	"github.com/gotracker/playback/player/sampler"
+	"github.com/gotracker/playback/song"
+	"github.com/gotracker/playback/voice/interpolation"
+	"github.com/gotracker/playback/voice/render"
+)
+
+// Playback is an interface for rendering a song to output data
+type Playback interface {
+	SetupSampler(samplesPerSecond int, channels int) error
+	GetSampleRate() period.Frequency
+	GetOPL2Chip() render.OPL2Chip
+	GetGlobalVolume() volume.Volume
+	SetGlobalVolume(volume.Volume)
+	GetMixerVolume() volume.Volume
+	GetSampler() *sampler.Sampler
+
+	Update(time.Duration, chan<- *output.PremixData) error
+	Generate(time.Duration) (*output.PremixData, error)
+
+	GetSongData() song.Data
+	GetInstrumentNote(int, note.Semitone) (*instrument.Instrument, period.Period)
+	GetPatternData(index.Pattern) [][]song.ChannelData
+
+	GetNumChannels() int
+	GetNumOrders() int
+	GetCurrentOrder() index.Order
+	SetNextOrder(index.Order) error
+	SetNextRow(index.Row) error
+	SetNextRowWithBacktrack(index.Row, bool) error
+	Seek(index.Order, index.Row) error
+	GetCurrentRow() index.Row
+	SetTempoScale(float64)
+	GetTempoScale() float64
+	SetTranspose(float64)
+	GetTranspose() float64
+	SetSpeedRampTime(time.Duration)
+	SetInterpolation(interpolation.Mode)
+	GetInterpolation() interpolation.Mode
+	Configure([]feature.Feature) error
+	GetName() string
+	CanOrderLoop() bool
+	BreakOrder() error
+	SetOnEffect(func(Effect))
+	GetOnEffect() func(Effect)
+	IgnoreUnknownEffect() bool
+
+	StartPatternTransaction() *pattern.RowUpdateTransaction
+}
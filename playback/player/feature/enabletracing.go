@@ -0,0 +1,5 @@
+package feature
+
+type EnableTracing struct {
+	Filename string
+}
@@ -0,0 +1,4 @@
+package feature
+
+// Feature is an interface for player features that can be optionally modified by the user and/or disabled by an output device
+type Feature any
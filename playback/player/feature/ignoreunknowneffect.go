@@ -0,0 +1,6 @@
+package feature
+
+// IgnoreUnknownEffect describes a bypass/ignore of unknown effects
+type IgnoreUnknownEffect struct {
+	Enabled bool
+}
@@ -0,0 +1,8 @@
+package feature
+
+import "github.com/gotracker/playback/voice/interpolation"
+
+// Interpolation sets how the player resamples instruments to the output rate
+type Interpolation struct {
+	Mode interpolation.Mode
+}
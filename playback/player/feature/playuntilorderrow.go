@@ -0,0 +1,6 @@
+package feature
+
+type PlayUntilOrderAndRow struct {
+	Order int
+	Row   int
+}
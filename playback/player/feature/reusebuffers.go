@@ -0,0 +1,8 @@
+package feature
+
+// ReuseBuffers makes the player render every tick into the buffers of the tick before it, so that rendering does
+// not allocate once the buffers have grown
+// The premix data returned by Generate is then only valid until Generate is called again.
+type ReuseBuffers struct {
+	Enabled bool
+}
@@ -0,0 +1,5 @@
+package feature
+
+type SetDefaultBPM struct {
+	BPM int
+}
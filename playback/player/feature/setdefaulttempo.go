@@ -0,0 +1,5 @@
+package feature
+
+type SetDefaultTempo struct {
+	Tempo int
+}
@@ -0,0 +1,6 @@
+package feature
+
+// SongLoop is a setting for enabling or disabling the song looping
+type SongLoop struct {
+	Count int
+}
@@ -0,0 +1,250 @@
+// Package jam plays the instruments of songs on their own, outside of their patterns, the way a tracker plays
+// them from the keyboard
+package jam
+
+import (
+	"errors"
+	"time"
+
+	"github.com/gotracker/gomixing/mixing"
+	"github.com/gotracker/gomixing/panning"
+	"github.com/gotracker/gomixing/volume"
+
+	"github.com/gotracker/playback"
+	"github.com/gotracker/playback/instrument"
+	"github.com/gotracker/playback/note"
+	"github.com/gotracker/playback/period"
+	"github.com/gotracker/playback/player/render"
+	"github.com/gotracker/playback/player/state"
+	voiceImpl "github.com/gotracker/playback/player/voice"
+	"github.com/gotracker/playback/voice"
+	"github.com/gotracker/playback/voice/interpolation"
+)
+
+var (
+	// ErrNoInstrument is for when a song does not have the instrument asked for, or the instrument does not
+	// play the note asked for
+	ErrNoInstrument = errors.New("no such instrument or note")
+	// ErrUnsupportedInstrument is for instruments that can only play as part of their song, such as OPL2 ones
+	ErrUnsupportedInstrument = errors.New("instrument cannot play on its own")
+)
+
+// DefaultTickDuration is how often envelopes and auto-vibrato move on, which is the tick length of a song
+// at tempo 125
+const DefaultTickDuration = 20 * time.Millisecond
+
+// Note is a note of an instrument that a jam plays
+type Note struct {
+	active        state.Active
+	output        render.Channel
+	samplerSpeed  float32
+	interpolation interpolation.Mode
+}
+
+// Release lets go of the key that plays the note
+// Instruments with a volume envelope move on to its release and fade out, and other ones stop.
+func (n *Note) Release() {
+	v := n.active.Voice
+	if v == nil {
+		return
+	}
+	v.Release()
+	if voice.IsVolumeEnvelopeEnabled(v) {
+		v.Fadeout()
+		return
+	}
+	n.Cut()
+}
+
+// Cut stops the note straight away
+func (n *Note) Cut() {
+	n.active.Period = nil
+}
+
+// IsDone returns true once the note has stopped
+func (n *Note) IsDone() bool {
+	return n.active.Period == nil || n.active.Voice == nil || n.active.Voice.IsDone()
+}
+
+// Volume returns the volume of the note, from 0 to 1
+func (n *Note) Volume() volume.Volume {
+	return n.active.Volume
+}
+
+// SetVolume sets the volume of the note, from 0 to 1, which starts at the default volume of the instrument
+func (n *Note) SetVolume(v volume.Volume) {
+	n.active.Volume = v
+}
+
+// SetPan sets the panning of the note, which starts in the center
+func (n *Note) SetPan(pan panning.Position) {
+	n.active.Pan = pan
+}
+
+// SetInterpolation sets how the instrument of the note is resampled to the output rate, which starts as the
+// Interpolation of the jam when the note was played
+func (n *Note) SetInterpolation(mode interpolation.Mode) {
+	n.interpolation = mode
+}
+
+// Jam mixes notes of instruments that play outside of their songs
+type Jam struct {
+	// TickDuration is how often the envelopes and auto-vibrato of the notes move on
+	TickDuration time.Duration
+	// Volume is the volume every note is mixed at
+	Volume volume.Volume
+	// Interpolation is how the instruments of the notes played from now on are resampled to the output rate
+	Interpolation interpolation.Mode
+
+	sampleRate int
+	channels   int
+	mixer      mixing.Mixer
+	panMixer   mixing.PanMixer
+
+	notes []*Note
+	// buffers and flat hold the memory the notes are rendered and mixed down in, which is used again every tick
+	buffers state.RenderBuffers
+	flat    *render.Flattener
+	data    []mixing.ChannelData
+	// pending holds the mixed samples of the last tick, and pos is how many of them have been read
+	pending []float32
+	pos     int
+}
+
+// New creates a jam that mixes notes at `sampleRate` frames per second into `channels` channels
+// Songs whose instruments it plays must be set up with the same sample rate.
+func New(sampleRate, channels int) *Jam {
+	return &Jam{
+		TickDuration: DefaultTickDuration,
+		Volume:       1,
+		sampleRate:   sampleRate,
+		channels:     channels,
+		mixer: mixing.Mixer{
+			Channels: channels,
+		},
+		panMixer: mixing.GetPanMixer(channels),
+		flat:     render.NewFlattener(channels),
+	}
+}
+
+// Play starts note `st` of instrument `inst` (1-based, as the song numbers it) of song `p`, with the envelopes,
+// note map and loops of the instrument
+func (j *Jam) Play(p playback.Playback, inst int, st note.Semitone) (*Note, error) {
+	in, per := p.GetInstrumentNote(inst, st)
+	if in == nil || per == nil {
+		return nil, ErrNoInstrument
+	}
+	if in.GetKind() == instrument.KindOPL2 {
+		return nil, ErrUnsupportedInstrument
+	}
+
+	n := &Note{
+		output: render.Channel{
+			GetSampleRate: func() period.Frequency {
+				return period.Frequency(j.sampleRate)
+			},
+			ChannelVolume:    volume.Volume(1),
+			LastGlobalVolume: p.GetGlobalVolume(),
+		},
+		samplerSpeed:  p.GetSampler().GetSamplerSpeed(),
+		interpolation: j.Interpolation,
+	}
+	n.active.Playback = state.Playback{
+		Instrument: in,
+		Period:     per,
+		Volume:     in.GetDefaultVolume(),
+		Pan:        panning.CenterAhead,
+	}
+	n.active.Voice = voiceImpl.New(in, &n.output)
+	if n.active.Voice == nil {
+		return nil, ErrUnsupportedInstrument
+	}
+	n.active.Voice.Attack()
+
+	j.notes = append(j.notes, n)
+	return n, nil
+}
+
+// Len returns the number of notes playing
+func (j *Jam) Len() int {
+	return len(j.notes)
+}
+
+// Stop cuts every note
+func (j *Jam) Stop() {
+	for i, n := range j.notes {
+		n.Cut()
+		j.notes[i] = nil
+	}
+	j.notes = j.notes[:0]
+	j.pending = j.pending[:0]
+	j.pos = 0
+}
+
+// Read mixes the next len(dst)/channels frames of the notes and adds them to `dst`, which holds interleaved
+// samples from -1 to 1
+func (j *Jam) Read(dst []float32) {
+	for len(dst) > 0 {
+		if j.pos >= len(j.pending) {
+			if len(j.notes) == 0 {
+				return
+			}
+			j.renderTick()
+		}
+		pending := j.pending[j.pos:]
+		if len(pending) > len(dst) {
+			pending = pending[:len(dst)]
+		}
+		for i, v := range pending {
+			dst[i] += v
+		}
+		j.pos += len(pending)
+		dst = dst[len(pending):]
+	}
+}
+
+// renderTick mixes the next tick of the notes into the pending samples and drops the notes that have stopped
+func (j *Jam) renderTick() {
+	samples := int(int64(j.sampleRate) * int64(j.TickDuration) / int64(time.Second))
+	if samples < 1 {
+		samples = 1
+	}
+
+	j.buffers.Reset()
+	var mixData []mixing.Data
+	notes := j.notes[:0]
+	for _, n := range j.notes {
+		if n.IsDone() {
+			continue
+		}
+		mixData = j.buffers.Add(&n.active, state.RenderDetails{
+			Mix:           &j.mixer,
+			Panmixer:      j.panMixer,
+			SamplerSpeed:  n.samplerSpeed,
+			Samples:       samples,
+			Duration:      j.TickDuration,
+			Interpolation: n.interpolation,
+			SampleRate:    period.Frequency(j.sampleRate),
+		})
+		if !n.IsDone() {
+			notes = append(notes, n)
+		}
+	}
+	for i := len(notes); i < len(j.notes); i++ {
+		j.notes[i] = nil
+	}
+	j.notes = notes
+
+	j.pos = 0
+	j.pending = j.pending[:0]
+	if len(mixData) == 0 {
+		// the notes that are left are silent for now, such as during a volume envelope
+		for i := 0; i < samples*j.channels; i++ {
+			j.pending = append(j.pending, 0)
+		}
+		return
+	}
+	// every note has a pan of its own, so they can all be mixed down as the data of one channel
+	j.data = append(j.data[:0], mixData)
+	j.pending = j.flat.Append(j.pending, samples, j.data, j.Volume)
+}
@@ -0,0 +1,58 @@
+package render
+
+import (
+	"github.com/gotracker/gomixing/mixing"
+	"github.com/gotracker/gomixing/volume"
+)
+
+// Flattener mixes rendered channel data down to interleaved samples the way the mixer of the player does, but
+// into a buffer it keeps from one tick to the next, so that it does not allocate once the buffer has grown
+type Flattener struct {
+	channels int
+	panMixer mixing.PanMixer
+	mix      mixing.MixBuffer
+}
+
+// NewFlattener creates a Flattener that mixes down to `channels` channels
+func NewFlattener(channels int) *Flattener {
+	return &Flattener{
+		channels: channels,
+		panMixer: mixing.GetPanMixer(channels),
+	}
+}
+
+// Append mixes `samples` samples of `data` down to interleaved samples at `mixerVolume`, where 1 is full scale,
+// and appends them to `dst`
+// The samples are not clipped, so that a limiter after it can bring loud mixes down without distortion; they
+// have to be clipped before they are turned into integers.
+func (f *Flattener) Append(dst []float32, samples int, data []mixing.ChannelData, mixerVolume volume.Volume) []float32 {
+	if cap(f.mix) < samples {
+		f.mix = make(mixing.MixBuffer, samples)
+	} else {
+		f.mix = f.mix[:samples]
+		for i := range f.mix {
+			f.mix[i] = volume.Matrix{}
+		}
+	}
+
+	for _, cd := range data {
+		for i := range cd {
+			d := &cd[i]
+			if d.Flush != nil {
+				d.Flush()
+			}
+			if len(d.Data) > 0 {
+				volMtx := f.panMixer.GetMixingMatrix(d.Pan).Apply(d.Volume)
+				f.mix.Add(d.Pos, &d.Data, volMtx)
+			}
+		}
+	}
+
+	for _, samp := range f.mix {
+		out := samp.Apply(mixerVolume).ToChannels(f.channels)
+		for c := 0; c < f.channels; c++ {
+			dst = append(dst, float32(out.StaticMatrix[c]))
+		}
+	}
+	return dst
+}
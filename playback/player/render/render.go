@@ -0,0 +1,68 @@
+package render
+
+import (
+	"strings"
+
+	"github.com/gotracker/playback/song"
+)
+
+// RowDisplay is an array of ChannelDisplays
+type RowDisplay[TChannelData song.ChannelData] struct {
+	Channels   []TChannelData
+	longFormat bool
+}
+
+// NewRowText creates an array of ChannelDisplay information
+func NewRowText[TChannelData song.ChannelData](channels int, longFormat bool) RowDisplay[TChannelData] {
+	rd := RowDisplay[TChannelData]{
+		Channels:   make([]TChannelData, channels),
+		longFormat: longFormat,
+	}
+	return rd
+}
+
+// Reset empties the row display for `channels` channels, keeping the memory of its channel data when there is
+// enough of it
+func (rt *RowDisplay[TChannelData]) Reset(channels int, longFormat bool) {
+	if cap(rt.Channels) < channels {
+		rt.Channels = make([]TChannelData, channels)
+	} else {
+		rt.Channels = rt.Channels[:channels]
+		var empty TChannelData
+		for i := range rt.Channels {
+			rt.Channels[i] = empty
+		}
+	}
+	rt.longFormat = longFormat
+}
+
+func (rt RowDisplay[TChannelData]) String(options ...any) string {
+	maxChannels := -1
+	if len(options) > 0 {
+		maxChannels = options[0].(int)
+	}
+	items := make([]string, 0, len(rt.Channels))
+	for i, c := range rt.Channels {
+		if maxChannels >= 0 && i >= maxChannels {
+			break
+		}
+		if rt.longFormat {
+			items = append(items, c.String())
+		} else {
+			items = append(items, c.ShortString())
+		}
+	}
+	return "|" + strings.Join(items, "|") + "|"
+}
+
+type RowStringer interface {
+	String(options ...any) string
+}
+
+//RowRender is the final output of a single row's data
+type RowRender struct {
+	Order   int
+	Row     int
+	Tick    int
+	RowText RowStringer
+}
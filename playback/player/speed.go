@@ -0,0 +1,117 @@
+package player
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// DefaultSpeedRampTime is how long tempo scale and transpose changes take by default
+	DefaultSpeedRampTime = 100 * time.Millisecond
+
+	minTempoScale = 1.0 / 16
+	maxTempoScale = 16
+)
+
+// speedControl scales the tempo and pitch of a song on top of what its data asks for
+// Both are kept as exponents, so that the zero value plays the song as it is written and ramps move
+// evenly in musical terms.
+type speedControl struct {
+	rampTime    time.Duration
+	rampTimeSet bool
+
+	// tempo is the log2 of the tempo scale
+	tempo       float64
+	tempoTarget float64
+	tempoRate   float64
+
+	// transpose is in semitones
+	transpose       float64
+	transposeTarget float64
+	transposeRate   float64
+}
+
+// SetTempoScale sets how fast the song plays compared to its own tempo, where 2 is twice as fast, without
+// changing its pitch
+// The change ramps over the speed ramp time.
+func (t *Tracker) SetTempoScale(scale float64) {
+	if !(scale >= minTempoScale) {
+		scale = minTempoScale
+	} else if scale > maxTempoScale {
+		scale = maxTempoScale
+	}
+	sc := &t.speed
+	sc.tempoTarget = math.Log2(scale)
+	sc.tempoRate = sc.rate(sc.tempo, sc.tempoTarget)
+}
+
+// GetTempoScale returns the tempo scale the song is set to play at
+func (t *Tracker) GetTempoScale() float64 {
+	return math.Exp2(t.speed.tempoTarget)
+}
+
+// SetTranspose transposes the song by `semitones`, without changing its tempo
+// Fractions of a semitone are allowed, so 0.01 is a cent. The change ramps over the speed ramp time.
+func (t *Tracker) SetTranspose(semitones float64) {
+	sc := &t.speed
+	sc.transposeTarget = semitones
+	sc.transposeRate = sc.rate(sc.transpose, sc.transposeTarget)
+}
+
+// GetTranspose returns the number of semitones the song is set to be transposed by
+func (t *Tracker) GetTranspose() float64 {
+	return t.speed.transposeTarget
+}
+
+// SetSpeedRampTime sets how long tempo scale and transpose changes take from now on
+// Zero makes them happen on the next tick.
+func (t *Tracker) SetSpeedRampTime(d time.Duration) {
+	t.speed.rampTime = d
+	t.speed.rampTimeSet = true
+}
+
+// TickTiming works out how the next tick is rendered, given `tickDuration`, the length the song data asks
+// for, and moves the tempo scale and transpose ramps on by that tick
+// It returns the length of the tick, its length in samples and the sampler speed to render it with.
+func (t *Tracker) TickTiming(tickDuration time.Duration) (time.Duration, int, float32) {
+	sc := &t.speed
+	duration := time.Duration(float64(tickDuration) / math.Exp2(sc.tempo))
+
+	samplerSpeed := t.s.GetSamplerSpeed()
+	if sc.transpose != 0 {
+		samplerSpeed *= float32(math.Exp2(sc.transpose / 12))
+	}
+
+	elapsed := duration.Seconds()
+	sc.tempo = approach(sc.tempo, sc.tempoTarget, sc.tempoRate*elapsed)
+	sc.transpose = approach(sc.transpose, sc.transposeTarget, sc.transposeRate*elapsed)
+
+	return duration, int(duration.Seconds() * float64(t.s.SampleRate)), samplerSpeed
+}
+
+// rate returns how fast a ramp from `from` to `to` has to move, in units per second
+func (sc *speedControl) rate(from, to float64) float64 {
+	rampTime := DefaultSpeedRampTime
+	if sc.rampTimeSet {
+		rampTime = sc.rampTime
+	}
+	if rampTime <= 0 {
+		return math.Inf(1)
+	}
+	return math.Abs(to-from) / rampTime.Seconds()
+}
+
+// approach moves `v` towards `target` by no more than `step`
+func approach(v, target, step float64) float64 {
+	switch {
+	case v < target:
+		if v += step; v > target {
+			v = target
+		}
+	case v > target:
+		if v -= step; v < target {
+			v = target
+		}
+	}
+	return v
+}
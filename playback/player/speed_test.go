@@ -0,0 +1,170 @@
+package player
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/gotracker/playback/period"
+)
+
+const (
+	testSampleRate = 44100
+	testTick       = 20 * time.Millisecond
+)
+
+// newTestTracker returns a tracker with a sampler, and with speed changes ramping over `rampTime`
+func newTestTracker(t *testing.T, rampTime time.Duration) *Tracker {
+	t.Helper()
+	tr := &Tracker{BaseClockRate: period.Frequency(7093789.2)}
+	if err := tr.SetupSampler(testSampleRate, 2); err != nil {
+		t.Fatal(err)
+	}
+	tr.SetSpeedRampTime(rampTime)
+	return tr
+}
+
+func TestTickTimingUnchanged(t *testing.T) {
+	tr := newTestTracker(t, 0)
+	duration, samples, speed := tr.TickTiming(testTick)
+	if duration != testTick {
+		t.Errorf("the tick is %v, want %v", duration, testTick)
+	}
+	if want := int(testTick.Seconds() * testSampleRate); samples != want {
+		t.Errorf("the tick is %d samples, want %d", samples, want)
+	}
+	if want := tr.GetSampler().GetSamplerSpeed(); speed != want {
+		t.Errorf("the sampler speed is %v, want %v", speed, want)
+	}
+}
+
+func TestSetTempoScale(t *testing.T) {
+	tests := []struct {
+		name     string
+		scale    float64
+		duration time.Duration
+	}{
+		{"twice as fast", 2, testTick / 2},
+		{"half as fast", 0.5, testTick * 2},
+		{"clamped high", 100, testTick / maxTempoScale},
+		{"clamped low", 0, testTick * 16},
+		{"not a number", math.NaN(), testTick * 16},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := newTestTracker(t, 0)
+			speed := tr.GetSampler().GetSamplerSpeed()
+			tr.SetTempoScale(tt.scale)
+
+			// the change is made once the tick it was asked for during has been rendered
+			if duration, _, _ := tr.TickTiming(testTick); duration != testTick {
+				t.Errorf("the tick the change was made on is %v, want %v", duration, testTick)
+			}
+			duration, samples, gotSpeed := tr.TickTiming(testTick)
+			if duration != tt.duration {
+				t.Errorf("the tick is %v, want %v", duration, tt.duration)
+			}
+			if want := int(tt.duration.Seconds() * testSampleRate); samples != want {
+				t.Errorf("the tick is %d samples, want %d", samples, want)
+			}
+			if gotSpeed != speed {
+				t.Errorf("the sampler speed is %v, want it unchanged at %v", gotSpeed, speed)
+			}
+		})
+	}
+}
+
+func TestSetTranspose(t *testing.T) {
+	tests := []struct {
+		name      string
+		semitones float64
+		ratio     float64
+	}{
+		{"octave up", 12, 2},
+		{"octave down", -12, 0.5},
+		{"fifth up", 7, math.Exp2(7.0 / 12)},
+		{"cent", 0.01, math.Exp2(0.01 / 12)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := newTestTracker(t, 0)
+			base := tr.GetSampler().GetSamplerSpeed()
+			tr.SetTranspose(tt.semitones)
+			if got := tr.GetTranspose(); got != tt.semitones {
+				t.Errorf("GetTranspose() = %v, want %v", got, tt.semitones)
+			}
+
+			tr.TickTiming(testTick)
+			duration, samples, speed := tr.TickTiming(testTick)
+			if duration != testTick {
+				t.Errorf("the tick is %v, want it unchanged at %v", duration, testTick)
+			}
+			if want := int(testTick.Seconds() * testSampleRate); samples != want {
+				t.Errorf("the tick is %d samples, want %d", samples, want)
+			}
+			if want := base * float32(tt.ratio); math.Abs(float64(speed-want)) > 1e-6*float64(want) {
+				t.Errorf("the sampler speed is %v, want %v", speed, want)
+			}
+		})
+	}
+}
+
+// TestSpeedRamps checks that the ramps move one way only, and land exactly on their targets in the ramp time
+func TestSpeedRamps(t *testing.T) {
+	const rampTime = 100 * time.Millisecond
+
+	tests := []struct {
+		name      string
+		scale     float64
+		semitones float64
+	}{
+		{"faster", 2, 0},
+		{"slower", 0.25, 0},
+		{"up", 1, 5},
+		{"down", 1, -7},
+		{"both", 1.5, 3.5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := newTestTracker(t, rampTime)
+			tr.SetTempoScale(tt.scale)
+			tr.SetTranspose(tt.semitones)
+			tempoTarget, transposeTarget := math.Log2(tt.scale), tt.semitones
+
+			var elapsed time.Duration
+			for tr.speed.tempo != tempoTarget || tr.speed.transpose != transposeTarget {
+				// the ramps take the ramp time, give or take the last tick
+				if elapsed > rampTime+testTick {
+					t.Fatalf("the ramps have not finished after %v, at tempo %v and transpose %v", elapsed,
+						tr.speed.tempo, tr.speed.transpose)
+				}
+				tempo, transpose := tr.speed.tempo, tr.speed.transpose
+				duration, _, _ := tr.TickTiming(testTick)
+				elapsed += duration
+
+				if !between(tr.speed.tempo, tempo, tempoTarget) {
+					t.Fatalf("the tempo went from %v to %v, past %v", tempo, tr.speed.tempo, tempoTarget)
+				}
+				if !between(tr.speed.transpose, transpose, transposeTarget) {
+					t.Fatalf("the transpose went from %v to %v, past %v", transpose, tr.speed.transpose,
+						transposeTarget)
+				}
+			}
+			if elapsed < rampTime {
+				t.Errorf("the ramps finished after %v, want %v", elapsed, rampTime)
+			}
+
+			// once there, they stay
+			tr.TickTiming(testTick)
+			if tr.speed.tempo != tempoTarget || tr.speed.transpose != transposeTarget {
+				t.Errorf("the ramps moved on to tempo %v and transpose %v after finishing", tr.speed.tempo,
+					tr.speed.transpose)
+			}
+		})
+	}
+}
+
+// between reports whether `v` is between `from` and `to`, taking either end
+func between(v, from, to float64) bool {
+	return math.Min(from, to) <= v && v <= math.Max(from, to)
+}
@@ -0,0 +1,218 @@
+package state
+
+import (
+	"time"
+
+	"github.com/gotracker/gomixing/mixing"
+	"github.com/gotracker/gomixing/panning"
+	"github.com/gotracker/gomixing/volume"
+	"github.com/gotracker/playback/instrument"
+	"github.com/gotracker/playback/period"
+	"github.com/gotracker/playback/voice"
+	"github.com/gotracker/playback/voice/interpolation"
+)
+
+// Active is the active state of a channel
+type Active struct {
+	Playback
+	Voice       voice.Voice
+	PeriodDelta period.PeriodDelta
+}
+
+// Reset sets the active state to defaults
+func (a *Active) Reset() {
+	if v := a.Voice; v != nil {
+		v.Release()
+		a.Voice = nil
+	}
+	a.PeriodDelta = 0
+	a.Playback.Reset()
+}
+
+// Clone clones the active state so that various interfaces do not collide
+func (a *Active) Clone() *Active {
+	var c Active = *a
+	if a.Voice != nil {
+		c.Voice = a.Voice.Clone()
+	}
+
+	return &c
+}
+
+// Transitions the active state so that various interfaces do not collide
+func (a *Active) Transition() *Active {
+	var c *Active
+	if a.Voice != nil && !a.Voice.IsDone() {
+		c = &Active{
+			Playback:    a.Playback,
+			Voice:       a.Voice,
+			PeriodDelta: a.PeriodDelta,
+		}
+	}
+
+	a.Reset()
+	a.Voice = nil
+
+	return c
+}
+
+type RenderDetails struct {
+	Mix          *mixing.Mixer
+	Panmixer     mixing.PanMixer
+	SamplerSpeed float32
+	Samples      int
+	Duration     time.Duration
+	// Interpolation is how the instruments are resampled to SampleRate, the output rate
+	Interpolation interpolation.Mode
+	SampleRate    period.Frequency
+	// ReuseBuffers renders into the buffers of the tick before, instead of new ones
+	ReuseBuffers bool
+}
+
+// RenderStatesTogether renders a channel's series of sample data for a the provided number of samples
+// It also returns the instrument each of the rendered sample data belongs to.
+func RenderStatesTogether(activeState *Active, pastNotes []*Active, details RenderDetails) ([]mixing.Data, []instrument.ID) {
+	var b RenderBuffers
+	return b.Render(activeState, pastNotes, details)
+}
+
+// RenderBuffers holds the buffers a channel renders its states into, so that they can be used again on the next tick
+type RenderBuffers struct {
+	mixData []mixing.Data
+	instIDs []instrument.ID
+	mix     []mixing.MixBuffer
+}
+
+// Render renders a channel's series of sample data for a the provided number of samples into the buffers
+// The data it returns is only valid until the next time Render is called.
+func (b *RenderBuffers) Render(activeState *Active, pastNotes []*Active, details RenderDetails) ([]mixing.Data, []instrument.ID) {
+	b.mixData, b.instIDs = b.mixData[:0], b.instIDs[:0]
+
+	centerAheadPan := details.Panmixer.GetMixingMatrix(panning.CenterAhead)
+
+	if activeState != nil {
+		if data, ok := activeState.renderState(centerAheadPan, details, b); ok {
+			b.mixData = append(b.mixData, data)
+			b.instIDs = append(b.instIDs, activeState.instrumentID())
+		}
+	}
+
+	for _, pn := range pastNotes {
+		if pn != nil {
+			if data, ok := pn.renderState(centerAheadPan, details, b); ok {
+				b.mixData = append(b.mixData, data)
+				b.instIDs = append(b.instIDs, pn.instrumentID())
+			}
+		}
+	}
+
+	if len(b.mixData) == 0 {
+		return nil, nil
+	}
+	return b.mixData, b.instIDs
+}
+
+// Reset empties the buffers, for rendering the states of a tick one at a time with Add
+func (b *RenderBuffers) Reset() {
+	b.mixData, b.instIDs = b.mixData[:0], b.instIDs[:0]
+}
+
+// Add renders `activeState` into the buffers after the states rendered since Reset, and returns the sample data
+// of all of them
+// The data it returns is only valid until Reset or Render is called.
+func (b *RenderBuffers) Add(activeState *Active, details RenderDetails) []mixing.Data {
+	centerAheadPan := details.Panmixer.GetMixingMatrix(panning.CenterAhead)
+	if data, ok := activeState.renderState(centerAheadPan, details, b); ok {
+		b.mixData = append(b.mixData, data)
+		b.instIDs = append(b.instIDs, activeState.instrumentID())
+	}
+	return b.mixData
+}
+
+// nextMixBuffer returns a silent buffer of `samples` samples for the next rendered state to mix into
+func (b *RenderBuffers) nextMixBuffer(samples int) mixing.MixBuffer {
+	i := len(b.mixData)
+	if i == len(b.mix) {
+		b.mix = append(b.mix, nil)
+	}
+	mb := b.mix[i]
+	if cap(mb) < samples {
+		mb = make(mixing.MixBuffer, samples)
+	} else {
+		mb = mb[:samples]
+		for j := range mb {
+			mb[j] = volume.Matrix{}
+		}
+	}
+	b.mix[i] = mb
+	return mb
+}
+
+func (a *Active) instrumentID() instrument.ID {
+	if a.Instrument == nil {
+		return nil
+	}
+	return a.Instrument.Static.ID
+}
+
+func (a *Active) renderState(centerAheadPan volume.Matrix, details RenderDetails, b *RenderBuffers) (mixing.Data, bool) {
+	if a.Period == nil || a.Volume == 0 {
+		return mixing.Data{}, false
+	}
+
+	ncv := a.Voice
+	if ncv == nil || ncv.IsDone() {
+		return mixing.Data{}, false
+	}
+
+	// Commit the playback settings to the note-control
+	voice.SetPeriod(ncv, a.Period)
+	voice.SetVolume(ncv, a.Volume)
+	voice.SetPos(ncv, a.Pos)
+	voice.SetPan(ncv, a.Pan)
+
+	voice.SetPeriodDelta(ncv, a.PeriodDelta)
+	voice.SetInterpolation(ncv, details.Interpolation, details.SampleRate)
+
+	// the period might be updated by the auto-vibrato system, here
+	ncv.Advance(details.Duration)
+
+	if !ncv.IsActive() {
+		return mixing.Data{}, false
+	}
+
+	sampler := ncv.GetSampler(details.SamplerSpeed)
+
+	if sampler == nil {
+		return mixing.Data{}, false
+	}
+
+	// ... so grab the new value now.
+	period := voice.GetFinalPeriod(ncv)
+	pan := voice.GetFinalPan(ncv)
+
+	// make a stand-alone data buffer for this channel for this tick
+	sampleData := mixing.SampleMixIn{
+		Sample:    sampler,
+		StaticVol: volume.Volume(1.0),
+		VolMatrix: centerAheadPan,
+		MixPos:    0,
+		MixLen:    details.Samples,
+	}
+
+	mb := b.nextMixBuffer(details.Samples)
+	mb.MixInSample(sampleData)
+	data := mixing.Data{
+		Data:       mb,
+		Pan:        pan,
+		Volume:     volume.Volume(1.0),
+		Pos:        0,
+		SamplesLen: details.Samples,
+	}
+
+	a.Pos = voice.GetPos(ncv)
+	samplerAdd := float32(period.GetSamplerAdd(float64(details.SamplerSpeed)))
+	a.Pos.Add(samplerAdd * float32(details.Samples))
+
+	return data, true
+}
@@ -0,0 +1,279 @@
+package player
+
+import (
+	"errors"
+	"os"
+	"time"
+
+	"github.com/gotracker/gomixing/mixing"
+	"github.com/gotracker/gomixing/panning"
+	"github.com/gotracker/gomixing/volume"
+	"github.com/gotracker/playback/output"
+	"github.com/gotracker/playback/period"
+	"github.com/gotracker/playback/player/feature"
+	"github.com/gotracker/playback/player/render"
+	"github.com/gotracker/playback/player/sampler"
+	"github.com/gotracker/playback/voice/interpolation"
+	voiceRender "github.com/gotracker/playback/voice/render"
+)
+
+// Premixable is an interface to getting the premix data from the tracker
+type Premixable interface {
+	GetPremixData() (*output.PremixData, error)
+}
+
+// Tracker is an extensible music tracker
+type Tracker struct {
+	BaseClockRate period.Frequency
+	Tickable      Tickable
+	Premixable    Premixable
+	Traceable     Traceable
+
+	s    *sampler.Sampler
+	opl2 voiceRender.OPL2Chip
+
+	globalVolume volume.Volume
+	mixerVolume  volume.Volume
+	speed        speedControl
+
+	interpolation interpolation.Mode
+	reuseBuffers  bool
+	silence       [1]mixing.Data
+	opl2Render    [1]mixing.Data
+	opl2Data      []int32
+	opl2Mix       mixing.MixBuffer
+
+	ignoreUnknownEffect feature.IgnoreUnknownEffect
+	tracingFile         *os.File
+	tracingState        tracingState
+	outputChannels      map[int]*render.Channel
+}
+
+func (t *Tracker) Close() {
+	if t.tracingState.c != nil {
+		close(t.tracingState.c)
+	}
+	if t.tracingFile != nil {
+		t.tracingFile.Close()
+	}
+	t.tracingState.wg.Wait()
+}
+
+// Update runs processing on the tracker, producing premixed sound data
+func (t *Tracker) Update(deltaTime time.Duration, out chan<- *output.PremixData) error {
+	premix, err := t.Generate(deltaTime)
+	if err != nil {
+		return err
+	}
+
+	t.OutputTraces()
+
+	if premix != nil && premix.Data != nil && len(premix.Data) != 0 {
+		out <- premix
+	}
+
+	return nil
+}
+
+// Generate runs processing on the tracker, then returns the premixed sound data (if possible)
+func (t *Tracker) Generate(deltaTime time.Duration) (*output.PremixData, error) {
+	premix, err := t.renderTick()
+	if err != nil {
+		return nil, err
+	}
+
+	if premix != nil {
+		if len(premix.Data) == 0 {
+			cd := mixing.ChannelData{}
+			if t.reuseBuffers {
+				cd = t.silence[:]
+			}
+			cd = append(cd[:0], mixing.Data{
+				Data:       nil,
+				Pan:        panning.CenterAhead,
+				Volume:     volume.Volume(0),
+				SamplesLen: premix.SamplesLen,
+			})
+			premix.Data = append(premix.Data, cd)
+			premix.Sources = append(premix.Sources, output.PremixSource{
+				Channel: -1,
+			})
+		}
+		return premix, nil
+	}
+
+	return nil, nil
+}
+
+// GetRenderChannel returns the output channel for the provided index `ch`
+func (t *Tracker) GetRenderChannel(ch int, init func(ch int) *render.Channel) *render.Channel {
+	if t.outputChannels == nil {
+		t.outputChannels = make(map[int]*render.Channel)
+	}
+
+	if oc, ok := t.outputChannels[ch]; ok {
+		return oc
+	}
+	oc := init(ch)
+	t.outputChannels[ch] = oc
+	return oc
+}
+
+// GetSampleRate returns the sample rate of the sampler
+func (t *Tracker) GetSampleRate() period.Frequency {
+	return period.Frequency(t.GetSampler().SampleRate)
+}
+
+func (t *Tracker) renderTick() (*output.PremixData, error) {
+	if err := DoTick(t.Tickable); err != nil {
+		return nil, err
+	}
+
+	premix, err := t.Premixable.GetPremixData()
+	if err != nil {
+		return nil, err
+	}
+
+	if t.opl2 != nil {
+		rr := &[1]mixing.Data{}
+		if t.reuseBuffers {
+			rr = &t.opl2Render
+		}
+		t.renderOPL2Tick(&rr[0],
+			t.s.Mixer(),
+			premix.SamplesLen)
+		premix.Data = append(premix.Data, rr[:])
+		premix.Sources = append(premix.Sources, output.PremixSource{
+			Channel: -1,
+		})
+
+		// make room in the mixer for the OPL2 data
+		// effectively, we can do this by calculating the new number (+1) of channels from the mixer volume (channels = reciprocal of mixer volume):
+		//   numChannels = (1/mv) + 1
+		// then by taking the reciprocal of it:
+		//   1 / numChannels
+		// but that ends up being simplified to:
+		//   mv / (mv + 1)
+		// and we get protection from div/0 in the process - provided, of course, that the mixerVolume is not exactly -1...
+		mv := premix.MixerVolume
+		premix.MixerVolume /= (mv + 1)
+	}
+	return premix, nil
+}
+
+func (t *Tracker) renderOPL2Tick(mixerData *mixing.Data, mix *mixing.Mixer, tickSamples int) {
+	// make a stand-alone data buffer for this channel for this tick
+	var (
+		data     mixing.MixBuffer
+		opl2data []int32
+	)
+	if t.reuseBuffers && cap(t.opl2Mix) >= tickSamples {
+		data, opl2data = t.opl2Mix[:tickSamples], t.opl2Data[:tickSamples]
+	} else {
+		data, opl2data = mix.NewMixBuffer(tickSamples), make([]int32, tickSamples)
+		if t.reuseBuffers {
+			t.opl2Mix, t.opl2Data = data, opl2data
+		}
+	}
+
+	if opl2 := t.opl2; opl2 != nil {
+		opl2.GenerateBlock2(uint(tickSamples), opl2data)
+	}
+
+	for i, s := range opl2data {
+		sv := [1]volume.Volume{volume.Volume(s) / 32768.0}
+		data[i].Assign(1, sv[:])
+	}
+	*mixerData = mixing.Data{
+		Data:       data,
+		Pan:        panning.CenterAhead,
+		Volume:     t.globalVolume,
+		SamplesLen: tickSamples,
+	}
+}
+
+// GetOPL2Chip returns the current song's OPL2 chip, if it's needed
+func (t *Tracker) GetOPL2Chip() voiceRender.OPL2Chip {
+	return t.opl2
+}
+
+// SetOPL2Chip sets the current song's OPL2 chip
+func (t *Tracker) SetOPL2Chip(opl2 voiceRender.OPL2Chip) {
+	t.opl2 = opl2
+}
+
+// SetupSampler configures the internal sampler
+func (t *Tracker) SetupSampler(samplesPerSecond int, channels int) error {
+	t.s = sampler.NewSampler(samplesPerSecond, channels, t.BaseClockRate)
+	if t.s == nil {
+		return errors.New("NewSampler() returned nil")
+	}
+
+	return nil
+}
+
+// GetSampler returns the current sampler
+func (t *Tracker) GetSampler() *sampler.Sampler {
+	return t.s
+}
+
+// GetGlobalVolume returns the global volume value
+func (t *Tracker) GetGlobalVolume() volume.Volume {
+	return t.globalVolume
+}
+
+// SetGlobalVolume sets the global volume to the specified `vol` value
+func (t *Tracker) SetGlobalVolume(vol volume.Volume) {
+	t.globalVolume = vol
+}
+
+// GetMixerVolume returns the mixer volume value
+func (t *Tracker) GetMixerVolume() volume.Volume {
+	return t.mixerVolume
+}
+
+// SetMixerVolume sets the mixer volume to the specified `vol` value
+func (t *Tracker) SetMixerVolume(vol volume.Volume) {
+	t.mixerVolume = vol
+}
+
+// SetInterpolation sets how instruments are resampled to the output rate, from the next tick on
+func (t *Tracker) SetInterpolation(mode interpolation.Mode) {
+	t.interpolation = mode
+}
+
+// GetInterpolation returns how instruments are resampled to the output rate
+func (t *Tracker) GetInterpolation() interpolation.Mode {
+	return t.interpolation
+}
+
+// ReuseBuffers returns true if the tracker renders every tick into the buffers of the tick before it
+func (t *Tracker) ReuseBuffers() bool {
+	return t.reuseBuffers
+}
+
+// IgnoreUnknownEffect returns true if the tracker wants unknown effects to be ignored
+func (t *Tracker) IgnoreUnknownEffect() bool {
+	return t.ignoreUnknownEffect.Enabled
+}
+
+// Configure sets specified features
+func (t *Tracker) Configure(features []feature.Feature) error {
+	for _, feat := range features {
+		switch f := feat.(type) {
+		case feature.IgnoreUnknownEffect:
+			t.ignoreUnknownEffect = f
+		case feature.Interpolation:
+			t.interpolation = f.Mode
+		case feature.ReuseBuffers:
+			t.reuseBuffers = f.Enabled
+		case feature.EnableTracing:
+			var err error
+			t.tracingFile, err = os.Create(f.Filename)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+package voice
+
+import (
+	"github.com/gotracker/playback/voice"
+
+	"github.com/gotracker/playback/filter"
+	"github.com/gotracker/playback/instrument"
+	"github.com/gotracker/playback/player/render"
+)
+
+// New returns a new Voice from the instrument and output channel provided
+func New(inst *instrument.Instrument, output *render.Channel) voice.Voice {
+	switch data := inst.GetData().(type) {
+	case *instrument.PCM:
+		return NewPCM(pcmConfiguration(inst, data, output))
+	case *instrument.OPL2:
+		return NewOPL2(OPLConfiguration{
+			Chip:          output.GetOPL2Chip(),
+			Channel:       output.ChannelNum,
+			C2SPD:         inst.GetC2Spd(),
+			InitialVolume: inst.GetDefaultVolume(),
+			AutoVibrato:   inst.GetAutoVibrato(),
+			Data:          data,
+		})
+	}
+	return nil
+}
+
+// Renew sets up `v`, a voice that New made for `inst` and that nothing plays any more, to play again as if New had
+// just made it, so that a channel going back to an instrument does not allocate a new voice
+// It returns false for voices it cannot set up again, such as OPL2 ones, which are left as they were.
+func Renew(v voice.Voice, inst *instrument.Instrument, output *render.Channel) bool {
+	pv, ok := v.(*pcmVoice)
+	if !ok {
+		return false
+	}
+	data, ok := inst.GetData().(*instrument.PCM)
+	if !ok {
+		return false
+	}
+	pv.setup(pcmConfiguration(inst, data, output))
+	return true
+}
+
+func pcmConfiguration(inst *instrument.Instrument, data *instrument.PCM, output *render.Channel) PCMConfiguration {
+	var (
+		voiceFilter  filter.Filter
+		pluginFilter filter.Filter
+	)
+	if factory := inst.GetFilterFactory(); factory != nil {
+		voiceFilter = factory(inst.C2Spd, output.GetSampleRate())
+	}
+	if factory := inst.GetPluginFilterFactory(); factory != nil {
+		pluginFilter = factory(inst.C2Spd, output.GetSampleRate())
+	}
+	return PCMConfiguration{
+		C2SPD:         inst.GetC2Spd(),
+		InitialVolume: inst.GetDefaultVolume(),
+		AutoVibrato:   inst.GetAutoVibrato(),
+		Data:          data,
+		OutputFilter:  output,
+		VoiceFilter:   voiceFilter,
+		PluginFilter:  pluginFilter,
+	}
+}
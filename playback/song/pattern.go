@@ -0,0 +1,53 @@
+package song
+
+import (
+	"errors"
+
+	"github.com/gotracker/playback/index"
+)
+
+var (
+	// ErrStopSong is a magic error asking to stop the current song
+	ErrStopSong = errors.New("stop song")
+	// ErrInvalidPosition is for seeking to an order or a row that the song does not have
+	ErrInvalidPosition = errors.New("no such order or row")
+)
+
+// Pattern is an interface for pattern data
+type Pattern[TChannelData any] interface {
+	GetRow(index.Row) Row[TChannelData]
+	GetRows() Rows[TChannelData]
+}
+
+// Patterns is an array of pattern interfaces
+type Patterns[TChannelData any] []Pattern[TChannelData]
+
+// Rows is an interface to obtain row data
+type Rows[TChannelData any] interface {
+	GetRow(index.Row) Row[TChannelData]
+	NumRows() int
+}
+
+// GetPatternChannelData returns the channel data of every row in `pat`, or nil if `pat` is nil
+// Rows hold at least `numChannels` channels, as the channels missing from the pattern data are filled in
+// with empty channel data.
+func GetPatternChannelData[TChannelData ChannelData](pat Pattern[TChannelData], numChannels int) [][]ChannelData {
+	if pat == nil {
+		return nil
+	}
+
+	var empty TChannelData
+	rows := pat.GetRows()
+	data := make([][]ChannelData, rows.NumRows())
+	for i := range data {
+		channels := rows.GetRow(index.Row(i)).GetChannels()
+		data[i] = make([]ChannelData, 0, len(channels)+numChannels)
+		for _, cd := range channels {
+			data[i] = append(data[i], cd)
+		}
+		for len(data[i]) < numChannels {
+			data[i] = append(data[i], empty)
+		}
+	}
+	return data
+}
@@ -0,0 +1,35 @@
+package util
+
+import "github.com/gotracker/playback/index"
+
+// loopDetectNode holds a bit for every row of an order
+type loopDetectNode [4]uint64
+
+// LoopDetect is a simple loop detection system for tracked music
+// It keeps a bit for every order+row combination, so observing a row never allocates.
+type LoopDetect struct {
+	orders *[256]loopDetectNode
+}
+
+// Observe determines if a particular order+row combination has been observed before and returns true if it has
+// it will also add the combination to the detection tree if it has not been observed before.
+func (ld *LoopDetect) Observe(ord index.Order, row index.Row) bool {
+	if ld.orders == nil {
+		ld.orders = new([256]loopDetectNode)
+	}
+
+	n := &ld.orders[ord]
+	word, bit := row/64, uint64(1)<<(row%64)
+	if n[word]&bit != 0 {
+		return true
+	}
+
+	n[word] |= bit
+	return false
+}
+
+func (ld *LoopDetect) Reset() {
+	if ld.orders != nil {
+		*ld.orders = [256]loopDetectNode{}
+	}
+}
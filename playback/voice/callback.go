@@ -0,0 +1,4 @@
+package voice
+
+// Callback is a callback function for performing some sort of action at a later time
+type Callback func(v Voice)
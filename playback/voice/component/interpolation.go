@@ -0,0 +1,107 @@
+package component
+
+import (
+	"math"
+
+	"github.com/gotracker/gomixing/sampling"
+	"github.com/gotracker/gomixing/volume"
+)
+
+const (
+	// sincTaps is the number of points the windowed-sinc kernel looks at, half of them on each side
+	sincTaps = 8
+	// sincPhases is the number of fractional positions the windowed-sinc kernel is worked out for
+	sincPhases = 256
+)
+
+// sincTable holds the windowed-sinc kernel for every phase, each normalized so that its taps add up to 1
+var sincTable = makeSincTable()
+
+func makeSincTable() [sincPhases + 1][sincTaps]volume.Volume {
+	var table [sincPhases + 1][sincTaps]volume.Volume
+	const half = sincTaps / 2
+	for p := range table {
+		frac := float64(p) / sincPhases
+		var sum float64
+		var taps [sincTaps]float64
+		for i := range taps {
+			x := float64(i-half+1) - frac
+			taps[i] = sinc(x) * sinc(x/half) // Lanczos window
+			sum += taps[i]
+		}
+		for i := range taps {
+			table[p][i] = volume.Volume(taps[i] / sum)
+		}
+	}
+	return table
+}
+
+func sinc(x float64) float64 {
+	if x == 0 {
+		return 1
+	}
+	x *= math.Pi
+	return math.Sin(x) / x
+}
+
+// getCubicSample interpolates between the points around `pos` with a Catmull-Rom spline
+func (s *Sampler) getCubicSample(pos sampling.Pos) volume.Matrix {
+	v1 := s.getConvertedSample(pos.Pos)
+	if v1.Channels == 0 || pos.Frac == 0 {
+		return v1
+	}
+	v0 := s.getConvertedSample(pos.Pos - 1).ToChannels(v1.Channels)
+	v2 := s.getConvertedSample(pos.Pos + 1).ToChannels(v1.Channels)
+	v3 := s.getConvertedSample(pos.Pos + 2).ToChannels(v1.Channels)
+
+	t := volume.Volume(pos.Frac)
+	out := v1
+	for c := 0; c < v1.Channels; c++ {
+		p0, p1, p2, p3 := v0.StaticMatrix[c], v1.StaticMatrix[c], v2.StaticMatrix[c], v3.StaticMatrix[c]
+		a := -0.5*p0 + 1.5*p1 - 1.5*p2 + 0.5*p3
+		b := p0 - 2.5*p1 + 2*p2 - 0.5*p3
+		d := -0.5*p0 + 0.5*p2
+		out.StaticMatrix[c] = ((a*t+b)*t+d)*t + p1
+	}
+	return out
+}
+
+// getSincSample interpolates between the points around `pos` with a windowed-sinc kernel
+func (s *Sampler) getSincSample(pos sampling.Pos) volume.Matrix {
+	center := s.getConvertedSample(pos.Pos)
+	if center.Channels == 0 || pos.Frac == 0 {
+		return center
+	}
+	kernel := &sincTable[int(pos.Frac*sincPhases+0.5)]
+
+	out := volume.Matrix{Channels: center.Channels}
+	for i, k := range kernel {
+		p := pos.Pos + i - sincTaps/2 + 1
+		v := center
+		if p != pos.Pos {
+			v = s.getConvertedSample(p).ToChannels(center.Channels)
+		}
+		for c := 0; c < out.Channels; c++ {
+			out.StaticMatrix[c] += k * v.StaticMatrix[c]
+		}
+	}
+	return out
+}
+
+// getAmigaSample holds the point at `pos` and runs it through the output filter of an Amiga
+// The filter keeps its state from one sample to the next, so it relies on being asked for the samples in
+// the order they are played.
+func (s *Sampler) getAmigaSample(pos sampling.Pos) volume.Matrix {
+	v := s.getConvertedSample(pos.Pos)
+	if v.Channels == 0 {
+		s.lowPass = volume.Matrix{}
+		return v
+	}
+	lp := s.lowPass.ToChannels(v.Channels)
+	lp.Channels = v.Channels
+	for c := 0; c < v.Channels; c++ {
+		lp.StaticMatrix[c] += s.lowPassCoeff * (v.StaticMatrix[c] - lp.StaticMatrix[c])
+	}
+	s.lowPass = lp
+	return lp
+}
@@ -0,0 +1,53 @@
+package component
+
+import (
+	"github.com/gotracker/gomixing/sampling"
+	"github.com/gotracker/gomixing/volume"
+
+	"github.com/gotracker/playback/voice"
+)
+
+// OutputFilter applies a filter to a sample stream
+type OutputFilter struct {
+	Input  sampling.SampleStream
+	Output voice.FilterApplier
+}
+
+// GetSample operates the filter
+func (o *OutputFilter) GetSample(pos sampling.Pos) volume.Matrix {
+	dry := o.Input.GetSample(pos)
+	return o.Output.ApplyFilter(dry)
+}
+
+// OutputSampler steps through a sample stream, passing each sample through an output filter
+// It lets a voice hand out the same sampler every tick, instead of making a new one.
+type OutputSampler struct {
+	filter OutputFilter
+	pos    sampling.Pos
+	period float32
+}
+
+// Setup starts the sampler at `pos` of `input`, moving on by `period` for every sample
+func (s *OutputSampler) Setup(input sampling.SampleStream, output voice.FilterApplier, pos sampling.Pos, period float32) {
+	s.filter = OutputFilter{
+		Input:  input,
+		Output: output,
+	}
+	s.pos = pos
+	s.period = period
+}
+
+// GetPosition returns the current position of the sampler
+func (s *OutputSampler) GetPosition() sampling.Pos {
+	return s.pos
+}
+
+// Advance moves the sampler on to the next sample
+func (s *OutputSampler) Advance() {
+	s.pos.Add(s.period)
+}
+
+// GetSample returns the filtered sample at the current position
+func (s *OutputSampler) GetSample() volume.Matrix {
+	return s.filter.GetSample(s.pos)
+}
@@ -0,0 +1,134 @@
+package component
+
+import (
+	"math"
+
+	"github.com/gotracker/gomixing/sampling"
+	"github.com/gotracker/gomixing/volume"
+
+	"github.com/gotracker/playback/period"
+	"github.com/gotracker/playback/voice/interpolation"
+	"github.com/gotracker/playback/voice/loop"
+	"github.com/gotracker/playback/voice/pcm"
+)
+
+// Sampler is a sampler component
+type Sampler struct {
+	sample       pcm.Sample
+	pos          sampling.Pos
+	keyOn        bool
+	loopsEnabled bool
+	wholeLoop    loop.Loop
+	sustainLoop  loop.Loop
+
+	interpolation interpolation.Mode
+	// lowPass is the state of the Amiga output filter, and lowPassCoeff how far it moves towards each sample
+	lowPass      volume.Matrix
+	lowPassCoeff volume.Volume
+}
+
+// Setup sets up the sampler
+func (s *Sampler) Setup(sample pcm.Sample, wholeLoop loop.Loop, sustainLoop loop.Loop) {
+	s.sample = sample
+	s.wholeLoop = wholeLoop
+	s.sustainLoop = sustainLoop
+}
+
+// SetPos sets the current position of the sampler in the pcm data (and loops)
+func (s *Sampler) SetPos(pos sampling.Pos) {
+	s.pos = pos
+}
+
+// GetPos returns the current position of the sampler in the pcm data (and loops)
+func (s *Sampler) GetPos() sampling.Pos {
+	return s.pos
+}
+
+// Attack sets the key-on value (for loop processing)
+func (s *Sampler) Attack() {
+	s.keyOn = true
+	s.loopsEnabled = true
+}
+
+// Release releases the key-on value (for loop processing)
+func (s *Sampler) Release() {
+	s.keyOn = false
+}
+
+// Fadeout disables the loops (for loop processing)
+func (s *Sampler) Fadeout() {
+	s.loopsEnabled = false
+}
+
+// SetInterpolation sets how the sampler works out the samples between the points of its pcm data, for output
+// at `sampleRate`
+func (s *Sampler) SetInterpolation(mode interpolation.Mode, sampleRate period.Frequency) {
+	if mode.IsAmiga() && sampleRate > 0 {
+		s.lowPassCoeff = volume.Volume(1 - math.Exp(-2*math.Pi*mode.AmigaCutoff()/float64(sampleRate)))
+	}
+	s.interpolation = mode
+}
+
+// GetSample returns a multi-channel sample at the specified position
+func (s *Sampler) GetSample(pos sampling.Pos) volume.Matrix {
+	switch s.interpolation {
+	case interpolation.Nearest:
+		return s.getConvertedSample(pos.Pos)
+	case interpolation.Cubic:
+		return s.getCubicSample(pos)
+	case interpolation.Sinc:
+		return s.getSincSample(pos)
+	case interpolation.AmigaA500, interpolation.AmigaA1200:
+		return s.getAmigaSample(pos)
+	}
+
+	v0 := s.getConvertedSample(pos.Pos)
+	if v0.Channels == 0 {
+		if s.canLoop() {
+			v01 := s.getConvertedSample(pos.Pos)
+			panic(v01)
+		}
+		return v0
+	}
+
+	if pos.Frac == 0 {
+		return v0
+	}
+
+	v1 := s.getConvertedSample(pos.Pos + 1)
+	return v0.Lerp(v1, pos.Frac)
+}
+
+func (s *Sampler) canLoop() bool {
+	switch {
+	case !s.loopsEnabled:
+		return false
+	case s.keyOn && s.sustainLoop.Enabled():
+		return true
+	case s.wholeLoop.Enabled():
+		return true
+	}
+	return false
+}
+
+func (s *Sampler) getConvertedSample(pos int) volume.Matrix {
+	if s.sample == nil {
+		return volume.Matrix{}
+	}
+	sl := s.sample.Length()
+	if pos >= sl && !s.canLoop() {
+		return volume.Matrix{}
+	}
+	opos := pos
+	pos, _ = loop.CalcLoopPos(s.wholeLoop, s.sustainLoop, pos, sl, s.keyOn)
+	_ = opos
+	if pos < 0 || pos >= sl {
+		return volume.Matrix{}
+	}
+	s.sample.Seek(pos)
+	data, err := s.sample.Read()
+	if err != nil {
+		return volume.Matrix{}
+	}
+	return data
+}
@@ -0,0 +1,84 @@
+// Package interpolation lists the ways samples can be resampled to the output rate
+package interpolation
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrUnknownMode is for when an interpolation mode name is not one of the known ones
+var ErrUnknownMode = errors.New("unknown interpolation mode")
+
+// Mode is a way of working out the value of a sample between two of its points
+type Mode int
+
+const (
+	// Linear draws a straight line between the two points around the position
+	Linear = Mode(iota)
+	// Nearest holds each point until the next one, for the raw sound of trackers without interpolation
+	Nearest
+	// Cubic fits a Catmull-Rom spline through the four points around the position
+	Cubic
+	// Sinc uses a windowed-sinc kernel over the eight points around the position
+	Sinc
+	// AmigaA500 holds each point like Paula does and runs the result through the fixed 4.4 kHz low-pass
+	// filter of the Amiga 500
+	AmigaA500
+	// AmigaA1200 holds each point like Paula does and runs the result through the much higher low-pass
+	// filter of the Amiga 1200
+	AmigaA1200
+
+	numModes
+)
+
+var names = [numModes]string{"linear", "nearest", "cubic", "sinc", "a500", "a1200"}
+
+// Modes returns every interpolation mode, in order
+func Modes() []Mode {
+	modes := make([]Mode, numModes)
+	for i := range modes {
+		modes[i] = Mode(i)
+	}
+	return modes
+}
+
+// Parse returns the mode called `name`, as returned by String, ignoring case
+func Parse(name string) (Mode, error) {
+	for i, n := range names {
+		if strings.EqualFold(n, name) {
+			return Mode(i), nil
+		}
+	}
+	return Linear, ErrUnknownMode
+}
+
+// String returns the short name of the mode
+func (m Mode) String() string {
+	if m < 0 || m >= numModes {
+		return "unknown"
+	}
+	return names[m]
+}
+
+// Next returns the mode after this one, wrapping around to the first
+func (m Mode) Next() Mode {
+	return (m + 1) % numModes
+}
+
+// IsAmiga returns true for the modes that emulate the output filter of an Amiga
+func (m Mode) IsAmiga() bool {
+	return m == AmigaA500 || m == AmigaA1200
+}
+
+// AmigaCutoff returns the cut-off frequency of the output low-pass filter of the Amiga that the mode emulates,
+// in Hz, or 0 for modes that do not emulate one
+func (m Mode) AmigaCutoff() float64 {
+	switch m {
+	case AmigaA500:
+		return 4420.97
+	case AmigaA1200:
+		return 34419.17
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,11 @@
+package voice
+
+import (
+	"github.com/gotracker/playback/period"
+	"github.com/gotracker/playback/voice/interpolation"
+)
+
+// Interpolator is the sample interpolation control interface
+type Interpolator interface {
+	SetInterpolation(mode interpolation.Mode, sampleRate period.Frequency)
+}
@@ -0,0 +1,274 @@
+package playlist
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrUnsupportedFormat is for when a playlist names a file that is not a module the player can load
+	ErrUnsupportedFormat = errors.New("unsupported song format")
+	// ErrEmpty is for when a playlist does not have any songs in it
+	ErrEmpty = errors.New("playlist is empty")
+	// ErrOutsideFS is for when a playlist names a song outside of the file system it is read from
+	ErrOutsideFS = errors.New("song is outside of the playlist's file system")
+)
+
+// Entry is a song in a playlist
+type Entry struct {
+	// Path is where the song is in the file system it was found in, separated by slashes
+	Path string
+	// Title is the title of the song from the playlist file, or its file name
+	Title string
+	// Format is the format of the song: "mod", "s3m", "xm" or "it"
+	Format string
+	// Loops is the number of times the song repeats after it has played through once, before the playlist
+	// moves on; negative loops it forever
+	Loops int
+
+	fsys fs.FS
+}
+
+// NewEntry creates an entry for the song at `name` in `fsys`, which repeats `loops` times
+func NewEntry(fsys fs.FS, name string, loops int) (Entry, error) {
+	format := formatOf(name)
+	if format == "" {
+		return Entry{}, fmt.Errorf("%s: %w", name, ErrUnsupportedFormat)
+	}
+	return Entry{
+		Path:   name,
+		Title:  path.Base(name),
+		Format: format,
+		Loops:  loops,
+		fsys:   fsys,
+	}, nil
+}
+
+// Read reads the song data
+func (e Entry) Read() ([]byte, error) {
+	return fs.ReadFile(e.fsys, e.Path)
+}
+
+// FromDir creates entries for every song in directory `dir` of `fsys`, in the order of their names, which
+// repeat `loops` times
+// Files that are not songs are left out.
+func FromDir(fsys fs.FS, dir string, loops int) ([]Entry, error) {
+	files, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []Entry
+	for _, f := range files {
+		// go.mod files are a common sight next to songs, and are not MOD files
+		if f.IsDir() || formatOf(f.Name()) == "" || f.Name() == "go.mod" {
+			continue
+		}
+		e, err := NewEntry(fsys, path.Join(dir, f.Name()), loops)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+	if len(entries) == 0 {
+		return nil, ErrEmpty
+	}
+	return entries, nil
+}
+
+// FromM3U creates entries for the songs listed in the M3U playlist `name` in `fsys`, which repeat `loops`
+// times
+// Song paths are relative to the playlist. The titles come from #EXTINF lines, and an #EXTLOOPS:<n> line
+// sets the loop count of the song that follows it.
+// Songs have to be inside `fsys`, so paths that go up out of it with ".." fail with ErrOutsideFS; Open
+// takes playlists on disk that do that.
+func FromM3U(fsys fs.FS, name string, loops int) ([]Entry, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+	songs, err := readM3U(data, name, loops)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]Entry, 0, len(songs))
+	for _, song := range songs {
+		p := path.Join(path.Dir(name), song.path)
+		if !fs.ValidPath(p) {
+			return nil, fmt.Errorf("%s: %w", song.path, ErrOutsideFS)
+		}
+		e, err := song.entry(fsys, p)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// m3uSong is a song listed in an M3U playlist
+type m3uSong struct {
+	// path is the path of the song as the playlist has it, separated by slashes
+	path  string
+	title string
+	loops int
+}
+
+// entry creates the entry of the song, which is at `name` in `fsys`
+func (s m3uSong) entry(fsys fs.FS, name string) (Entry, error) {
+	e, err := NewEntry(fsys, name, s.loops)
+	if err != nil {
+		return Entry{}, err
+	}
+	if s.title != "" {
+		e.Title = s.title
+	}
+	return e, nil
+}
+
+// readM3U reads the songs listed in M3U playlist `data`, called `name`, which repeat `loops` times unless
+// the playlist says otherwise
+func readM3U(data []byte, name string, loops int) ([]m3uSong, error) {
+	var (
+		songs     []m3uSong
+		title     string
+		songLoops = loops
+	)
+	s := bufio.NewScanner(bytes.NewReader(data))
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		switch {
+		case line == "":
+		case strings.HasPrefix(line, "#EXTINF:"):
+			if i := strings.IndexByte(line, ','); i >= 0 {
+				title = strings.TrimSpace(line[i+1:])
+			}
+		case strings.HasPrefix(line, "#EXTLOOPS:"):
+			n, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "#EXTLOOPS:")))
+			if err != nil {
+				return nil, fmt.Errorf("%s: bad loop count: %w", name, err)
+			}
+			songLoops = n
+		case strings.HasPrefix(line, "#"):
+		default:
+			songs = append(songs, m3uSong{
+				path:  strings.ReplaceAll(line, "\\", "/"),
+				title: title,
+				loops: songLoops,
+			})
+			title = ""
+			songLoops = loops
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if len(songs) == 0 {
+		return nil, ErrEmpty
+	}
+	return songs, nil
+}
+
+// Open creates entries from `name` on disk, which can be a directory of songs, an M3U playlist or a single
+// song, which repeat `loops` times
+// The songs of an M3U playlist can be anywhere on disk, including in the directories above it.
+func Open(name string, loops int) ([]Entry, error) {
+	info, err := os.Stat(name)
+	if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return FromDir(os.DirFS(name), ".", loops)
+	}
+
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".m3u", ".m3u8":
+		return openM3U(name, loops)
+	}
+	e, err := NewEntry(os.DirFS(filepath.Dir(name)), filepath.Base(name), loops)
+	if err != nil {
+		return nil, err
+	}
+	return []Entry{e}, nil
+}
+
+// openM3U creates entries for the songs listed in the M3U playlist at `name` on disk
+// The songs are read from the deepest directory that holds all of them, which can be above the playlist.
+func openM3U(name string, loops int) ([]Entry, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	songs, err := readM3U(data, filepath.Base(name), loops)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, len(songs))
+	for i, song := range songs {
+		p := filepath.FromSlash(song.path)
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(filepath.Dir(name), p)
+		}
+		if paths[i], err = filepath.Abs(p); err != nil {
+			return nil, err
+		}
+	}
+	root := commonDir(paths)
+	fsys := os.DirFS(root)
+
+	entries := make([]Entry, 0, len(songs))
+	for i, song := range songs {
+		rel, err := filepath.Rel(root, paths[i])
+		if err != nil {
+			return nil, err
+		}
+		e, err := song.entry(fsys, filepath.ToSlash(rel))
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// commonDir returns the deepest directory that holds every one of the absolute `paths`
+func commonDir(paths []string) string {
+	root := filepath.Dir(paths[0])
+	for _, p := range paths[1:] {
+		for {
+			rel, err := filepath.Rel(root, p)
+			if err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+				break
+			}
+			parent := filepath.Dir(root)
+			if parent == root {
+				break
+			}
+			root = parent
+		}
+	}
+	return root
+}
+
+// formatOf returns the format of the song at `name` going by its extension, or "" if it is not a song
+// Amiga-style names that start with "mod." are MOD files as well.
+func formatOf(name string) string {
+	switch ext := strings.ToLower(strings.TrimPrefix(path.Ext(name), ".")); ext {
+	case "mod", "s3m", "xm", "it":
+		return ext
+	}
+	if strings.HasPrefix(strings.ToLower(path.Base(name)), "mod.") {
+		return "mod"
+	}
+	return ""
+}
@@ -0,0 +1,156 @@
+package playlist
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+)
+
+func TestFromDir(t *testing.T) {
+	fsys := fstest.MapFS{
+		"songs/b.xm":        {Data: []byte("b")},
+		"songs/a.mod":       {Data: []byte("a")},
+		"songs/mod.amiga":   {Data: []byte("amiga")},
+		"songs/go.mod":      {Data: []byte("module songs")},
+		"songs/readme.txt":  {Data: []byte("readme")},
+		"songs/more/c.it":   {Data: []byte("c")},
+		"songs/more/d.s3m":  {Data: []byte("d")},
+		"elsewhere/e.s3m":   {Data: []byte("e")},
+		"songs/empty/x.txt": {Data: []byte("x")},
+	}
+	entries, err := FromDir(fsys, "songs", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct{ path, format string }{
+		{"songs/a.mod", "mod"},
+		{"songs/b.xm", "xm"},
+		{"songs/mod.amiga", "mod"},
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("got %d entries, want %d", len(entries), len(want))
+	}
+	for i, e := range entries {
+		if e.Path != want[i].path || e.Format != want[i].format || e.Loops != 2 {
+			t.Errorf("entry %d: got %s (%s, %d loops), want %s (%s, 2 loops)", i, e.Path, e.Format, e.Loops,
+				want[i].path, want[i].format)
+		}
+	}
+
+	if _, err := FromDir(fsys, "songs/empty", 0); !errors.Is(err, ErrEmpty) {
+		t.Errorf("got %v, want %v", err, ErrEmpty)
+	}
+}
+
+func TestFromM3U(t *testing.T) {
+	fsys := fstest.MapFS{
+		"lists/game.m3u": {Data: []byte("#EXTM3U\n" +
+			"#EXTINF:123,Title Screen\n" +
+			"title.xm\n" +
+			"\n" +
+			"#EXTLOOPS:-1\n" +
+			"levels\\level1.it\n" +
+			"# a comment\n" +
+			"#EXTINF:60, Credits \n" +
+			"#EXTLOOPS:3\n" +
+			"credits.s3m\n" +
+			"../shared/jingle.mod\n")},
+		"lists/title.xm":         {Data: []byte("title")},
+		"lists/levels/level1.it": {Data: []byte("level1")},
+	}
+	entries, err := FromM3U(fsys, "lists/game.m3u", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Entry{
+		{Path: "lists/title.xm", Title: "Title Screen", Format: "xm", Loops: 1},
+		{Path: "lists/levels/level1.it", Title: "level1.it", Format: "it", Loops: -1},
+		{Path: "lists/credits.s3m", Title: "Credits", Format: "s3m", Loops: 3},
+		{Path: "shared/jingle.mod", Title: "jingle.mod", Format: "mod", Loops: 1},
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("got %d entries, want %d", len(entries), len(want))
+	}
+	for i, e := range entries {
+		e.fsys = nil
+		if e != want[i] {
+			t.Errorf("entry %d: got %+v, want %+v", i, e, want[i])
+		}
+	}
+
+	data, err := entries[1].Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "level1" {
+		t.Errorf("read %q, want %q", data, "level1")
+	}
+}
+
+func TestFromM3UErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		m3u  string
+		want error
+	}{
+		{"empty", "#EXTM3U\n# nothing here\n", ErrEmpty},
+		{"unsupported", "song.wav\n", ErrUnsupportedFormat},
+		{"outside", "../../song.mod\n", ErrOutsideFS},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fsys := fstest.MapFS{"lists/game.m3u": {Data: []byte(tc.m3u)}}
+			if _, err := FromM3U(fsys, "lists/game.m3u", 0); !errors.Is(err, tc.want) {
+				t.Errorf("got %v, want %v", err, tc.want)
+			}
+		})
+	}
+}
+
+// TestOpenM3U checks that a playlist on disk can list songs in the directories above it
+func TestOpenM3U(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"music/lists/game.m3u": "../title.xm\n../../sfx/jingle.mod\nlocal.it\n",
+		"music/title.xm":       "title",
+		"sfx/jingle.mod":       "jingle",
+		"music/lists/local.it": "local",
+	}
+	for name, data := range files {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	entries, err := Open(filepath.Join(dir, "music", "lists", "game.m3u"), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct{ path, data string }{
+		{"music/title.xm", "title"},
+		{"sfx/jingle.mod", "jingle"},
+		{"music/lists/local.it", "local"},
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("got %d entries, want %d", len(entries), len(want))
+	}
+	for i, e := range entries {
+		if e.Path != want[i].path {
+			t.Errorf("entry %d: got path %s, want %s", i, e.Path, want[i].path)
+		}
+		data, err := e.Read()
+		if err != nil {
+			t.Errorf("entry %d: %v", i, err)
+			continue
+		}
+		if string(data) != want[i].data {
+			t.Errorf("entry %d: read %q, want %q", i, data, want[i].data)
+		}
+	}
+}
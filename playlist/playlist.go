@@ -0,0 +1,178 @@
+// Package playlist keeps a list of songs to play one after another, with shuffle and repeat, the way a music
+// player does
+package playlist
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Repeat is what happens once the last song of a playlist has ended
+type Repeat int
+
+const (
+	// RepeatOff stops at the end of the playlist
+	RepeatOff = Repeat(iota)
+	// RepeatAll starts the playlist over
+	RepeatAll
+	// RepeatOne plays the same song over and over, instead of moving on to the next one
+	RepeatOne
+)
+
+func (r Repeat) String() string {
+	switch r {
+	case RepeatAll:
+		return "all"
+	case RepeatOne:
+		return "one"
+	default:
+		return "off"
+	}
+}
+
+// Playlist is a list of songs and the order they play in
+// Songs are numbered by their place in the list, which does not change when the playlist is shuffled.
+type Playlist struct {
+	entries []Entry
+	// order holds the song numbers in the order they play in, and pos is the place of the current song in it
+	order []int
+	pos   int
+
+	shuffle bool
+	repeat  Repeat
+	rand    *rand.Rand
+}
+
+// New creates a playlist of `entries`, starting at the first one
+func New(entries []Entry) *Playlist {
+	p := &Playlist{
+		entries: entries,
+		rand:    rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	p.resetOrder()
+	return p
+}
+
+// Len returns the number of songs in the playlist
+func (p *Playlist) Len() int {
+	return len(p.entries)
+}
+
+// Entry returns song `i`
+func (p *Playlist) Entry(i int) Entry {
+	return p.entries[i]
+}
+
+// Current returns the number of the song playing now
+func (p *Playlist) Current() int {
+	if len(p.order) == 0 {
+		return 0
+	}
+	return p.order[p.pos]
+}
+
+// Next moves on to the song that plays once the current one has ended, following the repeat mode, and
+// returns its number
+// It returns false, and stays put, when the playlist has come to its end.
+func (p *Playlist) Next() (int, bool) {
+	if len(p.order) == 0 {
+		return 0, false
+	}
+	switch {
+	case p.repeat == RepeatOne:
+	case p.pos+1 < len(p.order):
+		p.pos++
+	case p.repeat == RepeatAll:
+		if p.shuffle {
+			p.reshuffleAfter(p.Current())
+		}
+		p.pos = 0
+	default:
+		return p.Current(), false
+	}
+	return p.Current(), true
+}
+
+// Skip moves `n` songs forwards, or backwards when it is negative, wrapping around at either end of the
+// playlist, and returns the number of the song it lands on
+func (p *Playlist) Skip(n int) int {
+	if len(p.order) == 0 {
+		return 0
+	}
+	p.pos = ((p.pos+n)%len(p.order) + len(p.order)) % len(p.order)
+	return p.Current()
+}
+
+// Select moves to song `i`
+func (p *Playlist) Select(i int) {
+	for pos, e := range p.order {
+		if e == i {
+			p.pos = pos
+			return
+		}
+	}
+}
+
+// SetShuffle turns shuffling on or off
+// Shuffling puts the songs in a random order that starts with the current song, and turning it off goes back
+// to the order of the list, carrying on from the current song.
+func (p *Playlist) SetShuffle(shuffle bool) {
+	if p.shuffle == shuffle {
+		return
+	}
+	p.shuffle = shuffle
+	cur := p.Current()
+	if shuffle {
+		p.reshuffle(cur)
+		p.pos = 0
+		return
+	}
+	p.resetOrder()
+	p.pos = cur
+}
+
+// Shuffle returns true if the playlist is shuffled
+func (p *Playlist) Shuffle() bool {
+	return p.shuffle
+}
+
+// SetRepeat sets what happens when a song ends
+func (p *Playlist) SetRepeat(r Repeat) {
+	p.repeat = r
+}
+
+// Repeat returns what happens when a song ends
+func (p *Playlist) Repeat() Repeat {
+	return p.repeat
+}
+
+func (p *Playlist) resetOrder() {
+	p.order = make([]int, len(p.entries))
+	for i := range p.order {
+		p.order[i] = i
+	}
+}
+
+// reshuffle puts the songs in a random order, with song `first` at the start unless it is negative
+func (p *Playlist) reshuffle(first int) {
+	p.order = p.rand.Perm(len(p.entries))
+	if first < 0 {
+		return
+	}
+	for i, e := range p.order {
+		if e == first {
+			p.order[0], p.order[i] = p.order[i], p.order[0]
+			break
+		}
+	}
+}
+
+// reshuffleAfter puts the songs in a random order for another time through the playlist, which does not
+// start with song `last`, the one that has just ended, unless it is the only song
+func (p *Playlist) reshuffleAfter(last int) {
+	p.reshuffle(-1)
+	if len(p.order) > 1 && p.order[0] == last {
+		i := 1 + p.rand.Intn(len(p.order)-1)
+		p.order[0], p.order[i] = p.order[i], p.order[0]
+	}
+}
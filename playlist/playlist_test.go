@@ -0,0 +1,190 @@
+package playlist
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// newTestPlaylist creates a playlist of `n` songs that shuffles the same way every time
+func newTestPlaylist(n int, seed int64) *Playlist {
+	entries := make([]Entry, n)
+	p := New(entries)
+	p.rand = rand.New(rand.NewSource(seed))
+	return p
+}
+
+// playThrough calls Next `n` times and returns the songs it moves to, and -1 for the calls that return false
+func playThrough(p *Playlist, n int) []int {
+	var got []int
+	for i := 0; i < n; i++ {
+		song, ok := p.Next()
+		if !ok {
+			song = -1
+		}
+		got = append(got, song)
+	}
+	return got
+}
+
+func TestNext(t *testing.T) {
+	tests := []struct {
+		repeat Repeat
+		want   []int
+	}{
+		{RepeatOff, []int{1, 2, -1, -1}},
+		{RepeatAll, []int{1, 2, 0, 1}},
+		{RepeatOne, []int{0, 0, 0, 0}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.repeat.String(), func(t *testing.T) {
+			p := newTestPlaylist(3, 1)
+			p.SetRepeat(tc.repeat)
+			if got := playThrough(p, len(tc.want)); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNextEmpty(t *testing.T) {
+	p := New(nil)
+	p.SetRepeat(RepeatAll)
+	if _, ok := p.Next(); ok {
+		t.Error("an empty playlist moved on")
+	}
+}
+
+// isPermutation returns true if `order` holds every song of a playlist of `n` songs once
+func isPermutation(order []int, n int) bool {
+	sorted := append([]int(nil), order...)
+	sort.Ints(sorted)
+	for i, song := range sorted {
+		if song != i {
+			return false
+		}
+	}
+	return len(sorted) == n
+}
+
+// TestNextShuffleRepeatAll checks that every time through a shuffled playlist plays every song once, and that
+// the song that ended a time through does not start the next one
+func TestNextShuffleRepeatAll(t *testing.T) {
+	const songs = 3
+	for seed := int64(0); seed < 50; seed++ {
+		p := newTestPlaylist(songs, seed)
+		p.SetRepeat(RepeatAll)
+		p.SetShuffle(true)
+		// the first time through starts with the song that was playing
+		playThrough(p, songs-1)
+		last := p.Current()
+		for pass := 0; pass < 10; pass++ {
+			order := playThrough(p, songs)
+			if !isPermutation(order, songs) {
+				t.Fatalf("seed %d: time %d through played %v", seed, pass, order)
+			}
+			if order[0] == last {
+				t.Fatalf("seed %d: song %d played twice in a row", seed, last)
+			}
+			last = order[songs-1]
+		}
+	}
+}
+
+func TestNextShuffleRepeatAllOneSong(t *testing.T) {
+	p := newTestPlaylist(1, 1)
+	p.SetRepeat(RepeatAll)
+	p.SetShuffle(true)
+	if got := playThrough(p, 3); !reflect.DeepEqual(got, []int{0, 0, 0}) {
+		t.Errorf("got %v, want [0 0 0]", got)
+	}
+}
+
+func TestSkip(t *testing.T) {
+	tests := []struct {
+		n    int
+		want int
+	}{
+		{1, 2},
+		{2, 3},
+		{3, 0},
+		{-1, 0},
+		{-2, 3},
+		{-9, 0},
+		{0, 1},
+	}
+	for _, tc := range tests {
+		p := newTestPlaylist(4, 1)
+		p.Select(1)
+		if got := p.Skip(tc.n); got != tc.want {
+			t.Errorf("Skip(%d) from song 1: got %d, want %d", tc.n, got, tc.want)
+		}
+	}
+}
+
+// TestSkipRepeat checks that skipping wraps around whatever the repeat mode, which only applies when a song
+// ends
+func TestSkipRepeat(t *testing.T) {
+	for _, repeat := range []Repeat{RepeatOff, RepeatAll, RepeatOne} {
+		p := newTestPlaylist(3, 1)
+		p.SetRepeat(repeat)
+		p.Select(2)
+		if got := p.Skip(1); got != 0 {
+			t.Errorf("repeat %v: got %d, want 0", repeat, got)
+		}
+	}
+}
+
+func TestSetShuffle(t *testing.T) {
+	const songs = 5
+	tests := []struct {
+		repeat Repeat
+		// nexts is how many times Next moves on after shuffling, before the playlist ends
+		nexts int
+	}{
+		{RepeatOff, songs - 1},
+		{RepeatAll, -1},
+		{RepeatOne, -1},
+	}
+	for _, tc := range tests {
+		t.Run(tc.repeat.String(), func(t *testing.T) {
+			p := newTestPlaylist(songs, 1)
+			p.SetRepeat(tc.repeat)
+			p.Select(2)
+
+			p.SetShuffle(true)
+			if !p.Shuffle() {
+				t.Fatal("not shuffled")
+			}
+			if got := p.Current(); got != 2 {
+				t.Fatalf("shuffling moved from song 2 to %d", got)
+			}
+			if p.order[0] != 2 || !isPermutation(p.order, songs) {
+				t.Fatalf("shuffled order %v does not start with song 2", p.order)
+			}
+
+			got := playThrough(p, 2*songs)
+			for i, song := range got {
+				switch {
+				case tc.repeat == RepeatOne && song != 2:
+					t.Fatalf("moved on to %v with repeat one", got)
+				case tc.nexts >= 0 && (i < tc.nexts) != (song >= 0):
+					t.Fatalf("got %v, want %d songs and then the end", got, tc.nexts)
+				}
+			}
+			if tc.repeat == RepeatOff && !isPermutation(append(got[:songs-1:songs-1], 2), songs) {
+				t.Fatalf("played %v after song 2", got[:songs-1])
+			}
+
+			cur := p.Current()
+			p.SetShuffle(false)
+			if got := p.Current(); got != cur {
+				t.Fatalf("unshuffling moved from song %d to %d", cur, got)
+			}
+			if !reflect.DeepEqual(p.order, []int{0, 1, 2, 3, 4}) {
+				t.Fatalf("unshuffled order is %v", p.order)
+			}
+		})
+	}
+}
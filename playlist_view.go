@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+
+	"github.com/eliasdaler/ebiten-tracker-demo/playlist"
+)
+
+// drawPlaylist draws the songs of `pl` into `height` pixels of `screen`, starting at `y`, scrolled so that
+// the song called `playing` in the music manager is in view and highlighted
+func drawPlaylist(screen *ebiten.Image, pl *playlist.Playlist, playing string, y, height int) {
+	current := pl.Current()
+	for i := 0; i < pl.Len(); i++ {
+		if pl.Entry(i).Path == playing {
+			current = i
+			break
+		}
+	}
+
+	lines := height / glyphHeight
+	first := current - lines/2
+	if first > pl.Len()-lines {
+		first = pl.Len() - lines
+	}
+	if first < 0 {
+		first = 0
+	}
+
+	for i := first; i < pl.Len() && i < first+lines; i++ {
+		e := pl.Entry(i)
+		ly := y + (i-first)*glyphHeight
+		if i == current {
+			ebitenutil.DrawRect(screen, 0, float64(ly), screenWidth, glyphHeight, currentRowColor)
+		}
+		loops := fmt.Sprintf("%dx", e.Loops+1)
+		if e.Loops < 0 {
+			loops = "loop"
+		}
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("%3d. %-60s %4s  %s", i+1, e.Title, loops, e.Format), 0, ly)
+	}
+}
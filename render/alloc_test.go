@@ -0,0 +1,276 @@
+package render
+
+import (
+	"fmt"
+	"math"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/gotracker/playback/output"
+
+	"github.com/eliasdaler/ebiten-tracker-demo/analysis"
+	"github.com/eliasdaler/ebiten-tracker-demo/channelmix"
+	"github.com/eliasdaler/ebiten-tracker-demo/dsp"
+	"github.com/eliasdaler/ebiten-tracker-demo/internal/fixture"
+	"github.com/eliasdaler/ebiten-tracker-demo/music"
+	"github.com/eliasdaler/ebiten-tracker-demo/sfx"
+	"github.com/eliasdaler/ebiten-tracker-demo/timeline"
+)
+
+// allocTicks is how many ticks a song plays for before the allocations are counted, and then how many ticks from
+// its start they are counted over
+const allocTicks = 1500
+
+// allocCases are the songs generation is checked not to allocate for once the buffers have grown and the
+// periods of the song have been cached
+var allocCases = []struct {
+	format string
+	data   func(t testing.TB) []byte
+}{
+	{format: "mod", data: builtFixture(fixture.MOD)},
+	{format: "s3m", data: readFixture("../belthsar.s3m")},
+	{format: "xm", data: readFixture("../theme.xm")},
+	{format: "it", data: builtFixture(fixture.IT)},
+}
+
+// gamePath generates audio the way Game.GenerateSamples does: the music manager mixes a tick of the song with
+// the channel mixer applied to its premix, the tick is marked on the timeline, the sound effects mix into it,
+// it goes through the master chain and into the history the visualizers read, and it is encoded as float
+// PCM for the ring buffer, which the audio player then consumes
+type gamePath struct {
+	name     string
+	music    *music.Manager
+	mixer    *channelmix.Mixer
+	timeline *timeline.Timeline
+	sounds   *sfx.Engine
+	master   *dsp.Chain
+	history  *analysis.History
+	channels *analysis.History
+	split    []float32
+	pcm      []byte
+}
+
+func newGamePath(t testing.TB, format string, data []byte) *gamePath {
+	t.Helper()
+	// the song loops, as a playlist entry can, so that the ticks never reach its end
+	opts := DefaultOptions
+	opts.Loops = -1
+	opts.MaxDuration = time.Hour
+	player, err := Load(format, data, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rate, channels := DefaultOptions.SampleRate, DefaultOptions.Channels
+	g := &gamePath{
+		name:     format,
+		music:    music.New(rate, channels),
+		mixer:    channelmix.New(rate),
+		timeline: timeline.New(rate),
+		sounds:   sfx.New(rate, channels, 4),
+		master:   dsp.NewChain(),
+		history:  analysis.NewHistory(channels, rate),
+		channels: analysis.NewHistory(16, rate),
+	}
+
+	// every stage of the master chain and a ramping channel setting are on, so that all of their work is counted
+	eq := dsp.NewEQ(rate, channels)
+	eq.SetGains(5, -3, 4)
+	g.master.Add("eq", eq)
+	reverb := dsp.NewReverb(rate, channels)
+	reverb.RoomSize, reverb.Mix = 0.85, 0.3
+	g.master.Add("reverb", reverb)
+	width := dsp.NewWidth(channels)
+	width.Amount = 1.5
+	g.master.Add("width", width)
+	g.master.Add("limiter", dsp.NewLimiter(rate, channels))
+	g.master.Add("soft clip", dsp.NewSoftClipper())
+	g.mixer.FadeVolume(0, 0.5, time.Second)
+	g.mixer.SetPan(1, -0.5)
+
+	g.music.Add(g.name, player)
+	g.music.OnPremix(func(name string, premix *output.PremixData) {
+		g.mixer.Apply(premix)
+	})
+	g.timeline.SetLatency(time.Second / 20)
+	g.timeline.OnRow(func(timeline.Event) {})
+	g.timeline.OnNote(timeline.Any, timeline.Any, func(timeline.Event) {})
+	g.timeline.OnEffect('Z', func(timeline.Event) {})
+	if err := g.music.Play(g.name, music.Transition{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := g.sounds.Play(sfx.Sound{Song: player, Instrument: 1, Note: 48, Volume: 0.5}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the song plays for a while first, for its buffers to grow to the sizes they keep
+	for i := 0; i < allocTicks; i++ {
+		if err := g.tick(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return g
+}
+
+// tick generates the next tick
+func (g *gamePath) tick() error {
+	b, err := g.music.Next()
+	if err != nil {
+		return err
+	}
+
+	if p, ok := g.music.Song(b.Song); ok && b.Premix != nil {
+		g.timeline.MarkPremix(p, b.Premix)
+	} else {
+		g.timeline.Skip(b.Frames)
+	}
+
+	g.sounds.Read(b.Samples)
+	g.master.Process(b.Samples)
+
+	g.history.Write(b.Samples)
+	if b.Premix == nil {
+		g.channels.Skip(b.Frames)
+	} else {
+		g.split = analysis.SplitPremix(g.split[:0], b.Premix, g.channels.Channels())
+		g.channels.Write(g.split)
+	}
+
+	g.pcm = AppendPCM(g.pcm[:0], b.Samples, 32)
+
+	// the audio player takes everything, and the game looks at what is being heard
+	g.timeline.Dispatch(g.timeline.Written())
+	g.timeline.Heard(g.timeline.Written())
+	return nil
+}
+
+// restart starts the song over from the top, the way the game seeks to an order
+func (g *gamePath) restart() error {
+	return g.music.Seek(0, 0)
+}
+
+// allocTries is how many times allocs plays the ticks over
+// The runtime fills in its caches of type assertions at random times, which allocates now and then until they are
+// full, so a few tries keep that from failing the tests.
+const allocTries = 3
+
+// countAllocs runs `run` a few times, calling `start` before each one when it is set, and returns the fewest
+// allocations a run made
+// Only `run` is counted, as starting over from the same place allocates in the players.
+func countAllocs(t testing.TB, start, run func() error) uint64 {
+	t.Helper()
+	defer runtime.GOMAXPROCS(runtime.GOMAXPROCS(1))
+
+	var before, after runtime.MemStats
+	fewest := uint64(math.MaxUint64)
+	for try := 0; try < allocTries && fewest > 0; try++ {
+		if start != nil {
+			if err := start(); err != nil {
+				t.Fatal(err)
+			}
+		}
+		runtime.ReadMemStats(&before)
+		err := run()
+		runtime.ReadMemStats(&after)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if allocs := after.Mallocs - before.Mallocs; allocs < fewest {
+			fewest = allocs
+		}
+	}
+	return fewest
+}
+
+// allocs generates `ticks` ticks from the start of the song and returns the fewest allocations they made
+// newGamePath plays the same ticks first, so the counted ones play notes and periods that have been played before.
+func (g *gamePath) allocs(t testing.TB, ticks int) uint64 {
+	t.Helper()
+	return countAllocs(t, g.restart, func() error {
+		for i := 0; i < ticks; i++ {
+			if err := g.tick(); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func TestGenerateAllocs(t *testing.T) {
+	for _, tc := range allocCases {
+		tc := tc
+		t.Run(tc.format, func(t *testing.T) {
+			g := newGamePath(t, tc.format, tc.data(t))
+			if allocs := g.allocs(t, allocTicks); allocs != 0 {
+				t.Errorf("%d ticks made %d allocations, want none", allocTicks, allocs)
+			}
+		})
+	}
+}
+
+func BenchmarkGenerate(b *testing.B) {
+	for _, tc := range allocCases {
+		tc := tc
+		b.Run(tc.format, func(b *testing.B) {
+			g := newGamePath(b, tc.format, tc.data(b))
+			if allocs := g.allocs(b, allocTicks); allocs != 0 {
+				b.Fatalf("%d ticks made %d allocations, want none", allocTicks, allocs)
+			}
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if err := g.tick(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// TestRendererAllocs checks that the renderer the command line tools use does not allocate either, while it
+// splits the song into stems and holds back audio for a fade-out
+func TestRendererAllocs(t *testing.T) {
+	for _, mode := range []StemMode{StemsNone, StemsChannel, StemsInstrument} {
+		for _, tc := range allocCases {
+			tc := tc
+			t.Run(fmt.Sprintf("%s/stems%d", tc.format, mode), func(t *testing.T) {
+				opts := DefaultOptions
+				opts.Loops = -1
+				opts.MaxDuration = time.Hour
+				opts.FadeOut = time.Second
+				player, err := Load(tc.format, tc.data(t), opts)
+				if err != nil {
+					t.Fatal(err)
+				}
+				r, err := NewRenderer(player, opts)
+				if err != nil {
+					t.Fatal(err)
+				}
+				r.SetStemMode(mode)
+
+				var pcm []byte
+				next := func(ticks int) error {
+					for i := 0; i < ticks; i++ {
+						b, err := r.Next()
+						if err != nil {
+							return err
+						}
+						pcm = AppendPCM(pcm[:0], b.Master, opts.BitsPerSample)
+					}
+					return nil
+				}
+				// the counted ticks start over from the top, so they play notes and periods that the warm-up has
+				// played before
+				if err := next(allocTicks); err != nil {
+					t.Fatal(err)
+				}
+				start := func() error {
+					return player.Seek(0, 0)
+				}
+				if allocs := countAllocs(t, start, func() error { return next(allocTicks) }); allocs != 0 {
+					t.Errorf("%d ticks made %d allocations, want none", allocTicks, allocs)
+				}
+			})
+		}
+	}
+}
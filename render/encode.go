@@ -0,0 +1,88 @@
+package render
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+
+	"github.com/gotracker/goaudiofile/audio/wav"
+)
+
+// AppendPCM encodes interleaved samples in the range -1 to 1 as WAV-style PCM data and appends it to `dst`
+// 8-bit data is unsigned, 16- and 24-bit data is signed little-endian and 32-bit data is little-endian float.
+// Samples outside that range are clipped.
+func AppendPCM(dst []byte, samples []float32, bitsPerSample int) []byte {
+	for _, v := range samples {
+		switch bitsPerSample {
+		case 8:
+			dst = append(dst, uint8(quantize(v, 8)+128))
+		case 16:
+			dst = binary.LittleEndian.AppendUint16(dst, uint16(quantize(v, 16)))
+		case 24:
+			s := quantize(v, 24)
+			dst = append(dst, uint8(s), uint8(s>>8), uint8(s>>16))
+		case 32:
+			dst = binary.LittleEndian.AppendUint32(dst, math.Float32bits(clip(v)))
+		}
+	}
+	return dst
+}
+
+// clip keeps a sample in the range -1 to 1
+func clip(v float32) float32 {
+	switch {
+	case v < -1:
+		return -1
+	case v > 1:
+		return 1
+	default:
+		return v
+	}
+}
+
+// quantize converts a sample into a signed integer of `bits` bits, clipping it to the valid range
+func quantize(v float32, bits int) int32 {
+	scale := float64(int32(1) << (bits - 1))
+	s := math.Round(float64(v) * scale)
+	switch {
+	case s < -scale:
+		return int32(-scale)
+	case s > scale-1:
+		return int32(scale - 1)
+	default:
+		return int32(s)
+	}
+}
+
+// FmtChunk returns the WAV fmt chunk describing the output of a render with these options
+func (o Options) FmtChunk() wav.FmtChunk {
+	format := wav.FormatPCM
+	if o.BitsPerSample == 32 {
+		format = wav.FormatIEEEFloat
+	}
+	return wav.NewFmtChunk(format, o.Channels, o.SampleRate, o.BitsPerSample)
+}
+
+// WriteWAV renders the song in `r` until it ends and writes it to `w` as a WAV file
+func WriteWAV(w io.Writer, r *Renderer) error {
+	ww, err := wav.NewWriter(w, r.opts.FmtChunk())
+	if err != nil {
+		return err
+	}
+
+	var buf []byte
+	for {
+		b, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		buf = AppendPCM(buf[:0], b.Master, r.opts.BitsPerSample)
+		if _, err := ww.Write(buf); err != nil {
+			return err
+		}
+	}
+	return ww.Close()
+}
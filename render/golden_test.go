@@ -0,0 +1,288 @@
+package render
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gotracker/playback/voice/interpolation"
+
+	"github.com/eliasdaler/ebiten-tracker-demo/internal/fixture"
+)
+
+// update rewrites the goldens with the renders of the code as it is, for when a change to the audio is meant
+//
+//	go test ./render -run Golden -update
+var update = flag.Bool("update", false, "rewrite the golden renders in testdata/golden")
+
+const (
+	// goldenWindow is the length of the windows the levels of a golden render are kept for
+	goldenWindow = 10 * time.Millisecond
+	// goldenTolerance is how far the level of a window can be from its golden, in dB, for renders that are not
+	// the same sample for sample
+	// Small changes to the mixing, such as rounding, move the levels by far less than this, while a note that
+	// goes missing or changes its pitch, volume or timing moves them by much more.
+	goldenTolerance = 0.5
+	// goldenFloor is the level under which windows count as silent, and are not compared, in dBFS
+	goldenFloor = -60
+)
+
+// goldenOptions are the fixed settings the goldens are rendered with
+var goldenOptions = Options{
+	SampleRate:    44100,
+	Channels:      2,
+	BitsPerSample: 16,
+	MaxDuration:   8 * time.Second,
+}
+
+// goldenCases are the songs and settings that are rendered and checked against their goldens
+var goldenCases = []struct {
+	name   string
+	format string
+	data   func(t testing.TB) []byte
+	// options changes the settings from goldenOptions
+	options func(o *Options)
+}{
+	{name: "belthsar", format: "s3m", data: readFixture("../belthsar.s3m")},
+	{name: "theme", format: "xm", data: readFixture("../theme.xm")},
+	{name: "theme_mono_cubic", format: "xm", data: readFixture("../theme.xm"), options: func(o *Options) {
+		o.SampleRate = 22050
+		o.Channels = 1
+		o.Interpolation = interpolation.Cubic
+		o.MaxDuration = 4 * time.Second
+	}},
+	{name: "fixture_mod", format: "mod", data: builtFixture(fixture.MOD)},
+	{name: "fixture_it", format: "it", data: builtFixture(fixture.IT)},
+}
+
+// builtFixture returns the data of a fixture song built in memory
+func builtFixture(build func() []byte) func(t testing.TB) []byte {
+	return func(t testing.TB) []byte {
+		return build()
+	}
+}
+
+func readFixture(path string) func(t testing.TB) []byte {
+	return func(t testing.TB) []byte {
+		t.Helper()
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+}
+
+// golden is what is kept of a render to check later renders against
+type golden struct {
+	Frames int `json:"frames"`
+	// SHA256 is the hash of the PCM data; renders with the same hash are the same sample for sample
+	SHA256 string `json:"sha256"`
+	// Levels holds the RMS level of every channel over every window, in dBFS
+	Levels [][]float64 `json:"levels"`
+	// Highs holds the RMS level of the difference between each sample and the one before it, which follows
+	// the high frequencies, such as the ones that interpolation changes
+	Highs [][]float64 `json:"highs"`
+}
+
+// renderGolden renders the song in `data` with `opts` and works out what is kept of it
+func renderGolden(t *testing.T, format string, data []byte, opts Options) golden {
+	t.Helper()
+	player, err := Load(format, data, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := NewRenderer(player, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	windowFrames := durationToFrames(goldenWindow, opts.SampleRate)
+	g := golden{
+		Levels: make([][]float64, opts.Channels),
+		Highs:  make([][]float64, opts.Channels),
+	}
+	sums := make([]float64, opts.Channels)
+	highSums := make([]float64, opts.Channels)
+	last := make([]float64, opts.Channels)
+	frames := 0
+	endWindow := func() {
+		for c := range sums {
+			g.Levels[c] = append(g.Levels[c], rmsDB(sums[c], frames))
+			g.Highs[c] = append(g.Highs[c], rmsDB(highSums[c], frames))
+			sums[c], highSums[c] = 0, 0
+		}
+		frames = 0
+	}
+
+	hash := sha256.New()
+	var pcm []byte
+	for {
+		b, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		pcm = AppendPCM(pcm[:0], b.Master, opts.BitsPerSample)
+		hash.Write(pcm)
+		for i := 0; i < b.Frames; i++ {
+			for c := range sums {
+				v := float64(b.Master[i*opts.Channels+c])
+				sums[c] += v * v
+				highSums[c] += (v - last[c]) * (v - last[c])
+				last[c] = v
+			}
+			if frames++; frames == windowFrames {
+				endWindow()
+			}
+		}
+	}
+	if frames > 0 {
+		endWindow()
+	}
+	g.Frames = r.Frames()
+	g.SHA256 = hex.EncodeToString(hash.Sum(nil))
+	return g
+}
+
+// rmsDB returns the RMS level of `frames` samples whose squares add up to `sum`, in dBFS rounded to 0.01 dB
+func rmsDB(sum float64, frames int) float64 {
+	db := 10 * math.Log10(sum/float64(frames))
+	if math.IsInf(db, -1) || db < -120 {
+		return -120
+	}
+	return math.Round(db*100) / 100
+}
+
+func TestGoldenRenders(t *testing.T) {
+	for _, tc := range goldenCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			opts := goldenOptions
+			if tc.options != nil {
+				tc.options(&opts)
+			}
+			got := renderGolden(t, tc.format, tc.data(t), opts)
+
+			path := filepath.Join("testdata", "golden", tc.name+".json")
+			if *update {
+				writeGolden(t, path, got)
+				return
+			}
+			want := readGolden(t, path)
+			compareGolden(t, got, want)
+		})
+	}
+}
+
+func compareGolden(t *testing.T, got, want golden) {
+	t.Helper()
+	if got.SHA256 == want.SHA256 {
+		return
+	}
+	if got.Frames != want.Frames {
+		t.Fatalf("rendered %d frames, golden has %d", got.Frames, want.Frames)
+	}
+	if len(got.Levels) != len(want.Levels) || len(got.Highs) != len(want.Highs) {
+		t.Fatalf("rendered %d channels, golden has %d", len(got.Levels), len(want.Levels))
+	}
+	failures := 0
+	compare := func(what string, got, want [][]float64) {
+		for c := range got {
+			for i, level := range got[c] {
+				golden := want[c][i]
+				if level < goldenFloor && golden < goldenFloor {
+					continue
+				}
+				if math.Abs(level-golden) <= goldenTolerance {
+					continue
+				}
+				if failures++; failures <= 10 {
+					t.Errorf("channel %d at %v: %s %.2f dBFS, golden %.2f dBFS", c, time.Duration(i)*goldenWindow,
+						what, level, golden)
+				}
+			}
+		}
+	}
+	compare("level", got.Levels, want.Levels)
+	compare("level of the highs", got.Highs, want.Highs)
+	if failures > 10 {
+		t.Errorf("and %d more windows", failures-10)
+	}
+	if failures == 0 {
+		t.Logf("the render is not the same as the golden sample for sample, but its levels are within %v dB",
+			goldenTolerance)
+	}
+}
+
+func readGolden(t *testing.T, path string) golden {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v (run with -update to write the goldens)", err)
+	}
+	var g golden
+	if err := json.Unmarshal(data, &g); err != nil {
+		t.Fatalf("%s: %v", path, err)
+	}
+	return g
+}
+
+func writeGolden(t *testing.T, path string, g golden) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// TestFixtures checks that the handcrafted fixtures play through to their ends with sound on every channel
+func TestFixtures(t *testing.T) {
+	fixtures := []struct {
+		format string
+		data   func(t testing.TB) []byte
+		// length is how long the song plays
+		length time.Duration
+	}{
+		// 64 rows and 17 rows at speed 5 and 125 BPM
+		{"mod", builtFixture(fixture.MOD), 81 * 5 * 20 * time.Millisecond},
+		// 32 rows twice, at speed 4 and 150 BPM
+		{"it", builtFixture(fixture.IT), 64 * 4 * 50 * time.Millisecond / 3},
+	}
+	for _, f := range fixtures {
+		t.Run(f.format, func(t *testing.T) {
+			opts := goldenOptions
+			opts.MaxDuration = 0
+			g := renderGolden(t, f.format, f.data(t), opts)
+			got := time.Duration(g.Frames) * time.Second / time.Duration(opts.SampleRate)
+			if d := got - f.length; d < -50*time.Millisecond || d > 50*time.Millisecond {
+				t.Errorf("played for %v, want %v", got, f.length)
+			}
+			for c, levels := range g.Levels {
+				loudest := -120.0
+				for _, l := range levels {
+					loudest = math.Max(loudest, l)
+				}
+				if loudest < -30 {
+					t.Errorf("channel %d peaks at %.1f dBFS", c, loudest)
+				}
+			}
+		})
+	}
+}
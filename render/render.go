@@ -0,0 +1,428 @@
+// Package render renders tracked music into PCM data as fast as possible, without an audio device
+package render
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/gotracker/gomixing/mixing"
+	"github.com/gotracker/playback"
+	"github.com/gotracker/playback/format"
+	"github.com/gotracker/playback/instrument"
+	"github.com/gotracker/playback/output"
+	"github.com/gotracker/playback/player/feature"
+	playerrender "github.com/gotracker/playback/player/render"
+	"github.com/gotracker/playback/song"
+	"github.com/gotracker/playback/voice/interpolation"
+)
+
+var (
+	// ErrInvalidChannels is for when the number of output channels is not supported by the mixer
+	ErrInvalidChannels = errors.New("invalid number of channels")
+	// ErrInvalidBitsPerSample is for when the output bit depth is not one of 8, 16, 24 or 32
+	ErrInvalidBitsPerSample = errors.New("invalid bits per sample")
+	// ErrEndlessRender is for when the song loops forever and there is no maximum duration to stop it
+	ErrEndlessRender = errors.New("endless song loop requires a maximum duration")
+)
+
+// Options are the settings of an offline render
+type Options struct {
+	SampleRate int
+	Channels   int
+	// BitsPerSample is 8, 16 or 24 for integer PCM, or 32 for floating-point data
+	BitsPerSample int
+	// Loops is the number of times the song repeats after it has played through once; negative loops forever
+	Loops int
+	// FadeOut is how long the end of the render fades out for
+	FadeOut time.Duration
+	// MaxDuration stops the render after this long; zero renders until the song ends
+	MaxDuration time.Duration
+	// Interpolation is how the instruments are resampled to the sample rate
+	Interpolation interpolation.Mode
+}
+
+// DefaultOptions are the render options matching the live demo output
+var DefaultOptions = Options{
+	SampleRate:    44100,
+	Channels:      2,
+	BitsPerSample: 16,
+}
+
+// Features returns the player features used for a render with these options
+func (o Options) Features() []feature.Feature {
+	var features []feature.Feature
+	features = append(features, feature.UseNativeSampleFormat(true))
+	features = append(features, feature.IgnoreUnknownEffect{Enabled: true})
+	features = append(features, feature.SongLoop{Count: o.Loops})
+	features = append(features, feature.Interpolation{Mode: o.Interpolation})
+	// every tick is mixed down before the next one is generated, so the player can render into the same buffers
+	features = append(features, feature.ReuseBuffers{Enabled: true})
+	return features
+}
+
+func (o Options) validate() error {
+	switch o.Channels {
+	case 1, 2, 4:
+	default:
+		return ErrInvalidChannels
+	}
+	switch o.BitsPerSample {
+	case 8, 16, 24, 32:
+	default:
+		return ErrInvalidBitsPerSample
+	}
+	if o.Loops < 0 && o.MaxDuration <= 0 {
+		return ErrEndlessRender
+	}
+	return nil
+}
+
+// Load loads the song in `data` and sets it up for rendering with the options `opts`
+// The format `name` is one of "mod", "s3m", "xm" or "it" - when empty, every format is tried.
+func Load(name string, data []byte, opts Options) (playback.Playback, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	features := opts.Features()
+	player, _, err := format.LoadFromReader(name, bytes.NewReader(data), features...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := player.SetupSampler(opts.SampleRate, opts.Channels); err != nil {
+		return nil, err
+	}
+
+	if err := player.Configure(features); err != nil {
+		return nil, err
+	}
+	return player, nil
+}
+
+// StemMode selects how a render is split into stems
+type StemMode int
+
+const (
+	// StemsNone renders only the master mix
+	StemsNone = StemMode(iota)
+	// StemsChannel renders one stem per song channel
+	StemsChannel
+	// StemsInstrument renders one stem per instrument
+	StemsInstrument
+)
+
+// Block is a piece of rendered audio, as interleaved samples in the range -1 to 1
+type Block struct {
+	Frames int
+	// Order and Row are where in the song the block was rendered from
+	Order  int
+	Row    int
+	Master []float32
+	// Stems holds the stems that have sound in this block, keyed by stem name
+	// Summing the stems of a block gives the master mix, give or take rounding.
+	Stems map[string][]float32
+
+	// stemBufs holds the samples of every stem the block has had, for the next time it is used
+	stemBufs map[string][]float32
+}
+
+// Renderer pulls ticks out of a player and mixes them down to interleaved floating-point samples
+type Renderer struct {
+	player playback.Playback
+	opts   Options
+	stems  StemMode
+	flat   *playerrender.Flattener
+
+	frames     int
+	maxFrames  int
+	fadeFrames int
+	held       []*Block
+	heldFrames int
+	done       bool
+	faded      bool
+
+	// free holds the blocks that can be used again, and out the block Next returned last, which is freed the
+	// next time it is called
+	free []*Block
+	out  *Block
+
+	// groups, names and parts are where mix sorts the data of a tick into stems, kept from tick to tick
+	groups map[string][]mixing.ChannelData
+	names  []string
+	parts  []mixing.Data
+	// channelStems and instrumentStems hold the stem names made so far, as making one allocates
+	channelStems    []string
+	instrumentStems map[instrument.ID]string
+}
+
+// NewRenderer creates a Renderer for the loaded song `player`
+// The player has to be set up with the same sample rate and channel count as `opts`, which Load does.
+func NewRenderer(player playback.Playback, opts Options) (*Renderer, error) {
+	if err := opts.validate(); err != nil {
+		return nil, err
+	}
+
+	r := Renderer{
+		player:          player,
+		opts:            opts,
+		flat:            playerrender.NewFlattener(opts.Channels),
+		maxFrames:       durationToFrames(opts.MaxDuration, opts.SampleRate),
+		fadeFrames:      durationToFrames(opts.FadeOut, opts.SampleRate),
+		groups:          make(map[string][]mixing.ChannelData),
+		instrumentStems: make(map[instrument.ID]string),
+	}
+	return &r, nil
+}
+
+// SetStemMode sets how the following blocks are split into stems
+func (r *Renderer) SetStemMode(mode StemMode) {
+	r.stems = mode
+}
+
+// Frames returns the number of sample frames rendered so far
+func (r *Renderer) Frames() int {
+	return r.frames
+}
+
+// Elapsed returns the amount of song time rendered so far
+func (r *Renderer) Elapsed() time.Duration {
+	return time.Duration(r.frames) * time.Second / time.Duration(r.opts.SampleRate)
+}
+
+// Next renders the next tick of the song
+// When a fade-out is set, the last part of the song is held back until the end is known.
+// It returns io.EOF when the song (or the maximum duration) has ended. The block is only valid until Next is
+// called again, as its memory is used for the blocks after it.
+func (r *Renderer) Next() (*Block, error) {
+	if r.out != nil {
+		r.free = append(r.free, r.out)
+		r.out = nil
+	}
+	for {
+		if r.done {
+			if !r.faded {
+				r.fadeHeld()
+				r.faded = true
+			}
+			if len(r.held) == 0 {
+				return nil, io.EOF
+			}
+			r.out = r.unhold()
+			return r.out, nil
+		}
+
+		premix, err := r.player.Generate(0)
+		if err != nil {
+			if errors.Is(err, song.ErrStopSong) {
+				r.done = true
+				continue
+			}
+			return nil, err
+		}
+		if premix == nil || premix.SamplesLen == 0 {
+			continue
+		}
+
+		b := r.mix(premix)
+		if r.maxFrames > 0 && r.frames+b.Frames >= r.maxFrames {
+			b.truncate(r.maxFrames-r.frames, r.opts.Channels)
+			r.done = true
+		}
+		r.frames += b.Frames
+
+		if r.fadeFrames == 0 {
+			r.out = b
+			return b, nil
+		}
+
+		r.held = append(r.held, b)
+		r.heldFrames += b.Frames
+		if r.done || r.heldFrames-r.held[0].Frames < r.fadeFrames {
+			continue
+		}
+		// only whole blocks are released, so that at least the fade-out length is always held back
+		r.out = r.unhold()
+		r.heldFrames -= r.out.Frames
+		return r.out, nil
+	}
+}
+
+// unhold takes the oldest block off the blocks held back
+func (r *Renderer) unhold() *Block {
+	b := r.held[0]
+	r.held = append(r.held[:0], r.held[1:]...)
+	return b
+}
+
+// block returns a block to render into, which is one that was freed if there are any
+func (r *Renderer) block() *Block {
+	if n := len(r.free); n > 0 {
+		b := r.free[n-1]
+		r.free = r.free[:n-1]
+		return b
+	}
+	return &Block{
+		Stems:    make(map[string][]float32),
+		stemBufs: make(map[string][]float32),
+	}
+}
+
+// mix flattens the premix data into a block, splitting it into stems if needed
+func (r *Renderer) mix(premix *output.PremixData) *Block {
+	b := r.block()
+	b.Frames = premix.SamplesLen
+	b.Order, b.Row = 0, 0
+	if rr, ok := premix.Userdata.(*playerrender.RowRender); ok {
+		b.Order, b.Row = rr.Order, rr.Row
+	}
+
+	b.Master = r.flat.Append(b.Master[:0], premix.SamplesLen, premix.Data, premix.MixerVolume)
+	for name := range b.Stems {
+		delete(b.Stems, name)
+	}
+	if r.stems == StemsNone {
+		return b
+	}
+
+	for _, name := range r.names {
+		r.groups[name] = r.groups[name][:0]
+	}
+	r.names = r.names[:0]
+	r.parts = r.parts[:0]
+	add := func(name string, cd mixing.ChannelData) {
+		if len(r.groups[name]) == 0 {
+			r.names = append(r.names, name)
+		}
+		r.groups[name] = append(r.groups[name], cd)
+	}
+	for i, cd := range premix.Data {
+		if isSilent(cd) {
+			continue
+		}
+
+		var src output.PremixSource
+		if i < len(premix.Sources) {
+			src = premix.Sources[i]
+		} else {
+			src.Channel = -1
+		}
+
+		if r.stems == StemsChannel || src.Channel < 0 {
+			add(r.stemName(src, nil), cd)
+			continue
+		}
+		for j, d := range cd {
+			var id instrument.ID
+			if j < len(src.Instruments) {
+				id = src.Instruments[j]
+			}
+			// every instrument gets channel data of its own, cut out of the parts kept for the tick
+			r.parts = append(r.parts, d)
+			add(r.stemName(src, id), r.parts[len(r.parts)-1:])
+		}
+	}
+
+	// the master is flattened on its own, so that it is the same as the render without stems
+	for _, name := range r.names {
+		samples := r.flat.Append(b.stemBufs[name][:0], premix.SamplesLen, r.groups[name], premix.MixerVolume)
+		b.stemBufs[name] = samples
+		b.Stems[name] = samples
+	}
+	return b
+}
+
+// stemName returns the name of the stem the source belongs to, making it only the first time it is asked for
+func (r *Renderer) stemName(src output.PremixSource, id instrument.ID) string {
+	switch {
+	case src.Channel >= 0 && r.stems == StemsChannel:
+		for len(r.channelStems) <= src.Channel {
+			r.channelStems = append(r.channelStems, "")
+		}
+		if r.channelStems[src.Channel] == "" {
+			r.channelStems[src.Channel] = stemName(r.stems, src, id)
+		}
+		return r.channelStems[src.Channel]
+	case src.Channel >= 0 && id != nil:
+		name, ok := r.instrumentStems[id]
+		if !ok {
+			name = stemName(r.stems, src, id)
+			r.instrumentStems[id] = name
+		}
+		return name
+	}
+	return stemName(r.stems, src, id)
+}
+
+// isSilent returns true when the channel data has no sound at all, like the filler the player adds to empty ticks
+func isSilent(cd mixing.ChannelData) bool {
+	for _, d := range cd {
+		if len(d.Data) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// stemName returns the name of the stem the source belongs to
+func stemName(mode StemMode, src output.PremixSource, id instrument.ID) string {
+	switch {
+	case src.Channel < 0:
+		return "other"
+	case mode == StemsChannel:
+		return fmt.Sprintf("channel%02d", src.Channel+1)
+	case id == nil || id.IsEmpty():
+		return "instrument00"
+	}
+	if n, err := strconv.Atoi(id.String()); err == nil {
+		return fmt.Sprintf("instrument%02d", n)
+	}
+	return "instrument" + id.String()
+}
+
+func (b *Block) truncate(frames int, channels int) {
+	b.Frames = frames
+	b.Master = b.Master[:frames*channels]
+	for name, s := range b.Stems {
+		b.Stems[name] = s[:frames*channels]
+	}
+}
+
+// fadeHeld fades out the last part of the held blocks
+func (r *Renderer) fadeHeld() {
+	fadeFrames := r.fadeFrames
+	if fadeFrames > r.heldFrames {
+		fadeFrames = r.heldFrames
+	}
+
+	pos := fadeFrames - r.heldFrames
+	for _, b := range r.held {
+		for i := 0; i < b.Frames; i, pos = i+1, pos+1 {
+			if pos < 0 {
+				continue
+			}
+			gain := 1 - float32(pos+1)/float32(fadeFrames)
+			applyGain(b.Master, i, r.opts.Channels, gain)
+			for _, s := range b.Stems {
+				applyGain(s, i, r.opts.Channels, gain)
+			}
+		}
+	}
+}
+
+func applyGain(samples []float32, frame int, channels int, gain float32) {
+	for c := 0; c < channels; c++ {
+		samples[frame*channels+c] *= gain
+	}
+}
+
+func durationToFrames(d time.Duration, sampleRate int) int {
+	if d <= 0 {
+		return 0
+	}
+	return int(int64(d) * int64(sampleRate) / int64(time.Second))
+}
@@ -0,0 +1,103 @@
+package render
+
+import (
+	"errors"
+	"io"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/eliasdaler/ebiten-tracker-demo/internal/fixture"
+)
+
+// renderAll renders the whole of the song in `data` with stem mode `mode`
+func renderAll(t *testing.T, format string, data []byte, mode StemMode) []*Block {
+	t.Helper()
+	opts := DefaultOptions
+	opts.MaxDuration = 4 * time.Second
+	player, err := Load(format, data, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := NewRenderer(player, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.SetStemMode(mode)
+
+	var blocks []*Block
+	for {
+		b, err := r.Next()
+		if errors.Is(err, io.EOF) {
+			return blocks
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		blocks = append(blocks, cloneBlock(b))
+	}
+}
+
+// cloneBlock copies `b`, which the renderer uses again for later blocks
+func cloneBlock(b *Block) *Block {
+	c := *b
+	c.Master = append([]float32(nil), b.Master...)
+	c.Stems = make(map[string][]float32, len(b.Stems))
+	for name, s := range b.Stems {
+		c.Stems[name] = append([]float32(nil), s...)
+	}
+	c.stemBufs = nil
+	return &c
+}
+
+func TestStems(t *testing.T) {
+	songs := []struct {
+		format string
+		data   []byte
+	}{
+		{"mod", fixture.MOD()},
+		{"it", fixture.IT()},
+	}
+	modes := []struct {
+		name string
+		mode StemMode
+	}{
+		{"channel", StemsChannel},
+		{"instrument", StemsInstrument},
+	}
+	for _, s := range songs {
+		master := renderAll(t, s.format, s.data, StemsNone)
+		for _, m := range modes {
+			t.Run(s.format+"/"+m.name, func(t *testing.T) {
+				blocks := renderAll(t, s.format, s.data, m.mode)
+				if len(blocks) != len(master) {
+					t.Fatalf("rendered %d blocks, want %d", len(blocks), len(master))
+				}
+				stems := 0
+				for i, b := range blocks {
+					for j, v := range b.Master {
+						if v != master[i].Master[j] {
+							t.Fatalf("block %d sample %d: master is %v, without stems %v", i, j, v, master[i].Master[j])
+						}
+					}
+					// the samples are not clipped until they are encoded, so the stems add up to the master at any level
+					for j, v := range b.Master {
+						var sum float32
+						for _, stem := range b.Stems {
+							sum += stem[j]
+						}
+						if math.Abs(float64(sum-v)) > 1e-5 {
+							t.Fatalf("block %d sample %d: stems add up to %v, master is %v", i, j, sum, v)
+						}
+					}
+					if len(b.Stems) > stems {
+						stems = len(b.Stems)
+					}
+				}
+				if stems < 2 {
+					t.Errorf("at most %d stems in a block", stems)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package render
+
+import (
+	"io"
+
+	"github.com/gotracker/goaudiofile/audio/wav"
+)
+
+type stemWriter struct {
+	w      io.WriteCloser
+	ww     *wav.Writer
+	frames int
+}
+
+// WriteStemsWAV renders the song in `r` split into stems by `mode` and writes every stem to the writer
+// that `create` returns for its name, along with the master mix to `master`, all as WAV files
+// Every stem starts at the beginning of the song and runs to its end, so that the stems line up with
+// the master mix sample for sample. The stem writers are closed once the render is done.
+func WriteStemsWAV(master io.Writer, create func(name string) (io.WriteCloser, error), mode StemMode, r *Renderer) error {
+	r.SetStemMode(mode)
+	fmt := r.opts.FmtChunk()
+
+	mw, err := wav.NewWriter(master, fmt)
+	if err != nil {
+		return err
+	}
+
+	stems := make(map[string]*stemWriter)
+	var order []string
+	defer func() {
+		for _, name := range order {
+			_ = stems[name].w.Close()
+		}
+	}()
+
+	var (
+		buf     []byte
+		silence []float32
+		total   int
+	)
+	// writeSilence pads a stem with silence up to `frames` frames
+	writeSilence := func(sw *stemWriter, frames int) error {
+		for sw.frames < frames {
+			n := frames - sw.frames
+			if n > 4096 {
+				n = 4096
+			}
+			if len(silence) < n*r.opts.Channels {
+				silence = make([]float32, n*r.opts.Channels)
+			}
+			buf = AppendPCM(buf[:0], silence[:n*r.opts.Channels], r.opts.BitsPerSample)
+			if _, err := sw.ww.Write(buf); err != nil {
+				return err
+			}
+			sw.frames += n
+		}
+		return nil
+	}
+
+	for {
+		b, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		buf = AppendPCM(buf[:0], b.Master, r.opts.BitsPerSample)
+		if _, err := mw.Write(buf); err != nil {
+			return err
+		}
+
+		for name, samples := range b.Stems {
+			sw, ok := stems[name]
+			if !ok {
+				w, err := create(name)
+				if err != nil {
+					return err
+				}
+				sw = &stemWriter{
+					w: w,
+				}
+				stems[name] = sw
+				order = append(order, name)
+				if sw.ww, err = wav.NewWriter(w, fmt); err != nil {
+					return err
+				}
+			}
+			if err := writeSilence(sw, total); err != nil {
+				return err
+			}
+			buf = AppendPCM(buf[:0], samples, r.opts.BitsPerSample)
+			if _, err := sw.ww.Write(buf); err != nil {
+				return err
+			}
+			sw.frames += b.Frames
+		}
+		total += b.Frames
+	}
+
+	for _, name := range order {
+		sw := stems[name]
+		if err := writeSilence(sw, total); err != nil {
+			return err
+		}
+		if err := sw.ww.Close(); err != nil {
+			return err
+		}
+	}
+	return mw.Close()
+}
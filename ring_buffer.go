@@ -1,10 +1,18 @@
 package main
 
+import "sync"
+
 type RingBuffer struct {
+	m sync.Mutex
+
 	head     int
 	tail     int
 	capacity int
 
+	// consumed counts the buffered bytes that have been read out, leaving out the silence
+	// that Read makes up when the buffer runs dry
+	consumed int64
+
 	buf []byte
 }
 
@@ -21,6 +29,9 @@ func NewRingBuffer(capacity int) *RingBuffer {
 }
 
 func (rb *RingBuffer) Append(b byte) {
+	rb.m.Lock()
+	defer rb.m.Unlock()
+
 	if (rb.tail+1)%rb.capacity == rb.head {
 		panic("buffer overflow")
 	}
@@ -29,11 +40,33 @@ func (rb *RingBuffer) Append(b byte) {
 	rb.tail = (rb.tail + 1) % rb.capacity
 }
 
+// Write appends all of `p` at once, and panics like Append when it does not fit
+func (rb *RingBuffer) Write(p []byte) (int, error) {
+	rb.m.Lock()
+	defer rb.m.Unlock()
+
+	if rb.size()+len(p) >= rb.capacity {
+		panic("buffer overflow")
+	}
+
+	n := copy(rb.buf[rb.tail:], p)
+	copy(rb.buf, p[n:])
+	rb.tail = (rb.tail + len(p)) % rb.capacity
+	return len(p), nil
+}
+
 func (rb *RingBuffer) Empty() bool {
 	return rb.Size() == 0
 }
 
 func (rb *RingBuffer) Size() int {
+	rb.m.Lock()
+	defer rb.m.Unlock()
+
+	return rb.size()
+}
+
+func (rb *RingBuffer) size() int {
 	if rb.tail == rb.head {
 		return 0
 	}
@@ -45,17 +78,46 @@ func (rb *RingBuffer) Size() int {
 }
 
 func (rb *RingBuffer) Pop() byte {
-	if rb.Empty() {
+	rb.m.Lock()
+	defer rb.m.Unlock()
+
+	return rb.pop()
+}
+
+func (rb *RingBuffer) pop() byte {
+	if rb.size() == 0 {
 		return 0
 	}
 
 	b := rb.buf[rb.head]
 	rb.head = (rb.head + 1) % rb.capacity
+	rb.consumed++
 	return b
 }
 
+// Clear drops everything that has been buffered but not read yet
+func (rb *RingBuffer) Clear() {
+	rb.m.Lock()
+	defer rb.m.Unlock()
+
+	rb.head = 0
+	rb.tail = 0
+	rb.consumed = 0
+}
+
+// Consumed returns how many buffered bytes have been read since the buffer was created or cleared
+func (rb *RingBuffer) Consumed() int64 {
+	rb.m.Lock()
+	defer rb.m.Unlock()
+
+	return rb.consumed
+}
+
 func (rb *RingBuffer) Read(b []byte) (n int, err error) {
-	if rb.Empty() {
+	rb.m.Lock()
+	defer rb.m.Unlock()
+
+	if rb.size() == 0 {
 		for i := 0; i < len(b); i++ {
 			b[i] = 0
 		}
@@ -63,7 +125,7 @@ func (rb *RingBuffer) Read(b []byte) (n int, err error) {
 	}
 
 	for i := 0; i < len(b); i++ {
-		b[i] = rb.Pop()
+		b[i] = rb.pop()
 	}
 
 	return len(b), nil
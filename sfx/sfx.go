@@ -0,0 +1,234 @@
+// Package sfx plays sound effects made from the instruments of tracker songs, so that a game can ship its
+// sound effects as samples of the same module as its music
+// A fixed number of voices play at once. When they are all busy, a new sound takes the voice of the oldest
+// sound with the lowest priority, as long as that priority is not higher than its own.
+package sfx
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/gotracker/gomixing/panning"
+	"github.com/gotracker/gomixing/volume"
+
+	"github.com/gotracker/playback"
+	"github.com/gotracker/playback/note"
+	"github.com/gotracker/playback/player/jam"
+
+	"github.com/eliasdaler/ebiten-tracker-demo/songinfo"
+)
+
+var (
+	// ErrUnknownSound is for when a sound name was never defined
+	ErrUnknownSound = errors.New("unknown sound")
+	// ErrNoVoice is for when every voice is playing a sound with a higher priority than the one asked for
+	ErrNoVoice = errors.New("no voice free")
+)
+
+// Sound is a note of an instrument of a song to play as a sound effect
+type Sound struct {
+	// Song is the song that has the instrument, which has to be set up with the sample rate of the engine
+	Song playback.Playback
+	// Instrument is the number of the instrument, from 1
+	Instrument int
+	Note       note.Semitone
+	// Volume scales the default volume of the instrument, from 0 to 1
+	Volume float32
+	// Pan goes from -1 (left) to 1 (right)
+	Pan float32
+	// Priority decides which sounds can take the voices of others when they are all busy; higher wins
+	Priority int
+	// Duration is how long the note is held for before it is released, or until it ends of its own accord
+	// when it is zero
+	// Sounds whose samples loop without a volume envelope to end them need a duration.
+	Duration time.Duration
+}
+
+// Handle controls a sound that is playing, until it ends or its voice is taken by another sound
+type Handle struct {
+	v  *voice
+	id uint64
+}
+
+// IsPlaying returns true if the sound is still playing
+func (h Handle) IsPlaying() bool {
+	return h.v != nil && h.v.id == h.id && h.v.note != nil && !h.v.note.IsDone()
+}
+
+// Release releases the note of the sound, which then moves on to the release of its envelopes
+func (h Handle) Release() {
+	if h.IsPlaying() {
+		h.v.note.Release()
+	}
+}
+
+// Stop cuts the sound off
+func (h Handle) Stop() {
+	if h.IsPlaying() {
+		h.v.note.Cut()
+	}
+}
+
+type voice struct {
+	note     *jam.Note
+	priority int
+	// id tells apart the sounds that played on the voice, and goes up with every sound
+	id uint64
+	// release is the frame at which the note is released, or 0 if it is held until it ends
+	release int64
+}
+
+func (v *voice) isFree() bool {
+	return v.note == nil || v.note.IsDone()
+}
+
+// Engine mixes sound effects
+type Engine struct {
+	jam        *jam.Jam
+	sampleRate int
+	channels   int
+	voices     []voice
+	sounds     map[string]Sound
+
+	// frame is the number of frames mixed so far
+	frame  int64
+	lastID uint64
+}
+
+// New creates an engine that plays up to `voices` sounds at once, and mixes them at `sampleRate` frames per
+// second into `channels` channels
+func New(sampleRate, channels, voices int) *Engine {
+	return &Engine{
+		jam:        jam.New(sampleRate, channels),
+		sampleRate: sampleRate,
+		channels:   channels,
+		voices:     make([]voice, voices),
+		sounds:     make(map[string]Sound),
+	}
+}
+
+// SetVolume sets the volume every sound is mixed at, from 0 to 1
+func (e *Engine) SetVolume(v float32) {
+	e.jam.Volume = volume.Volume(v)
+}
+
+// Define names sound `s`, for playing it with PlayNamed
+func (e *Engine) Define(name string, s Sound) {
+	e.sounds[name] = s
+}
+
+// PlayNamed plays the sound defined under `name`
+func (e *Engine) PlayNamed(name string) (Handle, error) {
+	s, ok := e.sounds[name]
+	if !ok {
+		return Handle{}, ErrUnknownSound
+	}
+	return e.Play(s)
+}
+
+// Play plays sound `s` on a free voice, or on the voice of a sound that does not have a higher priority
+func (e *Engine) Play(s Sound) (Handle, error) {
+	v := e.allocate(s.Priority)
+	if v == nil {
+		return Handle{}, ErrNoVoice
+	}
+
+	n, err := e.jam.Play(s.Song, s.Instrument, s.Note)
+	if err != nil {
+		return Handle{}, err
+	}
+	// sounds are resampled the same way as the music of their song
+	n.SetInterpolation(s.Song.GetInterpolation())
+	n.SetVolume(n.Volume() * volume.Volume(s.Volume) * s.Song.GetMixerVolume())
+	n.SetPan(panning.MakeStereoPosition(s.Pan, -1, 1))
+
+	if !v.isFree() {
+		v.note.Cut()
+	}
+	e.lastID++
+	*v = voice{
+		note:     n,
+		priority: s.Priority,
+		id:       e.lastID,
+	}
+	if s.Duration > 0 {
+		v.release = e.frame + int64(s.Duration)*int64(e.sampleRate)/int64(time.Second)
+	}
+	return Handle{v: v, id: v.id}, nil
+}
+
+// allocate returns a free voice, or else the voice of the oldest sound with the lowest priority, as long as
+// that priority is not above `priority`, or nil
+func (e *Engine) allocate(priority int) *voice {
+	var steal *voice
+	for i := range e.voices {
+		v := &e.voices[i]
+		if v.isFree() {
+			return v
+		}
+		if steal == nil || v.priority < steal.priority || (v.priority == steal.priority && v.id < steal.id) {
+			steal = v
+		}
+	}
+	if steal == nil || steal.priority > priority {
+		return nil
+	}
+	return steal
+}
+
+// Playing returns the number of sounds playing
+func (e *Engine) Playing() int {
+	n := 0
+	for i := range e.voices {
+		if !e.voices[i].isFree() {
+			n++
+		}
+	}
+	return n
+}
+
+// Voices returns the number of sounds that can play at once
+func (e *Engine) Voices() int {
+	return len(e.voices)
+}
+
+// StopAll cuts off every sound
+func (e *Engine) StopAll() {
+	e.jam.Stop()
+	for i := range e.voices {
+		e.voices[i] = voice{}
+	}
+}
+
+// Read mixes the next len(dst)/channels frames of the sounds and adds them to `dst`, which holds interleaved
+// samples from -1 to 1, such as the audio of the music
+// Sounds with a duration are released at the start of the first read that reaches their end.
+func (e *Engine) Read(dst []float32) {
+	for i := range e.voices {
+		v := &e.voices[i]
+		if v.release != 0 && v.release <= e.frame && !v.isFree() {
+			v.note.Release()
+			v.release = 0
+		}
+	}
+	e.jam.Read(dst)
+	e.frame += int64(len(dst) / e.channels)
+}
+
+// InstrumentNamed returns the number of the instrument called `name` in a song with details `info`, ignoring
+// case and surrounding spaces, or false if there is none
+// Songs that have no instruments, such as MOD files, are searched by their sample names.
+func InstrumentNamed(info *songinfo.Info, name string) (int, bool) {
+	items := info.Instruments
+	if len(items) == 0 {
+		items = info.Samples
+	}
+	name = strings.TrimSpace(name)
+	for _, it := range items {
+		if strings.EqualFold(strings.TrimSpace(it.Name), name) {
+			return it.Number, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,91 @@
+package sfx
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gotracker/playback"
+
+	"github.com/eliasdaler/ebiten-tracker-demo/internal/fixture"
+	"github.com/eliasdaler/ebiten-tracker-demo/render"
+)
+
+// loadSong loads the MOD fixture, whose first instrument is a looped square that plays until it is stopped
+func loadSong(t *testing.T) playback.Playback {
+	t.Helper()
+	p, err := render.Load("mod", fixture.MOD(), render.DefaultOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestAllocate(t *testing.T) {
+	song := loadSong(t)
+	tests := []struct {
+		name string
+		// playing are the priorities of the sounds that fill the voices, from the oldest
+		playing  []int
+		priority int
+		// stolen is the sound of `playing` whose voice the new sound takes, or -1 if it does not play
+		stolen int
+	}{
+		{name: "lowest priority", playing: []int{2, 1, 3}, priority: 2, stolen: 1},
+		{name: "oldest first", playing: []int{1, 1, 1}, priority: 1, stolen: 0},
+		{name: "oldest of the lowest", playing: []int{2, 1, 1}, priority: 3, stolen: 1},
+		{name: "refused", playing: []int{2, 3, 2}, priority: 1, stolen: -1},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			e := New(render.DefaultOptions.SampleRate, render.DefaultOptions.Channels, len(tc.playing))
+			var handles []Handle
+			for _, priority := range tc.playing {
+				h, err := e.Play(Sound{Song: song, Instrument: 1, Note: 48, Volume: 1, Priority: priority})
+				if err != nil {
+					t.Fatal(err)
+				}
+				handles = append(handles, h)
+			}
+
+			_, err := e.Play(Sound{Song: song, Instrument: 1, Note: 48, Volume: 1, Priority: tc.priority})
+			if tc.stolen < 0 {
+				if !errors.Is(err, ErrNoVoice) {
+					t.Errorf("got %v, want %v", err, ErrNoVoice)
+				}
+			} else if err != nil {
+				t.Fatal(err)
+			}
+			for i, h := range handles {
+				if want := i != tc.stolen; h.IsPlaying() != want {
+					t.Errorf("sound %d playing: got %v, want %v", i, h.IsPlaying(), want)
+				}
+			}
+			if got := e.Playing(); got != len(tc.playing) {
+				t.Errorf("got %d sounds playing, want %d", got, len(tc.playing))
+			}
+		})
+	}
+}
+
+// TestAllocateFree checks that a voice whose sound has stopped is used before the voice of any sound that
+// is playing
+func TestAllocateFree(t *testing.T) {
+	song := loadSong(t)
+	e := New(render.DefaultOptions.SampleRate, render.DefaultOptions.Channels, 2)
+	low, err := e.Play(Sound{Song: song, Instrument: 1, Note: 48, Volume: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	high, err := e.Play(Sound{Song: song, Instrument: 1, Note: 48, Volume: 1, Priority: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	high.Stop()
+
+	if _, err := e.Play(Sound{Song: song, Instrument: 1, Note: 48, Volume: 1}); err != nil {
+		t.Fatal(err)
+	}
+	if !low.IsPlaying() {
+		t.Error("the voice of a sound that was playing was taken while another one was free")
+	}
+}
@@ -0,0 +1,439 @@
+// Package songinfo reads the details of a song file that the player does not need to play it, such as the
+// tracker it was made with, its instrument and sample names and its message, for showing them to the player
+package songinfo
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gotracker/goaudiofile/music/tracked/it"
+	"github.com/gotracker/goaudiofile/music/tracked/it/block"
+	"github.com/gotracker/goaudiofile/music/tracked/mod"
+	"github.com/gotracker/goaudiofile/music/tracked/s3m"
+	"github.com/gotracker/goaudiofile/music/tracked/xm"
+)
+
+var (
+	// ErrUnsupportedFormat is for when the format is not one of "mod", "s3m", "xm" or "it"
+	ErrUnsupportedFormat = errors.New("unsupported format")
+)
+
+// Item is an instrument or a sample of a song
+type Item struct {
+	// Number is the 1-based number the song uses for it
+	Number   int
+	Name     string
+	Filename string
+	// Details describes it in a few words, such as its length and whether it loops
+	Details string
+}
+
+// Volume is a volume setting of a song and the highest value it can have in its format
+type Volume struct {
+	Value int
+	Max   int
+}
+
+func (v Volume) String() string {
+	return fmt.Sprintf("%d/%d", v.Value, v.Max)
+}
+
+// Info holds the details of a song
+type Info struct {
+	// Format names the format, along with any variant of it, such as the signature of a MOD file
+	Format  string
+	Title   string
+	Tracker string
+	// Message is the song message, with its lines separated by "\n"
+	Message string
+
+	Channels int
+	Patterns int
+	// Orders is the order list, with the markers some formats use to skip entries left out
+	Orders []int
+
+	InitialSpeed int
+	InitialTempo int
+	// GlobalVolume and MixingVolume are zero when the format does not have them
+	GlobalVolume Volume
+	MixingVolume Volume
+	// Flags lists the playback settings of the song, such as the frequency table it uses
+	Flags []string
+
+	Instruments []Item
+	Samples     []Item
+	// PatternNames holds the names of the patterns, when the format keeps them
+	PatternNames []string
+	// Plugins holds the names of the effect plugins the song uses, when the format keeps them
+	Plugins []string
+}
+
+// Read reads the details of the song in `data`, which is in format `format`: "mod", "s3m", "xm" or "it"
+func Read(format string, data []byte) (*Info, error) {
+	switch format {
+	case "mod":
+		return readMOD(data)
+	case "s3m":
+		return readS3M(data)
+	case "xm":
+		return readXM(data)
+	case "it":
+		return readIT(data)
+	}
+	return nil, ErrUnsupportedFormat
+}
+
+func readMOD(data []byte) (*Info, error) {
+	f, err := mod.Read(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	info := &Info{
+		Format:       fmt.Sprintf("MOD (%s)", strings.TrimSpace(string(f.Head.Sig[:]))),
+		Title:        f.Head.GetName(),
+		Patterns:     len(f.Patterns),
+		InitialSpeed: 6,
+		InitialTempo: 125,
+	}
+	if len(f.Patterns) > 0 {
+		info.Channels = len(f.Patterns[0][0])
+	}
+	// broken files can claim more orders than the order list holds
+	songLen := int(f.Head.SongLen)
+	if songLen > len(f.Head.Order) {
+		songLen = len(f.Head.Order)
+	}
+	for _, o := range f.Head.Order[:songLen] {
+		info.Orders = append(info.Orders, int(o))
+	}
+	for i, inst := range f.Head.Instrument {
+		details := fmt.Sprintf("%d bytes, volume %d", int(inst.Len)*2, inst.Volume)
+		if inst.FineTune&0x0f != 0 {
+			details += fmt.Sprintf(", finetune %+d", int(int8(inst.FineTune<<4))>>4)
+		}
+		if inst.LoopEnd > 1 {
+			details += ", looped"
+		}
+		info.Samples = append(info.Samples, Item{
+			Number:  i + 1,
+			Name:    inst.GetName(),
+			Details: details,
+		})
+	}
+	return info, nil
+}
+
+func readS3M(data []byte) (*Info, error) {
+	f, err := s3m.Read(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	info := &Info{
+		Format:       "S3M",
+		Title:        f.Head.GetName(),
+		Tracker:      s3mTracker(f.Head.TrackerVersion),
+		Patterns:     len(f.Patterns),
+		InitialSpeed: int(f.Head.InitialSpeed),
+		InitialTempo: int(f.Head.InitialTempo),
+		GlobalVolume: Volume{Value: int(f.Head.GlobalVolume), Max: 64},
+		MixingVolume: Volume{Value: int(f.Head.MixingVolume & 0x7f), Max: 127},
+	}
+	for _, cs := range f.ChannelSettings {
+		if cs.IsEnabled() {
+			info.Channels++
+		}
+	}
+	for _, o := range f.OrderList {
+		// 254 is a marker that is skipped over and 255 ends the song
+		if o < 254 {
+			info.Orders = append(info.Orders, int(o))
+		}
+	}
+	if f.Head.MixingVolume&0x80 != 0 {
+		info.Flags = append(info.Flags, "stereo")
+	} else {
+		info.Flags = append(info.Flags, "mono")
+	}
+	if f.Head.DefaultPanValueFlag == 0xfc {
+		info.Flags = append(info.Flags, "channel panning")
+	}
+
+	for i, inst := range f.Instruments {
+		item := Item{
+			Number:   i + 1,
+			Filename: inst.Head.GetFilename(),
+		}
+		switch a := inst.Ancillary.(type) {
+		case *s3m.SCRSNoneHeader:
+			item.Name = a.GetSampleName()
+			item.Details = "empty"
+		case *s3m.SCRSDigiplayerHeader:
+			item.Name = a.GetSampleName()
+			item.Details = fmt.Sprintf("%d samples, %d Hz, volume %d", a.Length.Lo, a.C2Spd.Lo, a.Volume)
+			if a.Flags.IsLooped() {
+				item.Details += ", looped"
+			}
+		case *s3m.SCRSAdlibHeader:
+			item.Name = a.GetSampleName()
+			item.Details = fmt.Sprintf("AdLib, volume %d", a.Volume)
+		}
+		info.Instruments = append(info.Instruments, item)
+	}
+	return info, nil
+}
+
+func readXM(data []byte) (*Info, error) {
+	f, err := xm.Read(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	info := &Info{
+		Format:       fmt.Sprintf("XM %d.%02d", f.Head.VersionNumber>>8, f.Head.VersionNumber&0xff),
+		Title:        f.Head.GetName(),
+		Tracker:      strings.TrimSpace(f.Head.GetTrackerName()),
+		Channels:     int(f.Head.NumChannels),
+		Patterns:     len(f.Patterns),
+		InitialSpeed: int(f.Head.DefaultSpeed),
+		InitialTempo: int(f.Head.DefaultTempo),
+	}
+	for _, o := range f.Head.OrderTable[:f.Head.SongLength] {
+		info.Orders = append(info.Orders, int(o))
+	}
+	if f.Head.Flags.IsLinearSlides() {
+		info.Flags = append(info.Flags, "linear frequency table")
+	} else {
+		info.Flags = append(info.Flags, "Amiga frequency table")
+	}
+
+	for i, inst := range f.Instruments {
+		info.Instruments = append(info.Instruments, Item{
+			Number:  i + 1,
+			Name:    inst.GetName(),
+			Details: fmt.Sprintf("%d samples", len(inst.Samples)),
+		})
+		for _, s := range inst.Samples {
+			details := fmt.Sprintf("instrument %d, %d bytes, volume %d", i+1, s.Length, s.Volume)
+			if s.Flags.Is16Bit() {
+				details += ", 16-bit"
+			}
+			if s.Flags.LoopMode() != xm.SampleLoopModeDisabled {
+				details += ", looped"
+			}
+			info.Samples = append(info.Samples, Item{
+				Number:  len(info.Samples) + 1,
+				Name:    s.GetName(),
+				Details: details,
+			})
+		}
+	}
+	return info, nil
+}
+
+func readIT(data []byte) (*Info, error) {
+	f, err := it.Read(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	info := &Info{
+		Format:       fmt.Sprintf("IT (compatible with %s)", itVersion(f.Head.TrackerCompatVersion)),
+		Title:        f.Head.GetName(),
+		Tracker:      itTracker(f.Head.TrackerVersion),
+		Message:      f.Message,
+		Patterns:     len(f.Patterns),
+		InitialSpeed: int(f.Head.InitialSpeed),
+		InitialTempo: int(f.Head.InitialTempo),
+		GlobalVolume: Volume{Value: int(f.Head.GlobalVolume), Max: 128},
+		MixingVolume: Volume{Value: int(f.Head.MixingVolume), Max: 128},
+	}
+	for _, p := range f.Head.ChannelPan {
+		if !p.IsDisabled() {
+			info.Channels++
+		}
+	}
+	for _, o := range f.OrderList {
+		// 254 is a marker that is skipped over and 255 ends the song
+		if o < 254 {
+			info.Orders = append(info.Orders, int(o))
+		}
+	}
+	if f.Head.Flags.IsStereo() {
+		info.Flags = append(info.Flags, "stereo")
+	} else {
+		info.Flags = append(info.Flags, "mono")
+	}
+	if f.Head.Flags.IsUseInstruments() {
+		info.Flags = append(info.Flags, "instruments")
+	} else {
+		info.Flags = append(info.Flags, "samples only")
+	}
+	if f.Head.Flags.IsLinearSlides() {
+		info.Flags = append(info.Flags, "linear slides")
+	} else {
+		info.Flags = append(info.Flags, "Amiga slides")
+	}
+
+	for i, inst := range f.Instruments {
+		item := Item{
+			Number: i + 1,
+		}
+		switch ii := inst.(type) {
+		case *it.IMPIInstrumentOld:
+			item.Name = ii.GetName()
+			item.Filename = ii.GetFilename()
+			item.Details = "old format"
+		case *it.IMPIInstrument:
+			item.Name = ii.GetName()
+			item.Filename = ii.GetFilename()
+		}
+		info.Instruments = append(info.Instruments, item)
+	}
+	for i, s := range f.Samples {
+		h := s.Header
+		details := "empty"
+		if h.Flags.DoesSampleExist() {
+			details = fmt.Sprintf("%d samples, %d Hz, volume %d", h.Length, h.C5Speed, h.Volume)
+			if h.Flags.Is16Bit() {
+				details += ", 16-bit"
+			}
+			if h.Flags.IsStereo() {
+				details += ", stereo"
+			}
+			if h.Flags.IsLoopEnabled() {
+				details += ", looped"
+			}
+		}
+		info.Samples = append(info.Samples, Item{
+			Number:   i + 1,
+			Name:     h.GetName(),
+			Filename: h.GetFilename(),
+			Details:  details,
+		})
+	}
+	for _, b := range f.Blocks {
+		switch bb := b.(type) {
+		case *block.PatternNames:
+			for i := range bb.Name {
+				info.PatternNames = append(info.PatternNames, bb.Name[i].String())
+			}
+		case *block.FX:
+			info.Plugins = append(info.Plugins, fmt.Sprintf("%s (%s)", bb.UserPluginName, bb.LibraryName))
+		}
+	}
+	return info, nil
+}
+
+// s3mTracker returns the name of the tracker that saved an S3M file, from the version it stores
+func s3mTracker(version uint16) string {
+	v := fmt.Sprintf("%X.%02X", (version>>8)&0x0f, version&0xff)
+	switch version >> 12 {
+	case 1:
+		return "Scream Tracker " + v
+	case 2:
+		return "Imago Orpheus " + v
+	case 3:
+		return "Impulse Tracker " + v
+	case 4:
+		return "Schism Tracker"
+	case 5:
+		return "OpenMPT"
+	}
+	return fmt.Sprintf("unknown (%04X)", version)
+}
+
+// itTracker returns the name of the tracker that saved an IT file, from the version it stores
+func itTracker(version uint16) string {
+	switch version >> 12 {
+	case 0:
+		return "Impulse Tracker " + itVersion(version)
+	case 1:
+		return "Schism Tracker"
+	case 5:
+		return "OpenMPT"
+	}
+	return fmt.Sprintf("unknown (%04X)", version)
+}
+
+func itVersion(version uint16) string {
+	return fmt.Sprintf("%X.%02X", (version>>8)&0x0f, version&0xff)
+}
+
+// Lines returns the details as lines of text, for showing them in a panel
+func (i *Info) Lines() []string {
+	lines := []string{
+		"Title:    " + i.Title,
+		"Format:   " + i.Format,
+	}
+	if i.Tracker != "" {
+		lines = append(lines, "Tracker:  "+i.Tracker)
+	}
+	lines = append(lines,
+		fmt.Sprintf("Channels: %d  Patterns: %d  Orders: %d", i.Channels, i.Patterns, len(i.Orders)),
+		fmt.Sprintf("Speed:    %d  Tempo: %d", i.InitialSpeed, i.InitialTempo),
+	)
+	if i.GlobalVolume.Max != 0 {
+		lines = append(lines, fmt.Sprintf("Volume:   global %s  mixing %s", i.GlobalVolume, i.MixingVolume))
+	}
+	if len(i.Flags) > 0 {
+		lines = append(lines, "Flags:    "+strings.Join(i.Flags, ", "))
+	}
+
+	orders := make([]string, len(i.Orders))
+	for n, o := range i.Orders {
+		orders[n] = fmt.Sprintf("%d", o)
+	}
+	lines = append(lines, "", "Order list:")
+	lines = append(lines, wrap(strings.Join(orders, " "), 100)...)
+
+	if i.Message != "" {
+		lines = append(lines, "", "Message:")
+		lines = append(lines, strings.Split(i.Message, "\n")...)
+	}
+	lines = appendItems(lines, "Instruments:", i.Instruments)
+	lines = appendItems(lines, "Samples:", i.Samples)
+	if len(i.PatternNames) > 0 {
+		lines = append(lines, "", "Pattern names:")
+		for n, name := range i.PatternNames {
+			lines = append(lines, fmt.Sprintf("%3d. %s", n, name))
+		}
+	}
+	if len(i.Plugins) > 0 {
+		lines = append(lines, "", "Plugins:")
+		lines = append(lines, i.Plugins...)
+	}
+	return lines
+}
+
+func appendItems(lines []string, heading string, items []Item) []string {
+	if len(items) == 0 {
+		return lines
+	}
+	lines = append(lines, "", heading)
+	for _, it := range items {
+		line := fmt.Sprintf("%3d. %-26s", it.Number, it.Name)
+		if it.Filename != "" {
+			line += fmt.Sprintf(" %-12s", it.Filename)
+		}
+		lines = append(lines, line+" "+it.Details)
+	}
+	return lines
+}
+
+// wrap splits `s` into lines of at most `width` characters, at spaces
+func wrap(s string, width int) []string {
+	var lines []string
+	for len(s) > width {
+		i := strings.LastIndexByte(s[:width+1], ' ')
+		if i <= 0 {
+			i = width
+		}
+		lines = append(lines, s[:i])
+		s = strings.TrimLeft(s[i:], " ")
+	}
+	return append(lines, s)
+}
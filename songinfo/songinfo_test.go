@@ -0,0 +1,147 @@
+package songinfo
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/eliasdaler/ebiten-tracker-demo/internal/fixture"
+)
+
+func readFile(t *testing.T, path string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestRead(t *testing.T) {
+	tests := []struct {
+		format string
+		data   func(t *testing.T) []byte
+		want   Info
+		// sample is the first sample of the song
+		sample Item
+		// samples is how many samples the song has
+		samples int
+	}{
+		{
+			format: "mod",
+			data:   func(t *testing.T) []byte { return fixture.MOD() },
+			want: Info{
+				Format:       "MOD (M.K.)",
+				Title:        "golden fixture",
+				Channels:     4,
+				Patterns:     2,
+				Orders:       []int{0, 1},
+				InitialSpeed: 6,
+				InitialTempo: 125,
+			},
+			sample:  Item{Number: 1, Name: "square", Details: "16384 bytes, volume 64, looped"},
+			samples: 31,
+		},
+		{
+			format: "s3m",
+			data:   func(t *testing.T) []byte { return readFile(t, "../belthsar.s3m") },
+			want: Info{
+				Format:       "S3M",
+				Title:        "Chrono Trigger...Belthsar..",
+				Tracker:      "Scream Tracker 3.20",
+				Channels:     13,
+				Patterns:     8,
+				Orders:       []int{0, 1, 2, 3, 4, 5, 6, 7},
+				InitialSpeed: 7,
+				InitialTempo: 125,
+				GlobalVolume: Volume{Value: 64, Max: 64},
+				MixingVolume: Volume{Value: 48, Max: 127},
+				Flags:        []string{"stereo", "channel panning"},
+			},
+		},
+		{
+			format: "xm",
+			data:   func(t *testing.T) []byte { return readFile(t, "../theme.xm") },
+			want: Info{
+				Format:       "XM 1.04",
+				Title:        "Torokos Theme",
+				Tracker:      "Org2XM by Rrrola",
+				Channels:     11,
+				Patterns:     46,
+				InitialSpeed: 17,
+				InitialTempo: 250,
+				Flags:        []string{"linear frequency table"},
+			},
+			sample:  Item{Number: 1, Name: "samples/061.wav", Details: "instrument 1, 256 bytes, volume 51, 16-bit, looped"},
+			samples: 8,
+		},
+		{
+			format: "it",
+			data:   func(t *testing.T) []byte { return fixture.IT() },
+			want: Info{
+				Format:       "IT (compatible with 2.00)",
+				Title:        "golden fixture",
+				Tracker:      "Impulse Tracker 2.14",
+				Channels:     4,
+				Patterns:     1,
+				Orders:       []int{0, 0},
+				InitialSpeed: 4,
+				InitialTempo: 150,
+				GlobalVolume: Volume{Value: 128, Max: 128},
+				MixingVolume: Volume{Value: 48, Max: 128},
+				Flags:        []string{"stereo", "instruments", "linear slides"},
+			},
+			sample:  Item{Number: 1, Name: "sine", Details: "64 samples, 8363 Hz, volume 64, looped"},
+			samples: 2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.format, func(t *testing.T) {
+			got, err := Read(tc.format, tc.data(t))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.samples != 0 {
+				if len(got.Samples) != tc.samples {
+					t.Errorf("got %d samples, want %d", len(got.Samples), tc.samples)
+				} else if got.Samples[0] != tc.sample {
+					t.Errorf("got first sample %+v, want %+v", got.Samples[0], tc.sample)
+				}
+			}
+			if tc.want.Orders == nil {
+				// the order list of the XM song is too long to spell out
+				tc.want.Orders = got.Orders
+			}
+			// the instruments and samples are checked on their own, and the rest is compared whole
+			tc.want.Instruments, tc.want.Samples = got.Instruments, got.Samples
+			if !reflect.DeepEqual(*got, tc.want) {
+				t.Errorf("got %+v, want %+v", *got, tc.want)
+			}
+			if len(got.Lines()) == 0 {
+				t.Error("no lines")
+			}
+		})
+	}
+}
+
+// TestReadMODLongSongLength checks that a song length past the end of the order list reads the whole list
+func TestReadMODLongSongLength(t *testing.T) {
+	data := fixture.MOD()
+	// the song length follows the title and the 31 sample headers
+	data[20+31*30] = 200
+	got, err := Read("mod", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Orders) != 128 {
+		t.Errorf("got %d orders, want 128", len(got.Orders))
+	}
+}
+
+func TestReadUnsupportedFormat(t *testing.T) {
+	if _, err := Read("wav", nil); !errors.Is(err, ErrUnsupportedFormat) {
+		t.Errorf("got %v, want %v", err, ErrUnsupportedFormat)
+	}
+}
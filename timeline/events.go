@@ -0,0 +1,243 @@
+package timeline
+
+import (
+	"github.com/gotracker/playback"
+	itChannel "github.com/gotracker/playback/format/it/channel"
+	s3mChannel "github.com/gotracker/playback/format/s3m/channel"
+	xmChannel "github.com/gotracker/playback/format/xm/channel"
+	"github.com/gotracker/playback/index"
+	"github.com/gotracker/playback/note"
+	"github.com/gotracker/playback/output"
+	"github.com/gotracker/playback/player/render"
+	"github.com/gotracker/playback/song"
+)
+
+// EventKind is the kind of a song event
+type EventKind int
+
+const (
+	// EventOrder is when playback moves onto another entry in the order list, and so possibly another pattern
+	EventOrder = EventKind(iota)
+	// EventRow is when playback moves onto a new row
+	EventRow
+	// EventNote is when a note starts playing on a channel
+	EventNote
+	// EventEffect is when a row with an effect command on a channel starts playing
+	EventEffect
+)
+
+// Any matches every channel or instrument in OnNote
+const Any = -1
+
+// Event is something that happens in a song at the start of a row
+type Event struct {
+	Kind     EventKind
+	Position Position
+	// Pattern is the pattern being played
+	Pattern int
+	// Channel is the channel (0-based) of an EventNote or EventEffect
+	Channel int
+	// Note and Instrument are the note that starts and the instrument (1-based) that plays it, for EventNote
+	// Instrument is 0 when the channel has not been given an instrument yet.
+	Note       note.Note
+	Instrument int
+	// Command and Param are the effect command letter, as the tracker shows it, and its parameter, for EventEffect
+	Command rune
+	Param   uint8
+}
+
+type scheduledEvent struct {
+	frame int64
+	event Event
+}
+
+type subscription struct {
+	match func(Event) bool
+	fn    func(Event)
+}
+
+// Subscribe calls `fn` for every event when it becomes audible
+func (t *Timeline) Subscribe(fn func(Event)) {
+	t.subscribe(func(Event) bool { return true }, fn)
+}
+
+// OnOrder calls `fn` when playback moving onto another entry in the order list becomes audible
+func (t *Timeline) OnOrder(fn func(Event)) {
+	t.subscribe(func(e Event) bool { return e.Kind == EventOrder }, fn)
+}
+
+// OnRow calls `fn` when every new row becomes audible
+func (t *Timeline) OnRow(fn func(Event)) {
+	t.subscribe(func(e Event) bool { return e.Kind == EventRow }, fn)
+}
+
+// OnNote calls `fn` when a note on `channel` (0-based) played with `instrument` (1-based) becomes audible
+// Either can be Any.
+func (t *Timeline) OnNote(channel, instrument int, fn func(Event)) {
+	t.subscribe(func(e Event) bool {
+		return e.Kind == EventNote &&
+			(channel == Any || e.Channel == channel) &&
+			(instrument == Any || e.Instrument == instrument)
+	}, fn)
+}
+
+// OnEffect calls `fn` when a row with the effect command `command` becomes audible, such as 'Z' for the
+// MIDI macro command of IT and the unused Z command of S3M, which are often used as sync markers
+func (t *Timeline) OnEffect(command rune, fn func(Event)) {
+	t.subscribe(func(e Event) bool { return e.Kind == EventEffect && e.Command == command }, fn)
+}
+
+func (t *Timeline) subscribe(match func(Event) bool, fn func(Event)) {
+	t.subscriptions = append(t.subscriptions, subscription{
+		match: match,
+		fn:    fn,
+	})
+}
+
+// Schedule queues `e` to be sent to the subscribers once the audio marked next is heard
+func (t *Timeline) Schedule(e Event) {
+	t.events = append(t.events, scheduledEvent{
+		frame: t.written,
+		event: e,
+	})
+}
+
+// Dispatch sends the scheduled events that are heard once `consumed` frames of the generated audio have been
+// consumed to their subscribers, in the order they happen in the song
+func (t *Timeline) Dispatch(consumed int64) {
+	heard := t.HeardFrame(consumed)
+	n := 0
+	for n < len(t.events) && t.events[n].frame <= heard {
+		n++
+	}
+	if n == 0 {
+		return
+	}
+
+	// take the events off the queue first, so that subscribers are free to reset the timeline
+	t.due = append(t.due[:0], t.events[:n]...)
+	t.events = append(t.events[:0], t.events[n:]...)
+
+	for _, se := range t.due {
+		for _, s := range t.subscriptions {
+			if s.match(se.event) {
+				s.fn(se.event)
+			}
+		}
+	}
+}
+
+// MarkPremix marks the tick of the song played by `p` that was rendered into `premix`, and schedules the
+// events that happen on it
+func (t *Timeline) MarkPremix(p playback.Playback, premix *output.PremixData) {
+	rr, ok := premix.Userdata.(*render.RowRender)
+	if !ok {
+		t.Skip(premix.SamplesLen)
+		return
+	}
+
+	pos := Position{
+		Song:  p,
+		Order: rr.Order,
+		Row:   rr.Row,
+		Tick:  rr.Tick,
+	}
+	if pos.Tick == 0 {
+		t.scheduleRow(p, pos)
+	}
+	t.Mark(pos, premix.SamplesLen)
+}
+
+// scheduleRow schedules the events that happen at the start of the row at `pos`
+func (t *Timeline) scheduleRow(p playback.Playback, pos Position) {
+	if t.player != p {
+		t.player = p
+		t.rows = nil
+		t.patterns = make(map[index.Pattern][][]song.ChannelData)
+		t.instruments = make(map[int]int)
+		t.hasLast = false
+	}
+
+	songData := p.GetSongData()
+	patIdx := index.InvalidPattern
+	if orders := songData.GetOrderList(); pos.Order >= 0 && pos.Order < len(orders) {
+		patIdx = orders[pos.Order]
+	}
+	if t.rows == nil || t.patIdx != patIdx {
+		// the pattern data is made every time it is asked for, so every pattern is only asked for once
+		rows, ok := t.patterns[patIdx]
+		if !ok {
+			rows = p.GetPatternData(patIdx)
+			t.patterns[patIdx] = rows
+		}
+		t.patIdx = patIdx
+		t.rows = rows
+	}
+
+	if !t.hasLast || t.last.Order != pos.Order {
+		t.Schedule(Event{
+			Kind:     EventOrder,
+			Position: pos,
+			Pattern:  int(patIdx),
+		})
+	}
+	t.last = pos
+	t.hasLast = true
+
+	t.Schedule(Event{
+		Kind:     EventRow,
+		Position: pos,
+		Pattern:  int(patIdx),
+	})
+
+	if pos.Row < 0 || pos.Row >= len(t.rows) {
+		return
+	}
+	for ch, cd := range t.rows[pos.Row] {
+		// patterns can hold more channels than the song plays
+		if ch >= p.GetNumChannels() || !songData.IsChannelEnabled(ch) {
+			continue
+		}
+
+		if cd.HasInstrument() {
+			t.instruments[ch] = instrumentNumber(cd)
+		}
+		if cd.HasNote() {
+			if n, ok := cd.GetNote().(note.Normal); ok {
+				t.Schedule(Event{
+					Kind:       EventNote,
+					Position:   pos,
+					Pattern:    int(patIdx),
+					Channel:    ch,
+					Note:       n,
+					Instrument: t.instruments[ch],
+				})
+			}
+		}
+		if command, param := cd.GetCommand(); command != 0 {
+			t.Schedule(Event{
+				Kind:     EventEffect,
+				Position: pos,
+				Pattern:  int(patIdx),
+				Channel:  ch,
+				Command:  command,
+				Param:    param,
+			})
+		}
+	}
+}
+
+// instrumentNumber returns the 1-based instrument number of the instrument on `cd`, or 0 for a format it
+// does not know
+func instrumentNumber(cd song.ChannelData) int {
+	switch id := cd.GetInstrument(0).(type) {
+	case s3mChannel.InstID:
+		return int(id)
+	case itChannel.SampleID:
+		return int(id.InstID)
+	case xmChannel.SampleID:
+		return int(id.InstID)
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,161 @@
+package timeline
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gotracker/playback/player/render"
+
+	"github.com/eliasdaler/ebiten-tracker-demo/internal/fixture"
+	songrender "github.com/eliasdaler/ebiten-tracker-demo/render"
+)
+
+// rowStart is the first frame of a row in the generated audio
+type rowStart struct {
+	frame      int64
+	order, row int
+}
+
+// markSong renders all of a fixture song and marks it on `tl`, returning where every row starts
+func markSong(t *testing.T, tl *Timeline, format string, data []byte) []rowStart {
+	t.Helper()
+	p, err := songrender.Load(format, data, songrender.DefaultOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var starts []rowStart
+	for {
+		premix, err := p.Generate(0)
+		if err != nil {
+			return starts
+		}
+		if premix == nil {
+			continue
+		}
+		if rr, ok := premix.Userdata.(*render.RowRender); ok && rr.Tick == 0 {
+			starts = append(starts, rowStart{frame: tl.Written(), order: rr.Order, row: rr.Row})
+		}
+		tl.MarkPremix(p, premix)
+	}
+}
+
+// TestCallbacksFireWhenHeard checks that every callback fires once the frames of its row have been consumed
+// and the latency has passed, rather than when the row is rendered
+func TestCallbacksFireWhenHeard(t *testing.T) {
+	const latency = 10 * time.Millisecond
+	tl := New(songrender.DefaultOptions.SampleRate)
+	tl.SetLatency(latency)
+	latencyFrames := int64(latency) * int64(songrender.DefaultOptions.SampleRate) / int64(time.Second)
+
+	var fired []Event
+	var orders, rows, leads, drums, retriggers []Event
+	record := func(list *[]Event) func(Event) {
+		return func(e Event) {
+			*list = append(*list, e)
+			fired = append(fired, e)
+		}
+	}
+	tl.OnOrder(record(&orders))
+	tl.OnRow(record(&rows))
+	tl.OnNote(0, 1, record(&leads))
+	tl.OnNote(Any, 3, record(&drums))
+	tl.OnEffect('Q', record(&retriggers))
+
+	starts := markSong(t, tl, "mod", fixture.MOD())
+	if len(fired) != 0 {
+		t.Fatalf("%d callbacks fired while the song was rendered, want none before it is heard", len(fired))
+	}
+
+	for _, rs := range starts {
+		tl.Dispatch(rs.frame + latencyFrames - 1)
+		if len(fired) != 0 {
+			t.Fatalf("order %d row %d: %d callbacks fired a frame before the row is heard", rs.order, rs.row, len(fired))
+		}
+		tl.Dispatch(rs.frame + latencyFrames)
+		for _, e := range fired {
+			if e.Position.Order != rs.order || e.Position.Row != rs.row {
+				t.Fatalf("an event of order %d row %d fired when order %d row %d is heard", e.Position.Order,
+					e.Position.Row, rs.order, rs.row)
+			}
+		}
+		fired = fired[:0]
+	}
+
+	// the fixture plays 64 rows of its first pattern and 17 of its second, with the lead on channel 0 playing
+	// instrument 1, the drum instrument 3, and the lead retriggering on every note of the second pattern
+	if len(orders) != 2 || orders[0].Position.Order != 0 || orders[1].Position.Order != 1 {
+		t.Errorf("got order events %+v, want orders 0 and 1", orders)
+	}
+	if len(rows) != 64+17 {
+		t.Errorf("got %d row events, want %d", len(rows), 64+17)
+	}
+	if len(leads) != 10 {
+		t.Errorf("got %d notes of instrument 1 on channel 0, want 10", len(leads))
+	}
+	if len(drums) != 9 {
+		t.Errorf("got %d notes of instrument 3, want 9", len(drums))
+	}
+	for _, e := range drums {
+		if e.Channel != 2 || e.Instrument != 3 {
+			t.Errorf("a drum note is on channel %d with instrument %d, want channel 2 and instrument 3", e.Channel,
+				e.Instrument)
+		}
+	}
+	if len(retriggers) != 4 {
+		t.Errorf("got %d retrigger effects, want 4", len(retriggers))
+	}
+	for _, e := range retriggers {
+		if e.Channel != 0 || e.Param != 0x03 {
+			t.Errorf("a retrigger is Q%02X on channel %d, want Q03 on channel 0", e.Param, e.Channel)
+		}
+	}
+}
+
+// TestNoteInstruments checks that notes carry the number of the instrument they play in every format
+func TestNoteInstruments(t *testing.T) {
+	tests := []struct {
+		format string
+		data   []byte
+	}{
+		{"mod", fixture.MOD()},
+		{"it", fixture.IT()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			tl := New(songrender.DefaultOptions.SampleRate)
+			var notes []Event
+			tl.OnNote(Any, Any, func(e Event) {
+				notes = append(notes, e)
+			})
+			markSong(t, tl, tt.format, tt.data)
+			tl.Dispatch(tl.Written())
+
+			if len(notes) == 0 {
+				t.Fatal("no notes were heard")
+			}
+			for _, e := range notes {
+				if e.Instrument < 1 {
+					t.Errorf("the note %v on channel %d at order %d row %d has instrument %d, want a number from 1",
+						e.Note, e.Channel, e.Position.Order, e.Position.Row, e.Instrument)
+				}
+			}
+		})
+	}
+}
+
+// TestDispatchResetFromCallback checks that a subscriber can reset the timeline while its events are sent
+func TestDispatchResetFromCallback(t *testing.T) {
+	tl := New(songrender.DefaultOptions.SampleRate)
+	var rows int
+	tl.OnRow(func(Event) {
+		rows++
+		tl.Reset()
+	})
+	tl.Schedule(Event{Kind: EventRow})
+	tl.Schedule(Event{Kind: EventRow, Position: Position{Row: 1}})
+	tl.Mark(Position{}, 100)
+	tl.Dispatch(100)
+	if rows != 2 {
+		t.Errorf("%d row callbacks fired, want the 2 that were due when Dispatch was called", rows)
+	}
+}
@@ -0,0 +1,136 @@
+// Package timeline keeps track of which part of a song every stretch of generated audio plays, so that
+// the position being heard can be worked out from how much of the audio has been consumed
+package timeline
+
+import (
+	"time"
+
+	"github.com/gotracker/playback"
+	"github.com/gotracker/playback/index"
+	"github.com/gotracker/playback/song"
+)
+
+// Position is a place in a song, down to the tick
+type Position struct {
+	// Song is the song the position is in, which is nil when it is not known
+	Song  playback.Playback
+	Order int
+	Row   int
+	Tick  int
+}
+
+type marker struct {
+	frame int64
+	pos   Position
+}
+
+// Timeline is a list of song positions against the frames of generated audio they were rendered into
+// Frames count from the start of the generated audio, or from the last call to Reset.
+type Timeline struct {
+	sampleRate int
+	latency    int64
+
+	markers []marker
+	written int64
+
+	events        []scheduledEvent
+	subscriptions []subscription
+	// due holds the events Dispatch is sending, and is used again every time
+	due []scheduledEvent
+
+	// what MarkPremix knows about the song so far
+	player      playback.Playback
+	patIdx      index.Pattern
+	rows        [][]song.ChannelData
+	patterns    map[index.Pattern][][]song.ChannelData
+	instruments map[int]int
+	last        Position
+	hasLast     bool
+}
+
+// New creates a timeline for audio played at `sampleRate` frames per second
+func New(sampleRate int) *Timeline {
+	return &Timeline{
+		sampleRate: sampleRate,
+	}
+}
+
+// SetLatency sets how long audio takes to be heard once it has been consumed, such as the size of the
+// audio player's own buffer
+func (t *Timeline) SetLatency(latency time.Duration) {
+	t.latency = t.durationToFrames(latency)
+}
+
+// Mark records that the next `frames` frames of generated audio play `pos`
+func (t *Timeline) Mark(pos Position, frames int) {
+	t.markers = append(t.markers, marker{
+		frame: t.written,
+		pos:   pos,
+	})
+	t.written += int64(frames)
+}
+
+// Skip moves on by `frames` frames of generated audio that no song position was rendered into
+// The position stays at the one marked last.
+func (t *Timeline) Skip(frames int) {
+	t.written += int64(frames)
+}
+
+// Written returns the number of frames that have been marked
+func (t *Timeline) Written() int64 {
+	return t.written
+}
+
+// Heard returns the position being heard once `consumed` frames of the generated audio have been consumed,
+// with the latency taken off
+// Markers older than the position are dropped, so `consumed` should never go backwards.
+func (t *Timeline) Heard(consumed int64) (Position, bool) {
+	return t.At(t.HeardFrame(consumed))
+}
+
+// HeardFrame returns the frame of the generated audio being heard once `consumed` frames of it have been
+// consumed, with the latency taken off
+func (t *Timeline) HeardFrame(consumed int64) int64 {
+	return consumed - t.latency
+}
+
+// At returns the position playing at `frame` frames into the generated audio
+// Before the first marker, that is the first marked position, and past the end of the generated audio, it
+// is the last one. It returns false when there are no markers at all.
+// Markers older than `frame` are dropped, so `frame` should never go backwards.
+func (t *Timeline) At(frame int64) (Position, bool) {
+	i := 0
+	for i+1 < len(t.markers) && t.markers[i+1].frame <= frame {
+		i++
+	}
+	if i > 0 {
+		t.markers = append(t.markers[:0], t.markers[i:]...)
+	}
+
+	if len(t.markers) == 0 {
+		return Position{}, false
+	}
+	return t.markers[0].pos, true
+}
+
+// Ahead returns how far the generated audio runs ahead of what is heard after `consumed` frames were consumed
+func (t *Timeline) Ahead(consumed int64) time.Duration {
+	return t.framesToDuration(t.written - consumed + t.latency)
+}
+
+// Reset forgets all the markers and drops the events that have not been dispatched yet, for when the
+// generated audio is thrown away
+func (t *Timeline) Reset() {
+	t.markers = t.markers[:0]
+	t.events = t.events[:0]
+	t.written = 0
+	t.hasLast = false
+}
+
+func (t *Timeline) durationToFrames(d time.Duration) int64 {
+	return int64(d) * int64(t.sampleRate) / int64(time.Second)
+}
+
+func (t *Timeline) framesToDuration(frames int64) time.Duration {
+	return time.Duration(frames * int64(time.Second) / int64(t.sampleRate))
+}
@@ -0,0 +1,176 @@
+package timeline
+
+import (
+	"testing"
+	"time"
+)
+
+// sampleRate is low enough that a frame is a round number of microseconds
+const sampleRate = 1000
+
+// newMarked returns a timeline with rows 0, 1 and 2 of order 0 marked for 100 frames each, then 50 frames that
+// no position was rendered into
+func newMarked(latency time.Duration) *Timeline {
+	tl := New(sampleRate)
+	tl.SetLatency(latency)
+	for row := 0; row < 3; row++ {
+		tl.Mark(Position{Row: row}, 100)
+	}
+	tl.Skip(50)
+	return tl
+}
+
+func TestAt(t *testing.T) {
+	tests := []struct {
+		name  string
+		frame int64
+		row   int
+	}{
+		{"before the first marker", -10, 0},
+		{"first frame", 0, 0},
+		{"last frame of a marker", 99, 0},
+		{"first frame of the next", 100, 1},
+		{"middle", 250, 2},
+		{"skipped frames", 320, 2},
+		{"past the end", 1000, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tl := newMarked(0)
+			pos, ok := tl.At(tt.frame)
+			if !ok {
+				t.Fatal("no position")
+			}
+			if pos.Row != tt.row {
+				t.Errorf("At(%d) is row %d, want %d", tt.frame, pos.Row, tt.row)
+			}
+		})
+	}
+}
+
+func TestAtEmpty(t *testing.T) {
+	tl := New(sampleRate)
+	if _, ok := tl.At(0); ok {
+		t.Error("a timeline with no markers has a position")
+	}
+	tl.Skip(100)
+	if _, ok := tl.At(50); ok {
+		t.Error("a timeline with only skipped frames has a position")
+	}
+}
+
+// TestAtDropsHeardMarkers checks that markers which have been played past are dropped, and that the position
+// stays on the oldest one left when asked about an earlier frame
+func TestAtDropsHeardMarkers(t *testing.T) {
+	tl := newMarked(0)
+	if pos, _ := tl.At(150); pos.Row != 1 {
+		t.Fatalf("At(150) is row %d, want 1", pos.Row)
+	}
+	if len(tl.markers) != 2 {
+		t.Errorf("%d markers are kept after row 1 is heard, want 2", len(tl.markers))
+	}
+	if pos, _ := tl.At(50); pos.Row != 1 {
+		t.Errorf("At(50) after row 0 was dropped is row %d, want 1", pos.Row)
+	}
+	if pos, _ := tl.At(299); pos.Row != 2 || len(tl.markers) != 1 {
+		t.Errorf("At(299) is row %d with %d markers kept, want row 2 and 1 marker", pos.Row, len(tl.markers))
+	}
+}
+
+func TestHeard(t *testing.T) {
+	tests := []struct {
+		name     string
+		latency  time.Duration
+		consumed int64
+		frame    int64
+		row      int
+	}{
+		{"no latency", 0, 150, 150, 1},
+		{"latency", 80 * time.Millisecond, 150, 70, 0},
+		{"latency at a row start", 80 * time.Millisecond, 180, 100, 1},
+		{"latency before anything is heard", 80 * time.Millisecond, 20, -60, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tl := newMarked(tt.latency)
+			if got := tl.HeardFrame(tt.consumed); got != tt.frame {
+				t.Errorf("HeardFrame(%d) = %d, want %d", tt.consumed, got, tt.frame)
+			}
+			pos, ok := tl.Heard(tt.consumed)
+			if !ok {
+				t.Fatal("no position")
+			}
+			if pos.Row != tt.row {
+				t.Errorf("Heard(%d) is row %d, want %d", tt.consumed, pos.Row, tt.row)
+			}
+		})
+	}
+}
+
+func TestAhead(t *testing.T) {
+	tests := []struct {
+		name     string
+		latency  time.Duration
+		consumed int64
+		want     time.Duration
+	}{
+		{"nothing consumed", 0, 0, 350 * time.Millisecond},
+		{"some consumed", 0, 100, 250 * time.Millisecond},
+		{"all consumed", 0, 350, 0},
+		{"latency", 20 * time.Millisecond, 100, 270 * time.Millisecond},
+		{"latency with all consumed", 20 * time.Millisecond, 350, 20 * time.Millisecond},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tl := newMarked(tt.latency)
+			if got := tl.Ahead(tt.consumed); got != tt.want {
+				t.Errorf("Ahead(%d) = %v, want %v", tt.consumed, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMarkAndSkip(t *testing.T) {
+	tl := newMarked(0)
+	if got := tl.Written(); got != 350 {
+		t.Errorf("Written() = %d, want 350", got)
+	}
+	// the next marker starts after the skipped frames
+	tl.Mark(Position{Order: 1}, 100)
+	if pos, _ := tl.At(349); pos.Order != 0 || pos.Row != 2 {
+		t.Errorf("At(349) is order %d row %d, want order 0 row 2", pos.Order, pos.Row)
+	}
+	if pos, _ := tl.At(350); pos.Order != 1 || pos.Row != 0 {
+		t.Errorf("At(350) is order %d row %d, want order 1 row 0", pos.Order, pos.Row)
+	}
+}
+
+func TestReset(t *testing.T) {
+	tl := newMarked(10 * time.Millisecond)
+	var rows int
+	tl.OnRow(func(Event) {
+		rows++
+	})
+	tl.Schedule(Event{Kind: EventRow})
+
+	tl.Reset()
+	if got := tl.Written(); got != 0 {
+		t.Errorf("Written() after Reset = %d, want 0", got)
+	}
+	if _, ok := tl.At(0); ok {
+		t.Error("a position is left after Reset")
+	}
+	tl.Dispatch(1000)
+	if rows != 0 {
+		t.Error("an event scheduled before Reset fired")
+	}
+
+	// frames count from the reset, and the latency is kept
+	tl.Mark(Position{Row: 5}, 100)
+	if got := tl.HeardFrame(30); got != 20 {
+		t.Errorf("HeardFrame(30) after Reset = %d, want 20", got)
+	}
+	if pos, _ := tl.Heard(30); pos.Row != 5 {
+		t.Errorf("Heard(30) after Reset is row %d, want 5", pos.Row)
+	}
+}
@@ -0,0 +1,196 @@
+package main
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+
+	"github.com/eliasdaler/ebiten-tracker-demo/analysis"
+	"github.com/eliasdaler/ebiten-tracker-demo/music"
+	"github.com/eliasdaler/ebiten-tracker-demo/render"
+	"github.com/eliasdaler/ebiten-tracker-demo/visualizer"
+)
+
+const (
+	// scopeChannels is the number of song channels that get an oscilloscope
+	scopeChannels = 16
+	// historyLength is how much of the generated audio is kept, which has to cover everything that is
+	// buffered ahead of what is heard
+	historyLength = 2 * time.Second
+	spectrumSize  = 4096
+	spectrumBands = 48
+	// scopeFrames is how much audio an oscilloscope shows
+	scopeFrames = 1024
+)
+
+var (
+	scopeColor      = color.RGBA{0x60, 0xe0, 0x80, 0xff}
+	scopeBackground = color.RGBA{0x10, 0x18, 0x10, 0xff}
+	barColor        = color.RGBA{0x40, 0xa0, 0xff, 0xff}
+	peakColor       = color.RGBA{0xff, 0x60, 0x40, 0xff}
+)
+
+// visualView draws oscilloscopes, a spectrum analyzer and VU meters of the audio being heard
+type visualView struct {
+	master   *analysis.History
+	channels *analysis.History
+	spectrum *analysis.Spectrum
+	meters   []*analysis.Meter
+	// sampleRate and numChannels are the format of the audio, and historyFrames the length of the histories
+	sampleRate    int
+	numChannels   int
+	historyFrames int
+	// lastHeard is the frame that was heard when the view was drawn last
+	lastHeard int64
+
+	scope        visualizer.Oscilloscope
+	channelScope visualizer.Oscilloscope
+	bars         visualizer.SpectrumBars
+	vu           visualizer.VUMeter
+
+	buf   []float32
+	mono  []float32
+	split []float32
+}
+
+// newVisualView creates a view of audio in the format of `out`
+func newVisualView(out render.Options) *visualView {
+	spectrum, err := analysis.NewSpectrum(spectrumSize, out.SampleRate, spectrumBands, 40, 16000)
+	if err != nil {
+		panic(err)
+	}
+	historyFrames := int(int64(out.SampleRate) * int64(historyLength) / int64(time.Second))
+	v := &visualView{
+		master:        analysis.NewHistory(out.Channels, historyFrames),
+		channels:      analysis.NewHistory(scopeChannels, historyFrames),
+		spectrum:      spectrum,
+		meters:        make([]*analysis.Meter, out.Channels),
+		sampleRate:    out.SampleRate,
+		numChannels:   out.Channels,
+		historyFrames: historyFrames,
+		scope: visualizer.Oscilloscope{
+			Color:      scopeColor,
+			Background: scopeBackground,
+		},
+		channelScope: visualizer.Oscilloscope{
+			Color:      scopeColor,
+			Background: scopeBackground,
+			Gain:       2,
+		},
+		bars: visualizer.SpectrumBars{
+			Color:      barColor,
+			Background: scopeBackground,
+			FallRate:   60,
+		},
+		vu: visualizer.VUMeter{
+			Color:      barColor,
+			PeakColor:  peakColor,
+			Background: scopeBackground,
+		},
+	}
+	for i := range v.meters {
+		v.meters[i] = analysis.NewMeter()
+	}
+	return v
+}
+
+// Write adds the audio of `b` to the view, which is shown once it is heard
+func (v *visualView) Write(b *music.Block) {
+	v.master.Write(b.Samples)
+	if b.Premix == nil {
+		v.channels.Skip(b.Frames)
+		return
+	}
+	v.split = analysis.SplitPremix(v.split[:0], b.Premix, scopeChannels)
+	v.channels.Write(v.split)
+}
+
+// Reset forgets all the audio written so far, for when the generated audio is thrown away
+func (v *visualView) Reset() {
+	v.master.Reset()
+	v.channels.Reset()
+	v.lastHeard = 0
+}
+
+// Draw draws the visualizers for the audio at frame `heard` into `height` pixels of `screen`, starting at `y`
+// `numChannels` is the number of channels in the song being heard.
+func (v *visualView) Draw(screen *ebiten.Image, heard int64, numChannels int, y, height int) {
+	elapsed := heard - v.lastHeard
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	v.lastHeard = heard
+	dt := time.Duration(elapsed) * time.Second / time.Duration(v.sampleRate)
+
+	top := height / 2
+	const (
+		meterWidth = 12
+		gap        = 4
+	)
+	scopeWidth := (screenWidth - 2*meterWidth - 3*gap) / 2
+
+	// master oscilloscope
+	frames := v.master.Read(v.buffer(scopeFrames*v.numChannels), heard)
+	v.scope.Draw(screen, analysis.Downmix(v.monoBuffer(scopeFrames), frames, v.numChannels),
+		image.Rect(0, y, scopeWidth, y+top-gap))
+
+	// spectrum
+	frames = v.master.Read(v.buffer(spectrumSize*v.numChannels), heard)
+	levels := v.spectrum.Analyze(analysis.Downmix(v.monoBuffer(spectrumSize), frames, v.numChannels))
+	v.bars.Update(levels, dt)
+	x := scopeWidth + gap
+	v.bars.Draw(screen, image.Rect(x, y, x+scopeWidth, y+top-gap))
+
+	// VU meters, which measure the audio heard since the last time they were drawn
+	if elapsed > 0 {
+		n := int(elapsed)
+		if n > v.historyFrames {
+			n = v.historyFrames
+		}
+		frames = v.master.Read(v.buffer(n*v.numChannels), heard)
+		for ch, m := range v.meters {
+			m.Update(analysis.Deinterleave(v.monoBuffer(n), frames, v.numChannels, ch), dt)
+		}
+	}
+	x += scopeWidth + gap
+	for ch, m := range v.meters {
+		mx := x + ch*(meterWidth+gap/2)
+		v.vu.Draw(screen, m, image.Rect(mx, y, mx+meterWidth, y+top-gap))
+	}
+
+	// channel oscilloscopes
+	if numChannels > scopeChannels {
+		numChannels = scopeChannels
+	}
+	const columns = scopeChannels / 2
+	cellWidth := screenWidth / columns
+	cellHeight := (height - top) / 2
+	frames = v.channels.Read(v.buffer(scopeFrames*scopeChannels), heard)
+	for ch := 0; ch < numChannels; ch++ {
+		cx := (ch % columns) * cellWidth
+		cy := y + top + (ch/columns)*cellHeight
+		samples := analysis.Deinterleave(v.monoBuffer(scopeFrames), frames, scopeChannels, ch)
+		v.channelScope.Draw(screen, samples, image.Rect(cx, cy, cx+cellWidth-gap, cy+cellHeight-gap))
+		ebitenutil.DebugPrintAt(screen, fmt.Sprintf("%d", ch+1), cx+2, cy)
+	}
+}
+
+// buffer returns a scratch buffer of `n` samples for reading from the histories
+func (v *visualView) buffer(n int) []float32 {
+	if cap(v.buf) < n {
+		v.buf = make([]float32, n)
+	}
+	return v.buf[:n]
+}
+
+// monoBuffer returns a scratch buffer of `n` samples for a single channel
+func (v *visualView) monoBuffer(n int) []float32 {
+	if cap(v.mono) < n {
+		v.mono = make([]float32, n)
+	}
+	return v.mono[:n]
+}
@@ -0,0 +1,155 @@
+// Package visualizer draws audio visualizers onto ebiten images: oscilloscopes, spectrum analyzers and VU
+// meters
+// The measuring is done by the analysis package, so the visualizers only keep what they need to draw.
+package visualizer
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"time"
+
+	"github.com/hajimehoshi/ebiten/v2"
+	"github.com/hajimehoshi/ebiten/v2/ebitenutil"
+
+	"github.com/eliasdaler/ebiten-tracker-demo/analysis"
+)
+
+// Oscilloscope draws the waveform of mono audio
+type Oscilloscope struct {
+	Color      color.Color
+	Background color.Color
+	// Gain scales the waveform before it is drawn, and 0 draws it as it is
+	Gain float64
+}
+
+// Draw draws `samples` across `r` of `dst`, with full scale reaching the top and the bottom of it
+func (o *Oscilloscope) Draw(dst *ebiten.Image, samples []float32, r image.Rectangle) {
+	fillRect(dst, r, o.Background)
+
+	w, h := r.Dx(), r.Dy()
+	if w < 2 || h < 1 {
+		return
+	}
+	gain := o.Gain
+	if gain == 0 {
+		gain = 1
+	}
+	mid := float64(r.Min.Y) + float64(h)/2
+
+	y := func(x int) float64 {
+		if len(samples) == 0 {
+			return mid
+		}
+		v := float64(samples[x*len(samples)/w]) * gain
+		v = math.Max(-1, math.Min(1, v))
+		return mid - v*float64(h-1)/2
+	}
+
+	prev := y(0)
+	for x := 1; x < w; x++ {
+		cur := y(x)
+		ebitenutil.DrawLine(dst, float64(r.Min.X+x-1), prev, float64(r.Min.X+x), cur, o.Color)
+		prev = cur
+	}
+}
+
+// SpectrumBars draws the band levels of an analysis.Spectrum as bars, with the quietest level at the bottom
+// The bars fall at FallRate, so that they do not flicker.
+type SpectrumBars struct {
+	Color      color.Color
+	Background color.Color
+	// MinDB is the level at the bottom of the bars, and 0 means -72 dB
+	MinDB float64
+	// FallRate is how fast the bars fall, in dB per second, and 0 lets them fall straight away
+	FallRate float64
+
+	shown []float64
+}
+
+// Update takes on the band levels `levels`, which were measured `elapsed` after the last ones
+func (s *SpectrumBars) Update(levels []float64, elapsed time.Duration) {
+	if len(s.shown) != len(levels) {
+		s.shown = make([]float64, len(levels))
+		for i := range s.shown {
+			s.shown[i] = analysis.MinDB
+		}
+	}
+	fall := math.Inf(1)
+	if s.FallRate > 0 {
+		fall = s.FallRate * elapsed.Seconds()
+	}
+	for i, l := range levels {
+		s.shown[i] = math.Max(l, s.shown[i]-fall)
+	}
+}
+
+// Draw draws the bars across `r` of `dst`
+func (s *SpectrumBars) Draw(dst *ebiten.Image, r image.Rectangle) {
+	fillRect(dst, r, s.Background)
+	if len(s.shown) == 0 {
+		return
+	}
+
+	w := float64(r.Dx()) / float64(len(s.shown))
+	for i, l := range s.shown {
+		h := levelHeight(l, s.MinDB, r.Dy())
+		x := float64(r.Min.X) + float64(i)*w
+		gap := 1.0
+		if w < 3 {
+			gap = 0
+		}
+		ebitenutil.DrawRect(dst, x, float64(r.Max.Y)-h, w-gap, h, s.Color)
+	}
+}
+
+// VUMeter draws the level and held peak of an analysis.Meter as a bar
+// The bar fills upwards when `r` is taller than it is wide, and rightwards when it is not.
+type VUMeter struct {
+	Color      color.Color
+	PeakColor  color.Color
+	Background color.Color
+	// MinDB is the level at the empty end of the bar, and 0 means -72 dB
+	MinDB float64
+}
+
+// Draw draws `m` in `r` of `dst`
+func (v *VUMeter) Draw(dst *ebiten.Image, m *analysis.Meter, r image.Rectangle) {
+	fillRect(dst, r, v.Background)
+
+	x, y := float64(r.Min.X), float64(r.Min.Y)
+	w, h := float64(r.Dx()), float64(r.Dy())
+	if r.Dy() > r.Dx() {
+		level := levelHeight(m.Level(), v.MinDB, r.Dy())
+		peak := levelHeight(m.Peak(), v.MinDB, r.Dy())
+		ebitenutil.DrawRect(dst, x, y+h-level, w, level, v.Color)
+		if peak > 0 {
+			ebitenutil.DrawRect(dst, x, y+h-peak, w, 2, v.PeakColor)
+		}
+		return
+	}
+
+	level := levelHeight(m.Level(), v.MinDB, r.Dx())
+	peak := levelHeight(m.Peak(), v.MinDB, r.Dx())
+	ebitenutil.DrawRect(dst, x, y, level, h, v.Color)
+	if peak > 0 {
+		ebitenutil.DrawRect(dst, x+peak-2, y, 2, h, v.PeakColor)
+	}
+}
+
+// levelHeight returns how much of `size` pixels the level `db` fills, on a scale from `minDB` to 0 dB
+func levelHeight(db, minDB float64, size int) float64 {
+	if minDB == 0 {
+		minDB = -72
+	}
+	f := (db - minDB) / -minDB
+	return math.Max(0, math.Min(1, f)) * float64(size)
+}
+
+// fillRect fills `r` of `dst` with `c`, unless it is nil
+func fillRect(dst *ebiten.Image, r image.Rectangle, c color.Color) {
+	if c == nil {
+		return
+	}
+	ebitenutil.DrawRect(dst, float64(r.Min.X), float64(r.Min.Y), float64(r.Dx()), float64(r.Dy()), c)
+}